@@ -7,11 +7,11 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.24.0
 	github.com/aws/aws-sdk-go v1.55.5
-	github.com/aws/aws-sdk-go-v2 v1.30.5
-	github.com/aws/aws-sdk-go-v2/config v1.27.33
-	github.com/aws/aws-sdk-go-v2/credentials v1.17.32
-	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.13
-	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.17.18
+	github.com/aws/aws-sdk-go-v2 v1.36.3
+	github.com/aws/aws-sdk-go-v2/config v1.29.0
+	github.com/aws/aws-sdk-go-v2/credentials v1.17.53
+	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.24
+	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.17.50
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.32.7
 	github.com/aws/aws-sdk-go-v2/service/account v1.19.6
 	github.com/aws/aws-sdk-go-v2/service/acm v1.28.8
@@ -49,7 +49,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cloud9 v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.20.7
 	github.com/aws/aws-sdk-go-v2/service/cloudformation v1.53.7
-	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.38.7
+	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.42.0
 	github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore v1.6.6
 	github.com/aws/aws-sdk-go-v2/service/cloudhsmv2 v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.24.6
@@ -94,13 +94,13 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdb v1.37.3
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.11.6
 	github.com/aws/aws-sdk-go-v2/service/drs v1.28.6
-	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.34.8
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.177.2
-	github.com/aws/aws-sdk-go-v2/service/ecr v1.32.4
+	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.42.4
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.224.0
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.43.0
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.45.4
 	github.com/aws/aws-sdk-go-v2/service/efs v1.31.6
-	github.com/aws/aws-sdk-go-v2/service/eks v1.48.4
+	github.com/aws/aws-sdk-go-v2/service/eks v1.57.0
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.40.9
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.26.5
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing v1.26.7
@@ -146,7 +146,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/kinesisvideo v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/kms v1.35.7
 	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.35.6
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.58.3
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.69.14
 	github.com/aws/aws-sdk-go-v2/service/launchwizard v1.6.6
 	github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.46.3
@@ -162,7 +162,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.32.6
 	github.com/aws/aws-sdk-go-v2/service/mediapackagev2 v1.15.3
 	github.com/aws/aws-sdk-go-v2/service/mediastore v1.22.6
-	github.com/aws/aws-sdk-go-v2/service/memorydb v1.21.8
+	github.com/aws/aws-sdk-go-v2/service/memorydb v1.26.0
 	github.com/aws/aws-sdk-go-v2/service/mq v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.29.7
 	github.com/aws/aws-sdk-go-v2/service/neptune v1.33.7
@@ -189,7 +189,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/quicksight v1.71.2
 	github.com/aws/aws-sdk-go-v2/service/ram v1.27.7
 	github.com/aws/aws-sdk-go-v2/service/rbin v1.18.7
-	github.com/aws/aws-sdk-go-v2/service/rds v1.82.4
+	github.com/aws/aws-sdk-go-v2/service/rds v1.93.0
 	github.com/aws/aws-sdk-go-v2/service/redshift v1.46.8
 	github.com/aws/aws-sdk-go-v2/service/redshiftdata v1.28.2
 	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.21.4
@@ -205,10 +205,11 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/route53recoverycontrolconfig v1.23.6
 	github.com/aws/aws-sdk-go-v2/service/route53recoveryreadiness v1.19.6
 	github.com/aws/aws-sdk-go-v2/service/route53resolver v1.30.7
-	github.com/aws/aws-sdk-go-v2/service/rum v1.19.6
-	github.com/aws/aws-sdk-go-v2/service/s3 v1.61.2
+	github.com/aws/aws-sdk-go-v2/service/rum v1.24.0
+	github.com/aws/aws-sdk-go-v2/service/s3 v1.73.0
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.47.0
 	github.com/aws/aws-sdk-go-v2/service/s3outposts v1.26.6
+	github.com/aws/aws-sdk-go-v2/service/s3tables v1.0.0
 	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.155.1
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.10.6
 	github.com/aws/aws-sdk-go-v2/service/schemas v1.26.6
@@ -231,10 +232,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.24.6
 	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.32.6
 	github.com/aws/aws-sdk-go-v2/service/ssmsap v1.16.2
-	github.com/aws/aws-sdk-go-v2/service/sso v1.22.7
+	github.com/aws/aws-sdk-go-v2/service/sso v1.24.10
 	github.com/aws/aws-sdk-go-v2/service/ssoadmin v1.27.7
 	github.com/aws/aws-sdk-go-v2/service/storagegateway v1.31.6
-	github.com/aws/aws-sdk-go-v2/service/sts v1.30.7
+	github.com/aws/aws-sdk-go-v2/service/sts v1.33.8
 	github.com/aws/aws-sdk-go-v2/service/swf v1.25.7
 	github.com/aws/aws-sdk-go-v2/service/synthetics v1.26.8
 	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.3.1
@@ -242,7 +243,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.39.6
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.50.6
 	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.17.6
-	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.10.7
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.13.0
 	github.com/aws/aws-sdk-go-v2/service/waf v1.23.6
 	github.com/aws/aws-sdk-go-v2/service/wafregional v1.23.6
 	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.52.2
@@ -251,7 +252,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.46.3
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.21.6
 	github.com/aws/aws-sdk-go-v2/service/xray v1.27.7
-	github.com/aws/smithy-go v1.20.4
+	github.com/aws/smithy-go v1.22.2
 	github.com/beevik/etree v1.4.1
 	github.com/cedar-policy/cedar-go v0.1.0
 	github.com/davecgh/go-spew v1.1.1
@@ -289,7 +290,9 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/pquerna/otp v1.4.0
 	github.com/shopspring/decimal v1.4.0
+	github.com/xeipuuv/gojsonschema v1.2.0
 	golang.org/x/crypto v0.26.0
+	golang.org/x/sync v0.8.0
 	golang.org/x/text v0.17.0
 	golang.org/x/tools v0.24.0
 	gopkg.in/dnaeon/go-vcr.v3 v3.2.1
@@ -304,17 +307,17 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.4 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.17 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.17 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.10 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.1 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.17 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.4 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.3.19 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.9.18 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.11.19 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.17.17 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.26.7 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.28 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.5.0 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.10.15 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.15 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.9 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.28.9 // indirect
 	github.com/bgentry/speakeasy v0.2.0 // indirect
 	github.com/boombuler/barcode v1.0.1 // indirect
 	github.com/bufbuild/protocompile v0.6.0 // indirect
@@ -351,7 +354,6 @@ require (
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/xeipuuv/gojsonpointer v0.0.0-20190905194746-02993c407bfb // indirect
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
-	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
 	github.com/zclconf/go-cty v1.15.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws v0.54.0 // indirect
 	go.opentelemetry.io/otel v1.29.0 // indirect
@@ -359,7 +361,6 @@ require (
 	go.opentelemetry.io/otel/trace v1.29.0 // indirect
 	golang.org/x/mod v0.20.0 // indirect
 	golang.org/x/net v0.28.0 // indirect
-	golang.org/x/sync v0.8.0 // indirect
 	golang.org/x/sys v0.24.0 // indirect
 	google.golang.org/appengine v1.6.8 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240827150818-7e3bb234dfed // indirect
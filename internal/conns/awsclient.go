@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/sync/semaphore"
 )
 
 type AWSClient struct {
@@ -34,6 +35,7 @@ type AWSClient struct {
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
+	concurrencyLimiters       map[string]*semaphore.Weighted // From provider configuration.
 	conns                     map[string]any
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
@@ -47,6 +49,22 @@ type AWSClient struct {
 	stsRegion                 string // From provider configuration.
 }
 
+// AcquireConcurrencySlot blocks until an operation against the specified service package may proceed,
+// honoring any per-service concurrency limit from provider configuration.
+// The returned function releases the slot and must always be called.
+func (c *AWSClient) AcquireConcurrencySlot(ctx context.Context, servicePackageName string) (func(), error) {
+	limiter, ok := c.concurrencyLimiters[servicePackageName]
+	if !ok {
+		return func() {}, nil
+	}
+
+	if err := limiter.Acquire(ctx, 1); err != nil {
+		return func() {}, err
+	}
+
+	return func() { limiter.Release(1) }, nil
+}
+
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
 func (c *AWSClient) CredentialsProvider(context.Context) aws_sdkv2.CredentialsProvider {
 	if c.awsConfig == nil {
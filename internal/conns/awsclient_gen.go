@@ -201,6 +201,7 @@ import (
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	s3control_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3control"
 	s3outposts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3outposts"
+	s3tables_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3tables"
 	sagemaker_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sagemaker"
 	scheduler_sdkv2 "github.com/aws/aws-sdk-go-v2/service/scheduler"
 	schemas_sdkv2 "github.com/aws/aws-sdk-go-v2/service/schemas"
@@ -1041,6 +1042,10 @@ func (c *AWSClient) S3OutpostsClient(ctx context.Context) *s3outposts_sdkv2.Clie
 	return errs.Must(client[*s3outposts_sdkv2.Client](ctx, c, names.S3Outposts, make(map[string]any)))
 }
 
+func (c *AWSClient) S3TablesClient(ctx context.Context) *s3tables_sdkv2.Client {
+	return errs.Must(client[*s3tables_sdkv2.Client](ctx, c, names.S3Tables, make(map[string]any)))
+}
+
 func (c *AWSClient) SESClient(ctx context.Context) *ses_sdkv2.Client {
 	return errs.Must(client[*ses_sdkv2.Client](ctx, c, names.SES, make(map[string]any)))
 }
@@ -6,6 +6,10 @@ package conns
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/sync/semaphore"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -177,3 +181,41 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientAcquireConcurrencySlot(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		concurrencyLimiters: map[string]*semaphore.Weighted{
+			names.CloudFront: semaphore.NewWeighted(1),
+		},
+	}
+
+	// Services without a configured limit are never blocked.
+	for range 3 {
+		if _, err := client.AcquireConcurrencySlot(ctx, names.Route53); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	release, err := client.AcquireConcurrencySlot(ctx, names.CloudFront)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.AcquireConcurrencySlot(timeoutCtx, names.CloudFront); err == nil {
+		t.Fatal("expected error acquiring a second slot, got none")
+	}
+
+	release()
+
+	if release, err := client.AcquireConcurrencySlot(ctx, names.CloudFront); err != nil {
+		t.Fatalf("unexpected error after release: %s", err)
+	} else {
+		release()
+	}
+}
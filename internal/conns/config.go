@@ -24,6 +24,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
+	"golang.org/x/sync/semaphore"
 )
 
 type Config struct {
@@ -50,6 +51,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceConcurrencyLimits       map[string]int
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -228,6 +230,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
+	client.concurrencyLimiters = make(map[string]*semaphore.Weighted, len(c.ServiceConcurrencyLimits))
+	for servicePackageName, limit := range c.ServiceConcurrencyLimits {
+		client.concurrencyLimiters[servicePackageName] = semaphore.NewWeighted(int64(limit))
+	}
+
 	return client, diags
 }
 
@@ -652,3 +652,51 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+type concurrencySlotReleaseKey struct{}
+
+// concurrencyLimitResourceInterceptor limits the number of concurrent resource operations against a service
+// to any per-service limit from provider configuration.
+type concurrencyLimitResourceInterceptor struct{}
+
+func (r concurrencyLimitResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r concurrencyLimitResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r concurrencyLimitResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r concurrencyLimitResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r concurrencyLimitResourceInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok || meta == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		release, err := meta.AcquireConcurrencySlot(ctx, inContext.ServicePackageName)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("waiting for %s concurrency slot", inContext.ServicePackageName), err.Error())
+
+			return ctx, diags
+		}
+
+		ctx = context.WithValue(ctx, concurrencySlotReleaseKey{}, release)
+	case Finally:
+		if release, ok := ctx.Value(concurrencySlotReleaseKey{}).(func()); ok {
+			release()
+		}
+	}
+
+	return ctx, diags
+}
@@ -119,6 +119,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency_limits": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Map of service names (as used in `endpoints`) to the maximum number of\nconcurrent resource create and delete operations for that service.",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -445,6 +450,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			// Run last so that an error from an earlier Before interceptor never leaves a slot held.
+			interceptors = append(interceptors, concurrencyLimitResourceInterceptor{})
+
 			resources = append(resources, func() resource.Resource {
 				return newWrappedResource(bootstrapContext, inner, interceptors)
 			})
@@ -497,3 +497,38 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+type concurrencySlotReleaseKey struct{}
+
+// concurrencyLimitInterceptor limits the number of concurrent resource operations against a service
+// to any per-service limit from provider configuration.
+type concurrencyLimitInterceptor struct{}
+
+func (r concurrencyLimitInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return ctx, diags
+		}
+
+		release, err := c.AcquireConcurrencySlot(ctx, inContext.ServicePackageName)
+
+		if err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "waiting for %s concurrency slot: %s", inContext.ServicePackageName, err)
+		}
+
+		ctx = context.WithValue(ctx, concurrencySlotReleaseKey{}, release)
+	case Finally:
+		if release, ok := ctx.Value(concurrencySlotReleaseKey{}).(func()); ok {
+			release()
+		}
+	}
+
+	return ctx, diags
+}
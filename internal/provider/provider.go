@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -188,6 +189,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency_limits": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "Map of service names (as used in `endpoints`) to the maximum number of\n" +
+					"concurrent resource create and delete operations for that service.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -411,6 +419,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Run last so that an error from an earlier Before interceptor never leaves a slot held.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before | Finally,
+				why:         Create | Delete,
+				interceptor: concurrencyLimitInterceptor{},
+			})
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -576,6 +591,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_concurrency_limits"); ok && len(v.(map[string]interface{})) > 0 {
+		limits, dx := expandServiceConcurrencyLimits(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceConcurrencyLimits = limits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -915,6 +939,42 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandServiceConcurrencyLimits(_ context.Context, tfMap map[string]interface{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	limitsPath := cty.GetAttrPath("service_concurrency_limits")
+	limits := make(map[string]int, len(tfMap))
+
+	for k, v := range tfMap {
+		pkg := k
+		if !slices.Contains(names.ProviderPackages(), pkg) {
+			var err error
+			if pkg, err = names.ProviderPackageForAlias(k); err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					limitsPath.IndexString(k),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Attribute %q contains unsupported service %q.", errs.PathString(limitsPath), k),
+				))
+				continue
+			}
+		}
+
+		limit := v.(int)
+		if limit < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				limitsPath.IndexString(k),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %q must be at least 1, got: %d.", errs.PathString(limitsPath.IndexString(k)), limit),
+			))
+			continue
+		}
+
+		limits[pkg] = limit
+	}
+
+	return limits, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
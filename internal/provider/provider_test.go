@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -61,6 +62,78 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		limits        map[string]interface{}
+		expected      map[string]int
+		expectedDiags diag.Diagnostics
+	}{
+		"service package": {
+			limits: map[string]interface{}{
+				names.CloudFront: 2,
+				names.Route53:    5,
+			},
+			expected: map[string]int{
+				names.CloudFront: 2,
+				names.Route53:    5,
+			},
+		},
+		"alias": {
+			limits: map[string]interface{}{
+				"cloudwatchlogs": 3,
+			},
+			expected: map[string]int{
+				names.Logs: 3,
+			},
+		},
+		"unsupported service": {
+			limits: map[string]interface{}{
+				"notaservice": 1,
+			},
+			expected: map[string]int{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("service_concurrency_limits").IndexString("notaservice"),
+					"Invalid Attribute Value",
+					`Attribute "service_concurrency_limits" contains unsupported service "notaservice".`,
+				),
+			},
+		},
+		"zero limit": {
+			limits: map[string]interface{}{
+				names.CloudFront: 0,
+			},
+			expected: map[string]int{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("service_concurrency_limits").IndexString(names.CloudFront),
+					"Invalid Attribute Value",
+					`Attribute "service_concurrency_limits[cloudfront]" must be at least 1, got: 0.`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandServiceConcurrencyLimits(ctx, testCase.limits)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(results, testCase.expected); diff != "" {
+				t.Errorf("unexpected limits difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
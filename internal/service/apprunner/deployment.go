@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -69,6 +70,14 @@ func (r *deploymentResource) Schema(ctx context.Context, request resource.Schema
 			names.AttrStatus: schema.StringAttribute{
 				Computed: true,
 			},
+			names.AttrTriggers: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
@@ -235,11 +244,12 @@ func waitDeploymentSucceeded(ctx context.Context, conn *apprunner.Client, servic
 }
 
 type deploymentResourceModel struct {
-	ID          types.String   `tfsdk:"id"`
-	OperationID types.String   `tfsdk:"operation_id"`
-	ServiceARN  fwtypes.ARN    `tfsdk:"service_arn"`
-	Status      types.String   `tfsdk:"status"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	ID          types.String                     `tfsdk:"id"`
+	OperationID types.String                     `tfsdk:"operation_id"`
+	ServiceARN  fwtypes.ARN                      `tfsdk:"service_arn"`
+	Status      types.String                     `tfsdk:"status"`
+	Timeouts    timeouts.Value                   `tfsdk:"timeouts"`
+	Triggers    fwtypes.MapValueOf[types.String] `tfsdk:"triggers"`
 }
 
 func (data *deploymentResourceModel) setID() {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -37,6 +38,41 @@ func TestAccAppRunnerDeployment_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppRunnerDeployment_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppRunnerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeployment_triggers(rName, "1.27"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.OperationStatusSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.image_tag", "1.27"),
+				),
+			},
+			{
+				Config: testAccDeployment_triggers(rName, "1.28"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.OperationStatusSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "triggers.image_tag", "1.28"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDeployment_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_apprunner_service" "test" {
@@ -59,3 +95,30 @@ resource "aws_apprunner_deployment" "test" {
 }
 `, rName)
 }
+
+func testAccDeployment_triggers(rName, imageTag string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+}
+
+resource "aws_apprunner_deployment" "test" {
+  service_arn = aws_apprunner_service.test.arn
+
+  triggers = {
+    image_tag = %[2]q
+  }
+}
+`, rName, imageTag)
+}
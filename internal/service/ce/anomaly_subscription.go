@@ -5,13 +5,16 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -104,7 +107,10 @@ func resourceAnomalySubscription() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAnomalySubscriptionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -164,8 +170,20 @@ func resourceAnomalySubscriptionRead(ctx context.Context, d *schema.ResourceData
 	d.Set("monitor_arn_list", subscription.MonitorArnList)
 	d.Set(names.AttrName, subscription.SubscriptionName)
 	d.Set("subscriber", flattenSubscribers(subscription.Subscribers))
-	if err := d.Set("threshold_expression", []interface{}{flattenExpression(subscription.ThresholdExpression)}); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting threshold_expression: %s", err)
+	thresholdExpression := subscription.ThresholdExpression
+	// Cost Explorer normalizes threshold values (e.g. "100" is returned as "100.0") and may reorder
+	// And/Or operands, so retain the configured expression if it is semantically equivalent.
+	if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if old := expandExpression(v.([]interface{})[0].(map[string]interface{})); thresholdExpressionsEquivalent(old, thresholdExpression) {
+			thresholdExpression = old
+		}
+	}
+	if thresholdExpression != nil {
+		if err := d.Set("threshold_expression", []interface{}{flattenExpression(thresholdExpression)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting threshold_expression: %s", err)
+		}
+	} else {
+		d.Set("threshold_expression", nil)
 	}
 
 	return diags
@@ -227,6 +245,28 @@ func resourceAnomalySubscriptionDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func resourceAnomalySubscriptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("frequency") {
+		return nil
+	}
+
+	// Subscriptions with SNS subscribers only support individual (IMMEDIATE) alerts.
+	if frequency := diff.Get("frequency").(string); frequency != string(awstypes.AnomalySubscriptionFrequencyImmediate) {
+		for _, tfMapRaw := range diff.Get("subscriber").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap[names.AttrType].(string); ok && v == string(awstypes.SubscriberTypeSns) {
+				return fmt.Errorf("frequency must be %q when a subscriber of type %q is configured, got %q", awstypes.AnomalySubscriptionFrequencyImmediate, awstypes.SubscriberTypeSns, frequency)
+			}
+		}
+	}
+
+	return nil
+}
+
 func findAnomalySubscriptionByARN(ctx context.Context, conn *costexplorer.Client, arn string) (*awstypes.AnomalySubscription, error) {
 	input := &costexplorer.GetAnomalySubscriptionsInput{
 		SubscriptionArnList: []string{arn},
@@ -291,3 +331,120 @@ func flattenSubscribers(apiObjects []awstypes.Subscriber) []interface{} {
 
 	return tfList
 }
+
+// thresholdExpressionsEquivalent returns whether two threshold expressions are semantically equivalent.
+// Operand order within And/Or and the order of values and match options are ignored, and values
+// that parse as numbers are compared numerically.
+func thresholdExpressionsEquivalent(a, b *awstypes.Expression) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if !thresholdExpressionListsEquivalent(a.And, b.And) || !thresholdExpressionListsEquivalent(a.Or, b.Or) {
+		return false
+	}
+
+	if !thresholdExpressionsEquivalent(a.Not, b.Not) {
+		return false
+	}
+
+	if (a.CostCategories == nil) != (b.CostCategories == nil) {
+		return false
+	}
+	if a.CostCategories != nil {
+		if aws.ToString(a.CostCategories.Key) != aws.ToString(b.CostCategories.Key) ||
+			!matchOptionsEquivalent(a.CostCategories.MatchOptions, b.CostCategories.MatchOptions) ||
+			!thresholdValuesEquivalent(a.CostCategories.Values, b.CostCategories.Values) {
+			return false
+		}
+	}
+
+	if (a.Dimensions == nil) != (b.Dimensions == nil) {
+		return false
+	}
+	if a.Dimensions != nil {
+		if a.Dimensions.Key != b.Dimensions.Key ||
+			!matchOptionsEquivalent(a.Dimensions.MatchOptions, b.Dimensions.MatchOptions) ||
+			!thresholdValuesEquivalent(a.Dimensions.Values, b.Dimensions.Values) {
+			return false
+		}
+	}
+
+	if (a.Tags == nil) != (b.Tags == nil) {
+		return false
+	}
+	if a.Tags != nil {
+		if aws.ToString(a.Tags.Key) != aws.ToString(b.Tags.Key) ||
+			!matchOptionsEquivalent(a.Tags.MatchOptions, b.Tags.MatchOptions) ||
+			!thresholdValuesEquivalent(a.Tags.Values, b.Tags.Values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func thresholdExpressionListsEquivalent(a, b []awstypes.Expression) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	matched := make([]bool, len(b))
+	for i := range a {
+		found := false
+		for j := range b {
+			if !matched[j] && thresholdExpressionsEquivalent(&a[i], &b[j]) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchOptionsEquivalent(a, b []awstypes.MatchOption) bool {
+	return thresholdValuesEquivalent(enum.Slice(a...), enum.Slice(b...))
+}
+
+func thresholdValuesEquivalent(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	matched := make([]bool, len(b))
+	for _, x := range a {
+		found := false
+		for j, y := range b {
+			if !matched[j] && thresholdValueEquivalent(x, y) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func thresholdValueEquivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	x, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return false
+	}
+	y, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return false
+	}
+
+	return x == y
+}
@@ -226,6 +226,79 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_ThresholdExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscription awstypes.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, "100", "50"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						names.AttrKey: "ANOMALY_TOTAL_IMPACT_ABSOLUTE",
+						"values.#":    acctest.Ct1,
+						"values.0":    "100",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						names.AttrKey: "ANOMALY_TOTAL_IMPACT_PERCENTAGE",
+						"values.#":    acctest.Ct1,
+						"values.0":    "50",
+					}),
+				),
+			},
+			{
+				Config:   testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, "100", "50"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, "200", "25.5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						names.AttrKey: "ANOMALY_TOTAL_IMPACT_ABSOLUTE",
+						"values.0":    "200",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						names.AttrKey: "ANOMALY_TOTAL_IMPACT_PERCENTAGE",
+						"values.0":    "25.5",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_frequencySNSValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_frequencySNS(rName, "DAILY"),
+				ExpectError: regexache.MustCompile(`frequency must be "IMMEDIATE" when a subscriber of type "SNS" is configured`),
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var subscription awstypes.AnomalySubscription
@@ -580,6 +653,72 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName))
 }
 
+func testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, absolute, percentage string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+        values        = [%[3]q]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_PERCENTAGE"
+        values        = [%[4]q]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+  }
+}
+`, rName, address, absolute, percentage))
+}
+
+func testAccAnomalySubscriptionConfig_frequencySNS(rName, rFrequency string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = %[2]q
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "SNS"
+    address = aws_sns_topic.test.arn
+  }
+
+  threshold_expression {
+    dimension {
+      key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+      values        = ["100.0"]
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+    }
+  }
+}
+`, rName, rFrequency))
+}
+
 func testAccAnomalySubscriptionConfig_tags1(rName, address, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
 resource "aws_ce_anomaly_subscription" "test" {
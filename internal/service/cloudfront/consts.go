@@ -15,6 +15,12 @@ func (streamType) Values() []streamType {
 	}
 }
 
+const (
+	anycastIPListStatusDeleting  = "Deleting"
+	anycastIPListStatusDeployed  = "Deployed"
+	anycastIPListStatusDeploying = "Deploying"
+)
+
 const (
 	distributionStatusDeployed   = "Deployed"
 	distributionStatusInProgress = "InProgress"
@@ -24,3 +30,9 @@ const (
 	keyValueStoreStatusProvisioning = "PROVISIONING"
 	keyValueStoreStatusReady        = "READY"
 )
+
+const (
+	vpcOriginStatusDeleting  = "Deleting"
+	vpcOriginStatusDeployed  = "Deployed"
+	vpcOriginStatusDeploying = "Deploying"
+)
@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -60,6 +64,10 @@ func resourceDistribution() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"anycast_ip_list_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"caller_reference": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -196,6 +204,21 @@ func resourceDistribution() *schema.Resource {
 								},
 							},
 						},
+						"grpc_config": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressDisabledGRPCConfig,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:             schema.TypeBool,
+										Required:         true,
+										DiffSuppressFunc: suppressDisabledGRPCConfig,
+									},
+								},
+							},
+						},
 						"lambda_function_association": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -429,6 +452,21 @@ func resourceDistribution() *schema.Resource {
 								},
 							},
 						},
+						"grpc_config": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressDisabledGRPCConfig,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:             schema.TypeBool,
+										Required:         true,
+										DiffSuppressFunc: suppressDisabledGRPCConfig,
+									},
+								},
+							},
+						},
 						"lambda_function_association": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -673,6 +711,20 @@ func resourceDistribution() *schema.Resource {
 								},
 							},
 						},
+						"vpc_origin_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vpc_origin_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -836,7 +888,10 @@ func resourceDistribution() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateDistributionGRPCConfig,
+		),
 	}
 }
 
@@ -901,6 +956,7 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 			return sdkdiag.AppendErrorf(diags, "setting aliases: %s", err)
 		}
 	}
+	d.Set("anycast_ip_list_id", distributionConfig.AnycastIpListId)
 	d.Set(names.AttrARN, output.Distribution.ARN)
 	d.Set("caller_reference", distributionConfig.CallerReference)
 	if aws.ToString(distributionConfig.Comment) != "" {
@@ -1269,6 +1325,47 @@ func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id st
 	return nil, err
 }
 
+// validateDistributionGRPCConfig enforces the distribution-level requirements for gRPC:
+// HTTP/2 must be enabled and every gRPC-enabled cache behavior must allow all HTTP methods.
+func validateDistributionGRPCConfig(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var grpcBehaviors []map[string]interface{}
+
+	if v, ok := d.Get("default_cache_behavior").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		grpcBehaviors = append(grpcBehaviors, v[0].(map[string]interface{}))
+	}
+	if v, ok := d.Get("ordered_cache_behavior").([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				grpcBehaviors = append(grpcBehaviors, tfMap)
+			}
+		}
+	}
+
+	grpcBehaviors = tfslices.Filter(grpcBehaviors, func(tfMap map[string]interface{}) bool {
+		v, ok := tfMap["grpc_config"].([]interface{})
+		return ok && len(v) > 0 && v[0] != nil && v[0].(map[string]interface{})[names.AttrEnabled].(bool)
+	})
+
+	if len(grpcBehaviors) == 0 {
+		return nil
+	}
+
+	if v := awstypes.HttpVersion(d.Get("http_version").(string)); v != awstypes.HttpVersionHttp2 && v != awstypes.HttpVersionHttp2and3 {
+		return fmt.Errorf(`gRPC requires "http_version" to be %q or %q, got %q`, awstypes.HttpVersionHttp2, awstypes.HttpVersionHttp2and3, v)
+	}
+
+	for _, tfMap := range grpcBehaviors {
+		allowedMethods := flex.ExpandStringSet(tfMap["allowed_methods"].(*schema.Set))
+		for _, method := range enum.Values[awstypes.Method]() {
+			if !slices.ContainsFunc(allowedMethods, func(v *string) bool { return aws.ToString(v) == method }) {
+				return fmt.Errorf(`gRPC requires "allowed_methods" to include all HTTP methods, missing %q`, method)
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandDistributionConfig(d *schema.ResourceData) *awstypes.DistributionConfig {
 	apiObject := &awstypes.DistributionConfig{
 		CacheBehaviors:               expandCacheBehaviors(d.Get("ordered_cache_behavior").([]interface{})),
@@ -1293,6 +1390,10 @@ func expandDistributionConfig(d *schema.ResourceData) *awstypes.DistributionConf
 		apiObject.Aliases = expandAliases([]interface{}{})
 	}
 
+	if v, ok := d.GetOk("anycast_ip_list_id"); ok {
+		apiObject.AnycastIpListId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("caller_reference"); ok {
 		apiObject.CallerReference = aws.String(v.(string))
 	}
@@ -1355,6 +1456,15 @@ func expandCacheBehavior(tfMap map[string]interface{}) *awstypes.CacheBehavior {
 		apiObject.FunctionAssociations = expandFunctionAssociations(v.(*schema.Set).List())
 	}
 
+	if v, ok := tfMap["grpc_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GrpcConfig = expandGRPCConfig(v[0].(map[string]interface{}))
+	} else {
+		// Disable gRPC explicitly when the configuration is removed.
+		apiObject.GrpcConfig = &awstypes.GrpcConfig{
+			Enabled: aws.Bool(false),
+		}
+	}
+
 	if v, ok := tfMap["lambda_function_association"]; ok {
 		apiObject.LambdaFunctionAssociations = expandLambdaFunctionAssociations(v.(*schema.Set).List())
 	}
@@ -1443,6 +1553,10 @@ func flattenCacheBehavior(apiObject *awstypes.CacheBehavior) map[string]interfac
 		tfMap["function_association"] = flattenFunctionAssociations(apiObject.FunctionAssociations)
 	}
 
+	if apiObject.GrpcConfig != nil {
+		tfMap["grpc_config"] = []interface{}{flattenGRPCConfig(apiObject.GrpcConfig)}
+	}
+
 	if len(apiObject.LambdaFunctionAssociations.Items) > 0 {
 		tfMap["lambda_function_association"] = flattenLambdaFunctionAssociations(apiObject.LambdaFunctionAssociations)
 	}
@@ -1521,6 +1635,15 @@ func expandDefaultCacheBehavior(tfMap map[string]interface{}) *awstypes.DefaultC
 		apiObject.FunctionAssociations = expandFunctionAssociations(v.(*schema.Set).List())
 	}
 
+	if v, ok := tfMap["grpc_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GrpcConfig = expandGRPCConfig(v[0].(map[string]interface{}))
+	} else {
+		// Disable gRPC explicitly when the configuration is removed.
+		apiObject.GrpcConfig = &awstypes.GrpcConfig{
+			Enabled: aws.Bool(false),
+		}
+	}
+
 	if v, ok := tfMap["lambda_function_association"]; ok {
 		apiObject.LambdaFunctionAssociations = expandLambdaFunctionAssociations(v.(*schema.Set).List())
 	}
@@ -1585,6 +1708,10 @@ func flattenDefaultCacheBehavior(apiObject *awstypes.DefaultCacheBehavior) map[s
 		tfMap["function_association"] = flattenFunctionAssociations(apiObject.FunctionAssociations)
 	}
 
+	if apiObject.GrpcConfig != nil {
+		tfMap["grpc_config"] = []interface{}{flattenGRPCConfig(apiObject.GrpcConfig)}
+	}
+
 	if len(apiObject.LambdaFunctionAssociations.Items) > 0 {
 		tfMap["lambda_function_association"] = flattenLambdaFunctionAssociations(apiObject.LambdaFunctionAssociations)
 	}
@@ -1608,6 +1735,42 @@ func flattenDefaultCacheBehavior(apiObject *awstypes.DefaultCacheBehavior) map[s
 	return tfMap
 }
 
+// suppressDisabledGRPCConfig suppresses differences between a missing grpc_config block and a disabled one.
+func suppressDisabledGRPCConfig(k, old, new string, d *schema.ResourceData) bool {
+	i := strings.LastIndex(k, "grpc_config.")
+	if i < 0 {
+		return false
+	}
+
+	o, n := d.GetChange(k[:i] + "grpc_config.0." + names.AttrEnabled)
+
+	return !o.(bool) && !n.(bool)
+}
+
+func expandGRPCConfig(tfMap map[string]interface{}) *awstypes.GrpcConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.GrpcConfig{
+		Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+	}
+
+	return apiObject
+}
+
+func flattenGRPCConfig(apiObject *awstypes.GrpcConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: aws.ToBool(apiObject.Enabled),
+	}
+
+	return tfMap
+}
+
 func expandTrustedKeyGroups(tfList []interface{}) *awstypes.TrustedKeyGroups {
 	apiObject := &awstypes.TrustedKeyGroups{}
 
@@ -2042,9 +2205,15 @@ func expandOrigin(tfMap map[string]interface{}) *awstypes.Origin {
 		}
 	}
 
-	// if both custom and s3 origin are missing, add an empty s3 origin
-	// One or the other must be specified, but the S3 origin can be "empty"
-	if apiObject.S3OriginConfig == nil && apiObject.CustomOriginConfig == nil {
+	if v, ok := tfMap["vpc_origin_config"]; ok {
+		if v := v.([]interface{}); len(v) > 0 {
+			apiObject.VpcOriginConfig = expandVPCOriginConfig(v[0].(map[string]interface{}))
+		}
+	}
+
+	// if custom, s3 and VPC origin are all missing, add an empty s3 origin
+	// One of them must be specified, but the S3 origin can be "empty"
+	if apiObject.S3OriginConfig == nil && apiObject.CustomOriginConfig == nil && apiObject.VpcOriginConfig == nil {
 		apiObject.S3OriginConfig = &awstypes.S3OriginConfig{
 			OriginAccessIdentity: aws.String(""),
 		}
@@ -2094,6 +2263,10 @@ func flattenOrigin(apiObject *awstypes.Origin) map[string]interface{} {
 		tfMap["s3_origin_config"] = []interface{}{flattenS3OriginConfig(apiObject.S3OriginConfig)}
 	}
 
+	if apiObject.VpcOriginConfig != nil {
+		tfMap["vpc_origin_config"] = []interface{}{flattenVPCOriginConfig(apiObject.VpcOriginConfig)}
+	}
+
 	return tfMap
 }
 
@@ -2367,6 +2540,16 @@ func expandOriginShield(tfMap map[string]interface{}) *awstypes.OriginShield {
 	}
 }
 
+func expandVPCOriginConfig(tfMap map[string]interface{}) *awstypes.VpcOriginConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &awstypes.VpcOriginConfig{
+		VpcOriginId: aws.String(tfMap["vpc_origin_id"].(string)),
+	}
+}
+
 func flattenS3OriginConfig(apiObject *awstypes.S3OriginConfig) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -2377,6 +2560,16 @@ func flattenS3OriginConfig(apiObject *awstypes.S3OriginConfig) map[string]interf
 	}
 }
 
+func flattenVPCOriginConfig(apiObject *awstypes.VpcOriginConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"vpc_origin_id": aws.ToString(apiObject.VpcOriginId),
+	}
+}
+
 func flattenOriginShield(apiObject *awstypes.OriginShield) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -5,6 +5,7 @@ package cloudfront_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -655,6 +656,158 @@ func TestAccCloudFrontDistribution_Origin_originAccessControl(t *testing.T) {
 //
 // If you are testing manually and can't wait for deletion, set the
 // TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccCloudFrontDistribution_Origin_vpcOriginConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	vpcOriginResourceName := "aws_cloudfront_vpc_origin.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig_vpcOriginConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "origin.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.custom_origin_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.s3_origin_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.vpc_origin_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "origin.0.vpc_origin_config.0.vpc_origin_id", vpcOriginResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_on_delete",
+					"wait_for_deployment",
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistribution_grpcConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDistributionConfig_grpcConfig("http1.1", true),
+				ExpectError: regexache.MustCompile(`gRPC requires "http_version" to be`),
+			},
+			{
+				Config: testAccDistributionConfig_grpcConfig("http2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.grpc_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.grpc_config.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.grpc_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.grpc_config.0.enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_on_delete",
+					"wait_for_deployment",
+				},
+			},
+			{
+				Config: testAccDistributionConfig_grpcConfig("http2and3", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.grpc_config.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.grpc_config.0.enabled", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccDistributionConfig_grpcConfig("http2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.grpc_config.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.grpc_config.0.enabled", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDistributionConfig_grpcConfigRemoved(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					func(*terraform.State) error {
+						config := distribution.DistributionConfig
+						if v := config.DefaultCacheBehavior.GrpcConfig; v != nil && aws.ToBool(v.Enabled) {
+							return errors.New("default_cache_behavior gRPC still enabled")
+						}
+						if v := config.CacheBehaviors.Items[0].GrpcConfig; v != nil && aws.ToBool(v.Enabled) {
+							return errors.New("ordered_cache_behavior gRPC still enabled")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistribution_anycastIPListID(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	anycastIPListResourceName := "aws_cloudfront_anycast_ip_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig_anycastIPListID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttrPair(resourceName, "anycast_ip_list_id", anycastIPListResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_on_delete",
+					"wait_for_deployment",
+				},
+			},
+		},
+	})
+}
+
 func TestAccCloudFrontDistribution_noOptionalItems(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -4470,3 +4623,188 @@ resource "aws_cloudfront_distribution" "test" {
 }
 `, rName, testAccDistributionRetainConfig(), which))
 }
+
+func testAccDistributionConfig_vpcOriginConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_basic(rName, "http-only"), `
+resource "aws_cloudfront_distribution" "test" {
+  enabled = true
+
+  origin {
+    domain_name = aws_lb.test.dns_name
+    origin_id   = "myVPCOrigin"
+
+    vpc_origin_config {
+      vpc_origin_id = aws_cloudfront_vpc_origin.test.id
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myVPCOrigin"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`)
+}
+
+func testAccDistributionConfig_grpcConfig(httpVersion string, grpcEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  enabled      = true
+  http_version = %[1]q
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods        = ["DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myCustomOrigin"
+    viewer_protocol_policy = "https-only"
+    cache_policy_id        = "4135ea2d-6df8-44a3-9df3-4b5a84be39ad" # Managed-CachingDisabled
+
+    grpc_config {
+      enabled = %[2]t
+    }
+  }
+
+  ordered_cache_behavior {
+    path_pattern           = "/grpc/*"
+    allowed_methods        = ["DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myCustomOrigin"
+    viewer_protocol_policy = "https-only"
+    cache_policy_id        = "4135ea2d-6df8-44a3-9df3-4b5a84be39ad" # Managed-CachingDisabled
+
+    grpc_config {
+      enabled = %[2]t
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, httpVersion, grpcEnabled)
+}
+
+func testAccDistributionConfig_grpcConfigRemoved() string {
+	return `
+resource "aws_cloudfront_distribution" "test" {
+  enabled      = true
+  http_version = "http2"
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods        = ["DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myCustomOrigin"
+    viewer_protocol_policy = "https-only"
+    cache_policy_id        = "4135ea2d-6df8-44a3-9df3-4b5a84be39ad" # Managed-CachingDisabled
+  }
+
+  ordered_cache_behavior {
+    path_pattern           = "/grpc/*"
+    allowed_methods        = ["DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myCustomOrigin"
+    viewer_protocol_policy = "https-only"
+    cache_policy_id        = "4135ea2d-6df8-44a3-9df3-4b5a84be39ad" # Managed-CachingDisabled
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`
+}
+
+func testAccDistributionConfig_anycastIPListID(rName string) string {
+	return acctest.ConfigCompose(testAccAnycastIPListConfig_basic(rName), `
+resource "aws_cloudfront_distribution" "test" {
+  enabled            = true
+  anycast_ip_list_id = aws_cloudfront_anycast_ip_list.test.id
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myCustomOrigin"
+    viewer_protocol_policy = "allow-all"
+    cache_policy_id        = "4135ea2d-6df8-44a3-9df3-4b5a84be39ad" # Managed-CachingDisabled
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`)
+}
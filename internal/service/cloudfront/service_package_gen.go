@@ -25,6 +25,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAnycastIPListResource,
+			Name:    "Anycast IP List",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory: newContinuousDeploymentPolicyResource,
 			Name:    "Continuous Deployment Policy",
@@ -33,6 +40,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newKeyValueStoreResource,
 			Name:    "Key Value Store",
 		},
+		{
+			Factory: newStagingDistributionPromotionResource,
+			Name:    "Staging Distribution Promotion",
+		},
+		{
+			Factory: newVPCOriginResource,
+			Name:    "VPC Origin",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Staging Distribution Promotion")
+func newStagingDistributionPromotionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &stagingDistributionPromotionResource{}, nil
+}
+
+const (
+	stagingDistributionPromotionResourceIDPartCount = 2
+)
+
+type stagingDistributionPromotionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+}
+
+func (*stagingDistributionPromotionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_staging_distribution_promotion"
+}
+
+func (r *stagingDistributionPromotionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"distribution_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"staging_distribution_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTriggers: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *stagingDistributionPromotionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data stagingDistributionPromotionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	distributionID, stagingDistributionID := data.DistributionID.ValueString(), data.StagingDistributionID.ValueString()
+	_, err := promoteStagingDistribution(ctx, conn, distributionID, stagingDistributionID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("promoting CloudFront Staging Distribution (%s) to Distribution (%s)", stagingDistributionID, distributionID), err.Error())
+
+		return
+	}
+
+	output, err := waitDistributionDeployed(ctx, conn, distributionID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution (%s) deploy", distributionID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *stagingDistributionPromotionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data stagingDistributionPromotionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	distributionID := data.DistributionID.ValueString()
+	_, err := findDistributionByID(ctx, conn, distributionID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Distribution (%s)", distributionID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// promoteStagingDistribution copies the staging distribution's configuration to the primary distribution.
+// Both distributions must be deployed and the current ETag of each must be supplied.
+func promoteStagingDistribution(ctx context.Context, conn *cloudfront.Client, id, stagingID string) (*cloudfront.UpdateDistributionWithStagingConfigOutput, error) {
+	ifMatch := func() (string, error) {
+		primary, err := waitDistributionDeployed(ctx, conn, id)
+
+		if err != nil {
+			return "", fmt.Errorf("waiting for CloudFront Distribution (%s) deploy: %w", id, err)
+		}
+
+		staging, err := waitDistributionDeployed(ctx, conn, stagingID)
+
+		if err != nil {
+			return "", fmt.Errorf("waiting for CloudFront Distribution (%s) deploy: %w", stagingID, err)
+		}
+
+		return aws.ToString(primary.ETag) + ", " + aws.ToString(staging.ETag), nil
+	}
+
+	etags, err := ifMatch()
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(id),
+		IfMatch:               aws.String(etags),
+		StagingDistributionId: aws.String(stagingID),
+	}
+
+	output, err := conn.UpdateDistributionWithStagingConfig(ctx, input)
+
+	// Refresh our ETags if they are out of date and attempt the promotion again.
+	if errs.IsA[*awstypes.PreconditionFailed](err) || errs.IsA[*awstypes.InvalidIfMatchVersion](err) {
+		etags, err = ifMatch()
+
+		if err != nil {
+			return nil, err
+		}
+
+		input.IfMatch = aws.String(etags)
+
+		output, err = conn.UpdateDistributionWithStagingConfig(ctx, input)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type stagingDistributionPromotionResourceModel struct {
+	DistributionID        types.String                     `tfsdk:"distribution_id"`
+	ETag                  types.String                     `tfsdk:"etag"`
+	ID                    types.String                     `tfsdk:"id"`
+	StagingDistributionID types.String                     `tfsdk:"staging_distribution_id"`
+	Triggers              fwtypes.MapValueOf[types.String] `tfsdk:"triggers"`
+}
+
+func (data *stagingDistributionPromotionResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DistributionID.ValueString(), data.StagingDistributionID.ValueString()}, stagingDistributionPromotionResourceIDPartCount, false)))
+}
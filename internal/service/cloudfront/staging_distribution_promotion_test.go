@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontStagingDistributionPromotion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var productionDistribution awstypes.Distribution
+	resourceName := "aws_cloudfront_staging_distribution_promotion.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_init(defaultDomain),
+			},
+			{
+				Config: testAccStagingDistributionPromotionConfig_basic("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", productionDistributionResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_distribution_id", stagingDistributionResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "first"),
+				),
+			},
+			{
+				Config: testAccStagingDistributionPromotionConfig_basic("second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStagingDistributionPromotionConfig_basic(release string) string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_basic(), fmt.Sprintf(`
+resource "aws_cloudfront_staging_distribution_promotion" "test" {
+  distribution_id         = aws_cloudfront_distribution.test.id
+  staging_distribution_id = aws_cloudfront_distribution.staging.id
+
+  triggers = {
+    release = %[1]q
+  }
+}
+`, release))
+}
@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="VPC Origin")
+// @Tags(identifierAttribute="arn")
+func newVPCOriginResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &vpcOriginResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultUpdateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type vpcOriginResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*vpcOriginResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_vpc_origin"
+}
+
+func (r *vpcOriginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"vpc_origin_endpoint_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcOriginEndpointConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"http_port": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"https_port": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"origin_protocol_policy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OriginProtocolPolicy](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"origin_ssl_protocols": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[originSSLProtocolsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"items": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										Required:    true,
+										ElementType: types.StringType,
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.SslProtocol]()),
+										},
+									},
+									"quantity": schema.Int64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vpcOriginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vpcOriginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	input := &cloudfront.CreateVpcOriginInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		input.Tags = &awstypes.Tags{Items: tags}
+	}
+
+	output, err := conn.CreateVpcOrigin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudFront VPC Origin", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.VpcOrigin.Arn)
+	data.ID = fwflex.StringToFramework(ctx, output.VpcOrigin.Id)
+
+	outputGVO, err := waitVPCOriginDeployed(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront VPC Origin (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ETag = fwflex.StringToFramework(ctx, outputGVO.ETag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vpcOriginResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vpcOriginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	output, err := findVPCOriginByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront VPC Origin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.VpcOrigin, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vpcOriginResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new vpcOriginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	if !new.VPCOriginEndpointConfig.Equal(old.VPCOriginEndpointConfig) {
+		input := &cloudfront.UpdateVpcOriginInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Id = aws.String(new.ID.ValueString())
+		// Use state ETag value. The planned value will be unknown.
+		input.IfMatch = aws.String(old.ETag.ValueString())
+
+		_, err := conn.UpdateVpcOrigin(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront VPC Origin (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitVPCOriginDeployed(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront VPC Origin (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.ETag = fwflex.StringToFramework(ctx, output.ETag)
+	} else {
+		new.ETag = old.ETag
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *vpcOriginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vpcOriginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	id := data.ID.ValueString()
+	input := &cloudfront.DeleteVpcOriginInput{
+		Id:      aws.String(id),
+		IfMatch: fwflex.StringFromFramework(ctx, data.ETag),
+	}
+
+	_, err := conn.DeleteVpcOrigin(ctx, input)
+
+	if errs.IsA[*awstypes.PreconditionFailed](err) || errs.IsA[*awstypes.InvalidIfMatchVersion](err) {
+		var output *cloudfront.GetVpcOriginOutput
+		output, err = findVPCOriginByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return
+		}
+
+		if err == nil {
+			input.IfMatch = output.ETag
+
+			_, err = conn.DeleteVpcOrigin(ctx, input)
+		}
+	}
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront VPC Origin (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitVPCOriginDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront VPC Origin (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *vpcOriginResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findVPCOriginByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetVpcOriginOutput, error) {
+	input := &cloudfront.GetVpcOriginInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetVpcOrigin(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VpcOrigin == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusVPCOrigin(ctx context.Context, conn *cloudfront.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findVPCOriginByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.VpcOrigin.Status), nil
+	}
+}
+
+func waitVPCOriginDeployed(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetVpcOriginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{vpcOriginStatusDeploying},
+		Target:  []string{vpcOriginStatusDeployed},
+		Refresh: statusVPCOrigin(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetVpcOriginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCOriginDeleted(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetVpcOriginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{vpcOriginStatusDeleting, vpcOriginStatusDeployed},
+		Target:  []string{},
+		Refresh: statusVPCOrigin(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetVpcOriginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type vpcOriginResourceModel struct {
+	ARN                     types.String                                                  `tfsdk:"arn"`
+	ETag                    types.String                                                  `tfsdk:"etag"`
+	ID                      types.String                                                  `tfsdk:"id"`
+	Tags                    tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                                `tfsdk:"timeouts"`
+	VPCOriginEndpointConfig fwtypes.ListNestedObjectValueOf[vpcOriginEndpointConfigModel] `tfsdk:"vpc_origin_endpoint_config"`
+}
+
+type vpcOriginEndpointConfigModel struct {
+	ARN                  fwtypes.ARN                                              `tfsdk:"arn"`
+	HTTPPort             types.Int64                                              `tfsdk:"http_port"`
+	HTTPSPort            types.Int64                                              `tfsdk:"https_port"`
+	Name                 types.String                                             `tfsdk:"name"`
+	OriginProtocolPolicy fwtypes.StringEnum[awstypes.OriginProtocolPolicy]        `tfsdk:"origin_protocol_policy"`
+	OriginSSLProtocols   fwtypes.ListNestedObjectValueOf[originSSLProtocolsModel] `tfsdk:"origin_ssl_protocols"`
+}
+
+type originSSLProtocolsModel struct {
+	Items    fwtypes.SetValueOf[types.String] `tfsdk:"items"`
+	Quantity types.Int64                      `tfsdk:"quantity"`
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontVPCOrigin_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudfront.GetVpcOriginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_vpc_origin.test"
+	lbResourceName := "aws_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginConfig_basic(rName, "https-only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_origin_endpoint_config.0.arn", lbResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.http_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.https_port", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.origin_protocol_policy", "https-only"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.origin_ssl_protocols.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.origin_ssl_protocols.0.items.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "vpc_origin_endpoint_config.0.origin_ssl_protocols.0.items.*", "TLSv1.2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.origin_ssl_protocols.0.quantity", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCOriginConfig_basic(rName, "match-viewer"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vpc_origin_endpoint_config.0.origin_protocol_policy", "match-viewer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontVPCOrigin_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudfront.GetVpcOriginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_vpc_origin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginConfig_basic(rName, "https-only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcloudfront.ResourceVPCOrigin, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontVPCOrigin_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudfront.GetVpcOriginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_vpc_origin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCOriginConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccVPCOriginConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCOriginDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_vpc_origin" {
+				continue
+			}
+
+			_, err := tfcloudfront.FindVPCOriginByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudFront VPC Origin %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCOriginExists(ctx context.Context, n string, v *cloudfront.GetVpcOriginOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		output, err := tfcloudfront.FindVPCOriginByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVPCOriginConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "application"
+  subnets            = aws_subnet.test[*].id
+
+  depends_on = [aws_internet_gateway.test]
+}
+`, rName))
+}
+
+func testAccVPCOriginConfig_basic(rName, originProtocolPolicy string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudfront_vpc_origin" "test" {
+  vpc_origin_endpoint_config {
+    name                   = %[1]q
+    arn                    = aws_lb.test.arn
+    http_port              = 8080
+    https_port             = 8443
+    origin_protocol_policy = %[2]q
+
+    origin_ssl_protocols {
+      items    = ["TLSv1.2"]
+      quantity = 1
+    }
+  }
+}
+`, rName, originProtocolPolicy))
+}
+
+func testAccVPCOriginConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudfront_vpc_origin" "test" {
+  vpc_origin_endpoint_config {
+    name                   = %[1]q
+    arn                    = aws_lb.test.arn
+    http_port              = 8080
+    https_port             = 8443
+    origin_protocol_policy = "https-only"
+
+    origin_ssl_protocols {
+      items    = ["TLSv1.2"]
+      quantity = 1
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccVPCOriginConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudfront_vpc_origin" "test" {
+  vpc_origin_endpoint_config {
+    name                   = %[1]q
+    arn                    = aws_lb.test.arn
+    http_port              = 8080
+    https_port             = 8443
+    origin_protocol_policy = "https-only"
+
+    origin_ssl_protocols {
+      items    = ["TLSv1.2"]
+      quantity = 1
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -286,6 +286,16 @@ func findKeyByTwoPartKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Clie
 }
 
 func findETagByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) (*string, error) {
+	output, err := findKeyValueStoreByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.ETag, nil
+}
+
+func findKeyValueStoreByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) (*cloudfrontkeyvaluestore.DescribeKeyValueStoreOutput, error) {
 	input := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
 		KvsARN: aws.String(arn),
 	}
@@ -307,7 +317,7 @@ func findETagByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, ar
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.ETag, nil
+	return output, nil
 }
 
 type keyResourceModel struct {
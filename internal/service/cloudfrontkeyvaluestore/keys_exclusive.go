@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// The UpdateKeys API accepts at most 50 puts and deletes per request.
+	keysExclusiveMaxBatchSize = 50
+)
+
+// @FrameworkResource(name="Keys Exclusive")
+func newKeysExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &keysExclusiveResource{}
+
+	return r, nil
+}
+
+type keysExclusiveResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*keysExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfrontkeyvaluestore_keys_exclusive"
+}
+
+func (r *keysExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"key_value_store_arn": schema.StringAttribute{
+				CustomType:          fwtypes.ARNType,
+				Required:            true,
+				MarkdownDescription: "The Amazon Resource Name (ARN) of the Key Value Store.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_batch_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(keysExclusiveMaxBatchSize),
+				MarkdownDescription: "Maximum resource key values pairs that will update in a single API request.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, keysExclusiveMaxBatchSize),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total size of the Key Value Store in bytes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_key_value_pair": schema.SetNestedBlock{
+				CustomType:          fwtypes.NewSetNestedObjectTypeOf[resourceKeyValuePairModel](ctx),
+				MarkdownDescription: "A list of all resource key value pairs associated with the Key Value Store.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The key to put.",
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The value to put.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *keysExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+
+	// Changing keys changes the etag of the key value store.
+	// Use a mutex serialize actions
+	mutexKey := kvsARN
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	totalSizeInBytes, diags := r.syncKeys(ctx, conn, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(kvsARN)
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, totalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *keysExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.ID.ValueString()
+	output, err := findKeyValueStoreByARN(ctx, conn, kvsARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s)", kvsARN), err.Error())
+
+		return
+	}
+
+	keys, err := findKeysByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+		return
+	}
+
+	if len(keys) == 0 {
+		data.ResourceKeyValuePair = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, []resourceKeyValuePairModel{})
+	} else {
+		response.Diagnostics.Append(fwflex.Flatten(ctx, keys, &data.ResourceKeyValuePair)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set attributes for import.
+	data.KvsARN = fwtypes.ARNValue(kvsARN)
+	if data.MaxBatchSize.IsNull() {
+		data.MaxBatchSize = types.Int64Value(keysExclusiveMaxBatchSize)
+	}
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *keysExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	if !new.ResourceKeyValuePair.Equal(old.ResourceKeyValuePair) {
+		kvsARN := new.KvsARN.ValueString()
+
+		// Changing keys changes the etag of the key value store.
+		// Use a mutex serialize actions
+		mutexKey := kvsARN
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		totalSizeInBytes, diags := r.syncKeys(ctx, conn, &new)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Set values for unknowns.
+		new.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, totalSizeInBytes)
+	} else {
+		new.TotalSizeInBytes = old.TotalSizeInBytes
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *keysExclusiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+
+	// Deleting keys changes the etag of the key value store.
+	// Use a mutex serialize actions
+	mutexKey := kvsARN
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	pairs, diags := data.ResourceKeyValuePair.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var deletes []awstypes.DeleteKeyRequestListItem
+	for _, v := range pairs {
+		deletes = append(deletes, awstypes.DeleteKeyRequestListItem{
+			Key: fwflex.StringFromFramework(ctx, v.Key),
+		})
+	}
+
+	_, err := updateKeys(ctx, conn, kvsARN, nil, deletes, int(data.MaxBatchSize.ValueInt64()))
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+		return
+	}
+}
+
+func (r *keysExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key_value_store_arn"), request, response)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+}
+
+// syncKeys puts every configured key value pair that differs from the Key Value Store's current contents
+// and deletes every key that is not configured, returning the resulting total size of the Key Value Store.
+func (r *keysExclusiveResource) syncKeys(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, data *keysExclusiveResourceModel) (*int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	kvsARN := data.KvsARN.ValueString()
+
+	pairs, d := data.ResourceKeyValuePair.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	existing, err := findKeysByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+		return nil, diags
+	}
+
+	have := make(map[string]string, len(existing))
+	for _, v := range existing {
+		have[aws.ToString(v.Key)] = aws.ToString(v.Value)
+	}
+
+	want := make(map[string]string, len(pairs))
+	for _, v := range pairs {
+		want[v.Key.ValueString()] = v.Value.ValueString()
+	}
+
+	var puts []awstypes.PutKeyRequestListItem
+	for k, v := range want {
+		if old, ok := have[k]; !ok || old != v {
+			puts = append(puts, awstypes.PutKeyRequestListItem{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+	}
+
+	var deletes []awstypes.DeleteKeyRequestListItem
+	for k := range have {
+		if _, ok := want[k]; !ok {
+			deletes = append(deletes, awstypes.DeleteKeyRequestListItem{
+				Key: aws.String(k),
+			})
+		}
+	}
+
+	totalSizeInBytes, err := updateKeys(ctx, conn, kvsARN, puts, deletes, int(data.MaxBatchSize.ValueInt64()))
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("updating CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+		return nil, diags
+	}
+
+	return totalSizeInBytes, diags
+}
+
+// updateKeys applies puts and deletes in batches of at most batchSize items.
+// Each request is conditioned on the Key Value Store ETag returned by the previous request.
+func updateKeys(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN string, puts []awstypes.PutKeyRequestListItem, deletes []awstypes.DeleteKeyRequestListItem, batchSize int) (*int64, error) {
+	output, err := findKeyValueStoreByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	etag, totalSizeInBytes := output.ETag, output.TotalSizeInBytes
+
+	update := func(input *cloudfrontkeyvaluestore.UpdateKeysInput) error {
+		input.IfMatch = etag
+		input.KvsARN = aws.String(kvsARN)
+
+		output, err := conn.UpdateKeys(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		etag, totalSizeInBytes = output.ETag, output.TotalSizeInBytes
+
+		return nil
+	}
+
+	for chunk := range slices.Chunk(puts, batchSize) {
+		if err := update(&cloudfrontkeyvaluestore.UpdateKeysInput{Puts: chunk}); err != nil {
+			return nil, err
+		}
+	}
+
+	for chunk := range slices.Chunk(deletes, batchSize) {
+		if err := update(&cloudfrontkeyvaluestore.UpdateKeysInput{Deletes: chunk}); err != nil {
+			return nil, err
+		}
+	}
+
+	return totalSizeInBytes, nil
+}
+
+func findKeysByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN string) ([]awstypes.ListKeysResponseListItem, error) {
+	input := &cloudfrontkeyvaluestore.ListKeysInput{
+		KvsARN: aws.String(kvsARN),
+	}
+	var output []awstypes.ListKeysResponseListItem
+
+	pages := cloudfrontkeyvaluestore.NewListKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type keysExclusiveResourceModel struct {
+	ID                   types.String                                              `tfsdk:"id"`
+	KvsARN               fwtypes.ARN                                               `tfsdk:"key_value_store_arn"`
+	MaxBatchSize         types.Int64                                               `tfsdk:"max_batch_size"`
+	ResourceKeyValuePair fwtypes.SetNestedObjectValueOf[resourceKeyValuePairModel] `tfsdk:"resource_key_value_pair"`
+	TotalSizeInBytes     types.Int64                                               `tfsdk:"total_size_in_bytes"`
+}
+
+type resourceKeyValuePairModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
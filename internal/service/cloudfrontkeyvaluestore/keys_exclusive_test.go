@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfrontkeyvaluestore "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfrontkeyvaluestore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, map[string]string{
+					"key1": acctest.CtValue1,
+					"key2": acctest.CtValue2,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeysExclusiveExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "key_value_store_arn", "aws_cloudfront_key_value_store.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						names.AttrKey:   "key1",
+						names.AttrValue: acctest.CtValue1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						names.AttrKey:   "key2",
+						names.AttrValue: acctest.CtValue2,
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "total_size_in_bytes"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, map[string]string{
+					"key1": acctest.CtValue1Updated,
+					"key3": "value3",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeysExclusiveExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						names.AttrKey:   "key1",
+						names.AttrValue: acctest.CtValue1Updated,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						names.AttrKey:   "key3",
+						names.AttrValue: "value3",
+					}),
+				),
+			},
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, map[string]string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeysExclusiveExists(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_outOfBandKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+	keys := map[string]string{
+		"key1": acctest.CtValue1,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, keys),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeysExclusiveExists(ctx, resourceName, 1),
+					testAccCheckKeysExclusivePutKey(ctx, resourceName, "key2", acctest.CtValue2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, keys),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeysExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_maxBatchSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_maxBatchSize(rName, 2, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeysExclusiveExists(ctx, resourceName, 7),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_size", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", "7"),
+				),
+			},
+			{
+				Config: testAccKeysExclusiveConfig_maxBatchSize(rName, 2, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeysExclusiveExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeysExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfrontkeyvaluestore_keys_exclusive" {
+				continue
+			}
+
+			output, err := tfcloudfrontkeyvaluestore.FindKeysByARN(ctx, conn, rs.Primary.Attributes["key_value_store_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("CloudFront KeyValueStore %s still has %d keys", rs.Primary.ID, len(output))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeysExclusiveExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		output, err := tfcloudfrontkeyvaluestore.FindKeysByARN(ctx, conn, rs.Primary.Attributes["key_value_store_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("CloudFront KeyValueStore %s has %d keys, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeysExclusivePutKey(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+		kvsARN := rs.Primary.Attributes["key_value_store_arn"]
+
+		output, err := conn.DescribeKeyValueStore(ctx, &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+			KvsARN: aws.String(kvsARN),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.PutKey(ctx, &cloudfrontkeyvaluestore.PutKeyInput{
+			IfMatch: output.ETag,
+			Key:     aws.String(key),
+			KvsARN:  aws.String(kvsARN),
+			Value:   aws.String(value),
+		})
+
+		return err
+	}
+}
+
+func testAccKeysExclusiveConfig_basic(rName string, keys map[string]string) string {
+	var pairs strings.Builder
+	for k, v := range keys {
+		fmt.Fprintf(&pairs, `
+  resource_key_value_pair {
+    key   = %[1]q
+    value = %[2]q
+  }
+`, k, v)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfrontkeyvaluestore_keys_exclusive" "test" {
+  key_value_store_arn = aws_cloudfront_key_value_store.test.arn
+%[2]s}
+`, rName, pairs.String())
+}
+
+func testAccKeysExclusiveConfig_maxBatchSize(rName string, maxBatchSize, keyCount int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfrontkeyvaluestore_keys_exclusive" "test" {
+  key_value_store_arn = aws_cloudfront_key_value_store.test.arn
+  max_batch_size      = %[2]d
+
+  dynamic "resource_key_value_pair" {
+    for_each = range(%[3]d)
+
+    content {
+      key   = "key${resource_key_value_pair.value}"
+      value = "value${resource_key_value_pair.value}"
+    }
+  }
+}
+`, rName, maxBatchSize, keyCount)
+}
@@ -129,6 +129,69 @@ func TestAccCloudTrailEventDataStore_kmsKeyId(t *testing.T) {
 	})
 }
 
+func TestAccCloudTrailEventDataStore_federation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_event_data_store.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDataStoreConfig_federation(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventDataStoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "federation_role_arn", roleResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventDataStoreConfig_federation(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventDataStoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailEventDataStore_federationDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_event_data_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEventDataStoreConfig_federationNoRole(rName),
+				ExpectError: regexache.MustCompile(`"federation_role_arn" is required when "federation_enabled" is true`),
+			},
+			// The event data store is destroyed with federation enabled.
+			{
+				Config: testAccEventDataStoreConfig_federation(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventDataStoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudTrailEventDataStore_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -432,6 +495,67 @@ resource "aws_cloudtrail_event_data_store" "test" {
 `, rName)
 }
 
+func testAccEventDataStoreConfig_federation(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudtrail.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "glue:*",
+        "lakeformation:*",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  federation_enabled  = %[2]t
+  federation_role_arn = aws_iam_role.test.arn
+
+  termination_protection_enabled = false # For ease of deletion.
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, enabled)
+}
+
+func testAccEventDataStoreConfig_federationNoRole(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  federation_enabled = true
+
+  termination_protection_enabled = false # For ease of deletion.
+}
+`, rName)
+}
+
 func testAccEventDataStoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudtrail_event_data_store" "test" {
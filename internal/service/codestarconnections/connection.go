@@ -5,7 +5,9 @@ package codestarconnections
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codestarconnections"
@@ -36,6 +38,11 @@ func resourceConnection() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -67,6 +74,11 @@ func resourceConnection() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_available": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -99,6 +111,12 @@ func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.ConnectionArn))
 
+	if d.Get("wait_for_available").(bool) {
+		if _, err := waitConnectionAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CodeStar Connections Connection (%s) create: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceConnectionRead(ctx, d, meta)...)
 }
 
@@ -131,8 +149,13 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta in
 
 func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
 
-	// Tags only.
+	if d.HasChange("wait_for_available") && d.Get("wait_for_available").(bool) {
+		if _, err := waitConnectionAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CodeStar Connections Connection (%s) update: %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceConnectionRead(ctx, d, meta)...)
 }
@@ -181,3 +204,41 @@ func findConnectionByARN(ctx context.Context, conn *codestarconnections.Client,
 
 	return output.Connection, nil
 }
+
+func statusConnection(ctx context.Context, conn *codestarconnections.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConnectionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ConnectionStatus), nil
+	}
+}
+
+func waitConnectionAvailable(ctx context.Context, conn *codestarconnections.Client, arn string, timeout time.Duration) (*types.Connection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ConnectionStatusPending),
+		Target:  enum.Slice(types.ConnectionStatusAvailable),
+		Refresh: statusConnection(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Connection); ok {
+		if output.ConnectionStatus == types.ConnectionStatusPending {
+			// A pending connection only becomes available once the authentication handshake with the provider is completed.
+			tfresource.SetLastError(err, errors.New("the connection must be completed in the AWS Developer Tools console; see https://docs.aws.amazon.com/dtconsole/latest/userguide/connections-update.html"))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
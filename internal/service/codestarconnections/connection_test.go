@@ -47,9 +47,10 @@ func TestAccCodeStarConnectionsConnection_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_available"},
 			},
 		},
 	})
@@ -83,9 +84,10 @@ func TestAccCodeStarConnectionsConnection_hostARN(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_available"},
 			},
 		},
 	})
@@ -142,9 +144,10 @@ func TestAccCodeStarConnectionsConnection_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_available"},
 			},
 			{
 				Config: testAccConnectionConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -167,6 +170,28 @@ func TestAccCodeStarConnectionsConnection_tags(t *testing.T) {
 	})
 }
 
+func TestAccCodeStarConnectionsConnection_waitForAvailable(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The provider handshake cannot be completed in an acceptance test.
+				Config:      testAccConnectionConfig_waitForAvailable(rName),
+				ExpectError: regexache.MustCompile(`must be completed in the AWS Developer Tools console`),
+			},
+		},
+	})
+}
+
 func testAccCheckConnectionExists(ctx context.Context, n string, v *types.Connection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -223,6 +248,20 @@ resource "aws_codestarconnections_connection" "test" {
 `, rName)
 }
 
+func testAccConnectionConfig_waitForAvailable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name               = %[1]q
+  provider_type      = "Bitbucket"
+  wait_for_available = true
+
+  timeouts {
+    create = "1m"
+  }
+}
+`, rName)
+}
+
 func testAccConnectionConfig_hostARN(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_codestarconnections_host" "test" {
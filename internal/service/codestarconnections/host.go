@@ -5,6 +5,7 @@ package codestarconnections
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -278,16 +279,17 @@ func statusHost(ctx context.Context, conn *codestarconnections.Client, arn strin
 }
 
 const (
-	hostStatusAvailable = "AVAILABLE"
-	hostStatusPending   = "PENDING"
-	// hostStatusVPCConfigDeleting             = "VPC_CONFIG_DELETING"
-	// hostStatusVPCConfigFailedInitialization = "VPC_CONFIG_FAILED_INITIALIZATION"
-	hostStatusVPCConfigInitializing = "VPC_CONFIG_INITIALIZING"
+	hostStatusAvailable                     = "AVAILABLE"
+	hostStatusPending                       = "PENDING"
+	hostStatusVPCConfigDeleting             = "VPC_CONFIG_DELETING"
+	hostStatusVPCConfigFailedInitialization = "VPC_CONFIG_FAILED_INITIALIZATION"
+	hostStatusVPCConfigInitializing         = "VPC_CONFIG_INITIALIZING"
 )
 
 func waitHostPendingOrAvailable(ctx context.Context, conn *codestarconnections.Client, arn string, timeout time.Duration) (*codestarconnections.GetHostOutput, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{hostStatusVPCConfigInitializing},
+		// Updating the VPC configuration of a host deletes and re-initializes the existing configuration.
+		Pending: []string{hostStatusVPCConfigDeleting, hostStatusVPCConfigInitializing},
 		Target:  []string{hostStatusAvailable, hostStatusPending},
 		Refresh: statusHost(ctx, conn, arn),
 		Timeout: timeout,
@@ -296,6 +298,10 @@ func waitHostPendingOrAvailable(ctx context.Context, conn *codestarconnections.C
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*codestarconnections.GetHostOutput); ok {
+		if aws.ToString(output.Status) == hostStatusVPCConfigFailedInitialization {
+			tfresource.SetLastError(err, errors.New("VPC configuration failed to initialize, verify the subnets, security groups and TLS certificate can reach the provider endpoint"))
+		}
+
 		return output, err
 	}
 
@@ -119,6 +119,16 @@ func TestAccCodeStarConnectionsHost_vpc(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccHostConfig_vpcUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.security_group_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.subnet_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.tls_certificate", ""),
+				),
+			},
 		},
 	})
 }
@@ -242,3 +252,18 @@ resource "aws_codestarconnections_host" "test" {
 }
 `, rName))
 }
+
+func testAccHostConfig_vpcUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccHostVPCBaseConfig(rName), fmt.Sprintf(`
+resource "aws_codestarconnections_host" "test" {
+  name              = %[1]q
+  provider_endpoint = "https://example.com"
+  provider_type     = "GitHubEnterpriseServer"
+  vpc_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = [aws_subnet.test[0].id]
+    vpc_id             = aws_vpc.test.id
+  }
+}
+`, rName))
+}
@@ -35,6 +35,7 @@ func TestAccConfigService_serial(t *testing.T) {
 			acctest.CtBasic:      testAccConfigurationRecorder_basic,
 			"allParams":          testAccConfigurationRecorder_allParams,
 			"recordStrategy":     testAccConfigurationRecorder_recordStrategy,
+			"recordingMode":      testAccConfigurationRecorder_recordingMode,
 			acctest.CtDisappears: testAccConfigurationRecorder_disappears,
 		},
 		"ConformancePack": {
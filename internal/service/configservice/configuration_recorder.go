@@ -63,6 +63,7 @@ func resourceConfigurationRecorder() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"resource_types": {
@@ -81,6 +82,7 @@ func resourceConfigurationRecorder() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"use_only": {
@@ -277,7 +279,7 @@ func resourceConfigurationRecorderCustomizeDiff(_ context.Context, diff *schema.
 									return errors.New(` Invalid record group strategy ,  use only must be set to INCLUSION_BY_RESOURCE_TYPES`)
 								}
 
-								if m, ok := tfMap["exclusion_by_resource_types"]; ok && len(m.([]interface{})) > 0 && i.([]interface{})[0] != nil {
+								if m, ok := tfMap["exclusion_by_resource_types"]; ok && len(m.([]interface{})) > 0 && m.([]interface{})[0] != nil {
 									return errors.New(` Invalid record group , exclusion_by_resource_types must not be set when resource_types is set `)
 								}
 							}
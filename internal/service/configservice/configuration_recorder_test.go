@@ -134,6 +134,48 @@ func testAccConfigurationRecorder_recordStrategy(t *testing.T) {
 	})
 }
 
+func testAccConfigurationRecorder_recordingMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cr types.ConfigurationRecorder
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_configuration_recorder.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationRecorderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationRecorderConfig_recordingMode(rName, "CONTINUOUS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.recording_strategy.0.use_only", "EXCLUSION_BY_RESOURCE_TYPES"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_frequency", "CONTINUOUS"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.0.description", "High-churn resource types"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.0.recording_frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.0.resource_types.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationRecorderConfig_recordingMode(rName, "DAILY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckConfigurationRecorderExists(ctx context.Context, n string, v *types.ConfigurationRecorder) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -405,3 +447,55 @@ resource "aws_config_delivery_channel" "test" {
 }
 `, rName)
 }
+
+func testAccConfigurationRecorderConfig_recordingMode(rName, frequency string) string {
+	return fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  recording_group {
+    all_supported                 = false
+    include_global_resource_types = false
+
+    exclusion_by_resource_types {
+      resource_types = ["AWS::CloudTrail::Trail"]
+    }
+
+    recording_strategy {
+      use_only = "EXCLUSION_BY_RESOURCE_TYPES"
+    }
+  }
+
+  recording_mode {
+    recording_frequency = %[2]q
+
+    recording_mode_override {
+      description         = "High-churn resource types"
+      resource_types      = ["AWS::EC2::NetworkInterface", "AWS::EC2::Volume"]
+      recording_frequency = "DAILY"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+`, rName, frequency)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -56,6 +57,12 @@ func resourceLag() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validConnectionBandWidth(),
 			},
+			"encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"no_encrypt", "should_encrypt", "must_encrypt"}, false),
+			},
 			names.AttrForceDestroy: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -74,6 +81,10 @@ func resourceLag() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"macsec_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -88,6 +99,12 @@ func resourceLag() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"request_macsec": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -105,6 +122,7 @@ func resourceLagCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		ConnectionsBandwidth: aws.String(d.Get("connections_bandwidth").(string)),
 		LagName:              aws.String(name),
 		Location:             aws.String(d.Get(names.AttrLocation).(string)),
+		RequestMACSec:        aws.Bool(d.Get("request_macsec").(bool)),
 		Tags:                 getTagsIn(ctx),
 	}
 
@@ -136,6 +154,20 @@ func resourceLagCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	// Encryption mode can only be set via UpdateLag.
+	if v, ok := d.GetOk("encryption_mode"); ok && v.(string) != aws.ToString(output.EncryptionMode) {
+		input := &directconnect.UpdateLagInput{
+			EncryptionMode: aws.String(v.(string)),
+			LagId:          aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateLag(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Direct Connect LAG (%s) encryption mode: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceLagRead(ctx, d, meta)...)
 }
 
@@ -164,9 +196,11 @@ func resourceLagRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}.String()
 	d.Set(names.AttrARN, arn)
 	d.Set("connections_bandwidth", lag.ConnectionsBandwidth)
+	d.Set("encryption_mode", lag.EncryptionMode)
 	d.Set("has_logical_redundancy", lag.HasLogicalRedundancy)
 	d.Set("jumbo_frame_capable", lag.JumboFrameCapable)
 	d.Set(names.AttrLocation, lag.Location)
+	d.Set("macsec_capable", lag.MacSecCapable)
 	d.Set(names.AttrName, lag.LagName)
 	d.Set(names.AttrOwnerAccountID, lag.OwnerAccount)
 	d.Set(names.AttrProviderName, lag.ProviderName)
@@ -178,10 +212,17 @@ func resourceLagUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DirectConnectClient(ctx)
 
-	if d.HasChange(names.AttrName) {
+	if d.HasChanges("encryption_mode", names.AttrName) {
 		input := &directconnect.UpdateLagInput{
-			LagId:   aws.String(d.Id()),
-			LagName: aws.String(d.Get(names.AttrName).(string)),
+			LagId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("encryption_mode") {
+			input.EncryptionMode = aws.String(d.Get("encryption_mode").(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.LagName = aws.String(d.Get(names.AttrName).(string))
 		}
 
 		_, err := conn.UpdateLag(ctx, input)
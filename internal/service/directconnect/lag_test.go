@@ -180,6 +180,40 @@ func TestAccDirectConnectLag_providerName(t *testing.T) {
 	})
 }
 
+func TestAccDirectConnectLag_macsecRequested(t *testing.T) {
+	ctx := acctest.Context(t)
+	var lag awstypes.Lag
+	resourceName := "aws_dx_lag.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLagConfig_macsecRequested(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLagExists(ctx, resourceName, &lag),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "directconnect", regexache.MustCompile(`dxlag/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "connections_bandwidth", "100Gbps"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_mode", "should_encrypt"),
+					resource.TestCheckResourceAttrSet(resourceName, "macsec_capable"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "request_macsec", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "request_macsec"},
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectLag_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var lag awstypes.Lag
@@ -326,6 +360,25 @@ resource "aws_dx_lag" "test" {
 `, rName)
 }
 
+func testAccLagConfig_macsecRequested(rName string) string {
+	return fmt.Sprintf(`
+data "aws_dx_locations" "test" {}
+
+locals {
+  location_codes = tolist(data.aws_dx_locations.test.location_codes)
+  idx            = min(2, length(local.location_codes) - 1)
+}
+
+resource "aws_dx_lag" "test" {
+  name                  = %[1]q
+  connections_bandwidth = "100Gbps"
+  location              = local.location_codes[local.idx]
+  request_macsec        = true
+  encryption_mode       = "should_encrypt"
+}
+`, rName)
+}
+
 func testAccLagConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 data "aws_dx_locations" "test" {}
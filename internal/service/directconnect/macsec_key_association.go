@@ -40,6 +40,7 @@ func resourceMacSecKeyAssociation() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
+				Sensitive:    true,
 				RequiredWith: []string{"ckn"},
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
 			},
@@ -131,6 +131,9 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 			},
 			"shard_capacity": schema.Int64Attribute{
 				Required: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(2, 4, 8, 16, 32, 64),
+				},
 			},
 			"shard_count": schema.Int64Attribute{
 				Required: true,
@@ -138,6 +141,16 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					int64validator.Between(1, 32),
 				},
 			},
+			"shard_instance_count": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 16),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrSubnetIDs: schema.SetAttribute{
 				CustomType: fwtypes.SetOfStringType,
 				Optional:   true,
@@ -318,6 +331,10 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			input.ShardCount = fwflex.Int32FromFramework(ctx, plan.ShardCount)
 		}
 
+		if !plan.ShardInstanceCount.Equal(state.ShardInstanceCount) {
+			input.ShardInstanceCount = plan.ShardInstanceCount.ValueInt32Pointer()
+		}
+
 		if !plan.SubnetIds.Equal(state.SubnetIds) {
 			input.SubnetIds = fwflex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
 		}
@@ -421,6 +438,7 @@ type resourceClusterData struct {
 	PreferredMaintenanceWindow fwtypes.OnceAWeekWindow           `tfsdk:"preferred_maintenance_window"`
 	ShardCapacity              types.Int64                       `tfsdk:"shard_capacity"`
 	ShardCount                 types.Int64                       `tfsdk:"shard_count"`
+	ShardInstanceCount         types.Int32                       `tfsdk:"shard_instance_count"`
 	SubnetIds                  fwtypes.SetValueOf[types.String]  `tfsdk:"subnet_ids"`
 	Tags                       tftags.Map                        `tfsdk:"tags"`
 	TagsAll                    tftags.Map                        `tfsdk:"tags_all"`
@@ -528,6 +546,7 @@ func clusterHasChanges(_ context.Context, plan, state resourceClusterData) bool
 		!plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) ||
 		!plan.ShardCapacity.Equal(state.ShardCapacity) ||
 		!plan.ShardCount.Equal(state.ShardCount) ||
+		!plan.ShardInstanceCount.Equal(state.ShardInstanceCount) ||
 		!plan.SubnetIds.Equal(state.SubnetIds) ||
 		!plan.VpcSecurityGroupIds.Equal(state.VpcSecurityGroupIds)
 }
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Cluster")
+func newDataSourceCluster(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCluster{}, nil
+}
+
+const (
+	DSNameCluster = "Cluster Data Source"
+)
+
+type dataSourceCluster struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCluster) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_docdbelastic_cluster"
+}
+
+func (d *dataSourceCluster) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_user_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Auth](),
+				Computed:   true,
+			},
+			"backup_retention_period": schema.Int32Attribute{
+				Computed: true,
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"preferred_backup_window": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrPreferredMaintenanceWindow: schema.StringAttribute{
+				Computed: true,
+			},
+			"shard_capacity": schema.Int64Attribute{
+				Computed: true,
+			},
+			"shard_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"shard_instance_count": schema.Int32Attribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Computed:   true,
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Computed:   true,
+			},
+			names.AttrVPCSecurityGroupIDs: schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCluster) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	conn := d.Meta().DocDBElasticClient(ctx)
+
+	var data dataSourceClusterData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findClusterByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionReading, DSNameCluster, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data, fwflex.WithFieldNamePrefix("Cluster"))...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceClusterData struct {
+	AdminUserName              types.String                        `tfsdk:"admin_user_name"`
+	ARN                        types.String                        `tfsdk:"arn"`
+	AuthType                   fwtypes.StringEnum[awstypes.Auth]   `tfsdk:"auth_type"`
+	BackupRetentionPeriod      types.Int32                         `tfsdk:"backup_retention_period"`
+	Endpoint                   types.String                        `tfsdk:"endpoint"`
+	ID                         types.String                        `tfsdk:"id"`
+	KmsKeyID                   types.String                        `tfsdk:"kms_key_id"`
+	Name                       types.String                        `tfsdk:"name"`
+	PreferredBackupWindow      types.String                        `tfsdk:"preferred_backup_window"`
+	PreferredMaintenanceWindow types.String                        `tfsdk:"preferred_maintenance_window"`
+	ShardCapacity              types.Int64                         `tfsdk:"shard_capacity"`
+	ShardCount                 types.Int64                         `tfsdk:"shard_count"`
+	ShardInstanceCount         types.Int32                         `tfsdk:"shard_instance_count"`
+	Status                     fwtypes.StringEnum[awstypes.Status] `tfsdk:"status"`
+	SubnetIds                  fwtypes.SetValueOf[types.String]    `tfsdk:"subnet_ids"`
+	VpcSecurityGroupIds        fwtypes.SetValueOf[types.String]    `tfsdk:"vpc_security_group_ids"`
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDocDBElasticClusterDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_docdbelastic_cluster.test"
+	resourceName := "aws_docdbelastic_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBElasticServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "admin_user_name", resourceName, "admin_user_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auth_type", resourceName, "auth_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "backup_retention_period", resourceName, "backup_retention_period"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrEndpoint, resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrKMSKeyID, resourceName, names.AttrKMSKeyID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "preferred_backup_window", resourceName, "preferred_backup_window"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrPreferredMaintenanceWindow, resourceName, names.AttrPreferredMaintenanceWindow),
+					resource.TestCheckResourceAttrPair(dataSourceName, "shard_capacity", resourceName, "shard_capacity"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "shard_count", resourceName, "shard_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "shard_instance_count", resourceName, "shard_instance_count"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_security_group_ids.#", resourceName, "vpc_security_group_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_docdbelastic_cluster" "test" {
+  id = aws_docdbelastic_cluster.test.id
+}
+`)
+}
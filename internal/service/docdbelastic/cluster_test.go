@@ -224,6 +224,62 @@ func TestAccDocDBElasticCluster_update(t *testing.T) {
 	})
 }
 
+func TestAccDocDBElasticCluster_shards(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var cluster awstypes.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdbelastic_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBElasticServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_shards(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "shard_instance_count", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_user_password",
+				},
+			},
+			{
+				Config: testAccClusterConfig_shards(rName, 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "shard_instance_count", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccClusterConfig_shards(rName, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "shard_instance_count", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBElasticClient(ctx)
@@ -356,6 +412,32 @@ resource "aws_docdbelastic_cluster" "test" {
 `, rName, shardCapacity, backupRetentionPeriod))
 }
 
+func testAccClusterConfig_shards(rName string, shardCount, shardInstanceCount int) string {
+	return acctest.ConfigCompose(
+		testAccClusterBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_docdbelastic_cluster" "test" {
+  name                 = %[1]q
+  shard_capacity       = 2
+  shard_count          = %[2]d
+  shard_instance_count = %[3]d
+
+  admin_user_name     = "testuser"
+  admin_user_password = "testpassword"
+  auth_type           = "PLAIN_TEXT"
+
+  vpc_security_group_ids = [
+    aws_security_group.test.id
+  ]
+
+  subnet_ids = [
+    aws_subnet.test[0].id,
+    aws_subnet.test[1].id
+  ]
+}
+`, rName, shardCount, shardInstanceCount))
+}
+
 func testAccClusterConfig_tags1(rName, key1, value1 string) string {
 	return acctest.ConfigCompose(
 		testAccClusterBaseConfig(rName),
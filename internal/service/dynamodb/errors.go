@@ -8,6 +8,7 @@ import (
 )
 
 const (
+	errCodeAccessDeniedException     = "AccessDeniedException"
 	errCodeThrottlingException       = "ThrottlingException"
 	errCodeUnknownOperationException = "UnknownOperationException"
 	errCodeValidationException       = "ValidationException"
@@ -20,6 +20,7 @@ var (
 	ExpandTableItemAttributes                    = expandTableItemAttributes
 	ExpandTableItemQueryKey                      = expandTableItemQueryKey
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
+	FindEnabledKinesisDataStreamDestinationARNs  = findEnabledKinesisDataStreamDestinationARNsByTableName
 	FindGlobalTableByName                        = findGlobalTableByName
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
 	FindResourcePolicyByARN                      = findResourcePolicyByARN
@@ -34,4 +35,5 @@ var (
 	TableNameFromARN                             = tableNameFromARN
 	TableReplicaParseResourceID                  = tableReplicaParseResourceID
 	UpdateDiffGSI                                = updateDiffGSI
+	WaitKinesisStreamingDestinationActive        = waitKinesisStreamingDestinationActive
 )
@@ -17,6 +17,8 @@ func stripCapacityAttributes(in map[string]interface{}) (map[string]interface{},
 
 	delete(m, "write_capacity")
 	delete(m, "read_capacity")
+	delete(m, "on_demand_throughput")
+	delete(m, "warm_throughput")
 
 	return m, nil
 }
@@ -35,6 +35,7 @@ func resourceKinesisStreamingDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKinesisStreamingDestinationCreate,
 		ReadWithoutTimeout:   resourceKinesisStreamingDestinationRead,
+		UpdateWithoutTimeout: resourceKinesisStreamingDestinationUpdate,
 		DeleteWithoutTimeout: resourceKinesisStreamingDestinationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -42,6 +43,12 @@ func resourceKinesisStreamingDestination() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"approximate_creation_date_time_precision": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ApproximateCreationDateTimePrecision](),
+			},
 			names.AttrStreamARN: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -69,6 +76,12 @@ func resourceKinesisStreamingDestinationCreate(ctx context.Context, d *schema.Re
 		TableName: aws.String(tableName),
 	}
 
+	if v, ok := d.GetOk("approximate_creation_date_time_precision"); ok {
+		input.EnableKinesisStreamingConfiguration = &awstypes.EnableKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: awstypes.ApproximateCreationDateTimePrecision(v.(string)),
+		}
+	}
+
 	_, err := conn.EnableKinesisStreamingDestination(ctx, input)
 
 	if err != nil {
@@ -106,12 +119,44 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Kinesis Streaming Destination (%s): %s", d.Id(), err)
 	}
 
+	d.Set("approximate_creation_date_time_precision", output.ApproximateCreationDateTimePrecision)
 	d.Set(names.AttrStreamARN, output.StreamArn)
 	d.Set(names.AttrTableName, tableName)
 
 	return diags
 }
 
+func resourceKinesisStreamingDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), kinesisStreamingDestinationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tableName, streamARN := parts[0], parts[1]
+	input := &dynamodb.UpdateKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamARN),
+		TableName: aws.String(tableName),
+		UpdateKinesisStreamingConfiguration: &awstypes.UpdateKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: awstypes.ApproximateCreationDateTimePrecision(d.Get("approximate_creation_date_time_precision").(string)),
+		},
+	}
+
+	_, err = conn.UpdateKinesisStreamingDestination(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Kinesis Streaming Destination (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitKinesisStreamingDestinationActive(ctx, conn, streamARN, tableName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Kinesis Streaming Destination (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKinesisStreamingDestinationRead(ctx, d, meta)...)
+}
+
 func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
@@ -231,7 +276,7 @@ func waitKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.C
 		timeout = 5 * time.Minute
 	)
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DestinationStatusDisabled, awstypes.DestinationStatusEnabling),
+		Pending: enum.Slice(awstypes.DestinationStatusDisabled, awstypes.DestinationStatusEnabling, awstypes.DestinationStatusUpdating),
 		Target:  enum.Slice(awstypes.DestinationStatusActive),
 		Timeout: timeout,
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamARN, tableName),
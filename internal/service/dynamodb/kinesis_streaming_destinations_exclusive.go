@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_dynamodb_kinesis_streaming_destinations_exclusive", name="Kinesis Streaming Destinations Exclusive")
+func newKinesisStreamingDestinationsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &kinesisStreamingDestinationsExclusiveResource{}, nil
+}
+
+type kinesisStreamingDestinationsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*kinesisStreamingDestinationsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_dynamodb_kinesis_streaming_destinations_exclusive"
+}
+
+func (r *kinesisStreamingDestinationsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"approximate_creation_date_time_precision": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApproximateCreationDateTimePrecision](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stream_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			names.AttrTableName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *kinesisStreamingDestinationsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data kinesisStreamingDestinationsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncDestinations(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *kinesisStreamingDestinationsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data kinesisStreamingDestinationsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	destinations, err := findEnabledKinesisDataStreamDestinationsByTableName(ctx, conn, tableName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Kinesis Streaming Destinations Exclusive (%s)", tableName), err.Error())
+
+		return
+	}
+
+	data.ApproximateCreationDateTimePrecision = flattenKinesisStreamingDestinationsPrecision(destinations, data.ApproximateCreationDateTimePrecision)
+	data.StreamARNs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, kinesisDataStreamDestinationARNs(destinations))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *kinesisStreamingDestinationsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data kinesisStreamingDestinationsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncDestinations(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *kinesisStreamingDestinationsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrTableName), request, response)
+}
+
+// syncDestinations enables the configured Kinesis streaming destinations that are not enabled
+// and disables any enabled destinations that are not configured.
+// The configured precision is applied to all destinations.
+func (r *kinesisStreamingDestinationsExclusiveResource) syncDestinations(ctx context.Context, data *kinesisStreamingDestinationsExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	destinations, err := findEnabledKinesisDataStreamDestinationsByTableName(ctx, conn, tableName)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading DynamoDB Kinesis Streaming Destinations Exclusive (%s)", tableName), err.Error())
+
+		return diags
+	}
+
+	have := kinesisDataStreamDestinationARNs(destinations)
+	want := fwflex.ExpandFrameworkStringValueSet(ctx, data.StreamARNs)
+	precision := data.ApproximateCreationDateTimePrecision.ValueEnum()
+
+	for _, streamARN := range want.Difference(have) {
+		input := &dynamodb.EnableKinesisStreamingDestinationInput{
+			StreamArn: aws.String(streamARN),
+			TableName: aws.String(tableName),
+		}
+
+		if precision != "" {
+			input.EnableKinesisStreamingConfiguration = &awstypes.EnableKinesisStreamingConfiguration{
+				ApproximateCreationDateTimePrecision: precision,
+			}
+		}
+
+		_, err := conn.EnableKinesisStreamingDestination(ctx, input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("enabling DynamoDB Kinesis Streaming Destination (%s,%s)", tableName, streamARN), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitKinesisStreamingDestinationActive(ctx, conn, streamARN, tableName); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for DynamoDB Kinesis Streaming Destination (%s,%s) create", tableName, streamARN), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, destination := range destinations {
+		streamARN := aws.ToString(destination.StreamArn)
+		if precision == "" || destination.ApproximateCreationDateTimePrecision == precision || !slices.Contains(want, streamARN) {
+			continue
+		}
+
+		input := &dynamodb.UpdateKinesisStreamingDestinationInput{
+			StreamArn: aws.String(streamARN),
+			TableName: aws.String(tableName),
+			UpdateKinesisStreamingConfiguration: &awstypes.UpdateKinesisStreamingConfiguration{
+				ApproximateCreationDateTimePrecision: precision,
+			},
+		}
+
+		_, err := conn.UpdateKinesisStreamingDestination(ctx, input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating DynamoDB Kinesis Streaming Destination (%s,%s)", tableName, streamARN), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitKinesisStreamingDestinationActive(ctx, conn, streamARN, tableName); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for DynamoDB Kinesis Streaming Destination (%s,%s) update", tableName, streamARN), err.Error())
+
+			return diags
+		}
+	}
+
+	for _, streamARN := range have.Difference(want) {
+		log.Printf("[DEBUG] Disabling DynamoDB Kinesis Streaming Destination: %s,%s", tableName, streamARN)
+		_, err := conn.DisableKinesisStreamingDestination(ctx, &dynamodb.DisableKinesisStreamingDestinationInput{
+			StreamArn: aws.String(streamARN),
+			TableName: aws.String(tableName),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("disabling DynamoDB Kinesis Streaming Destination (%s,%s)", tableName, streamARN), err.Error())
+
+			return diags
+		}
+
+		if _, err := waitKinesisStreamingDestinationDisabled(ctx, conn, streamARN, tableName); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for DynamoDB Kinesis Streaming Destination (%s,%s) delete", tableName, streamARN), err.Error())
+
+			return diags
+		}
+	}
+
+	destinations, err = findEnabledKinesisDataStreamDestinationsByTableName(ctx, conn, tableName)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading DynamoDB Kinesis Streaming Destinations Exclusive (%s)", tableName), err.Error())
+
+		return diags
+	}
+
+	data.ApproximateCreationDateTimePrecision = flattenKinesisStreamingDestinationsPrecision(destinations, data.ApproximateCreationDateTimePrecision)
+
+	return diags
+}
+
+// findEnabledKinesisDataStreamDestinationARNsByTableName returns the ARNs of the Kinesis data streams
+// that are, or are becoming, streaming destinations of the specified table.
+func findEnabledKinesisDataStreamDestinationARNsByTableName(ctx context.Context, conn *dynamodb.Client, tableName string) (itypes.Set[string], error) {
+	output, err := findEnabledKinesisDataStreamDestinationsByTableName(ctx, conn, tableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return kinesisDataStreamDestinationARNs(output), nil
+}
+
+// findEnabledKinesisDataStreamDestinationsByTableName returns the streaming destinations of the
+// specified table that are, or are becoming, enabled.
+func findEnabledKinesisDataStreamDestinationsByTableName(ctx context.Context, conn *dynamodb.Client, tableName string) ([]awstypes.KinesisDataStreamDestination, error) {
+	input := &dynamodb.DescribeKinesisStreamingDestinationInput{
+		TableName: aws.String(tableName),
+	}
+
+	return findKinesisDataStreamDestinations(ctx, conn, input, func(v awstypes.KinesisDataStreamDestination) bool {
+		switch v.DestinationStatus {
+		case awstypes.DestinationStatusDisabled, awstypes.DestinationStatusDisabling, awstypes.DestinationStatusEnableFailed:
+			return false
+		default:
+			return true
+		}
+	})
+}
+
+func kinesisDataStreamDestinationARNs(destinations []awstypes.KinesisDataStreamDestination) itypes.Set[string] {
+	return tfslices.ApplyToAll(destinations, func(v awstypes.KinesisDataStreamDestination) string {
+		return aws.ToString(v.StreamArn)
+	})
+}
+
+// flattenKinesisStreamingDestinationsPrecision returns the precision of the streaming destinations,
+// preferring any precision that differs from the current value so that drift is detected.
+// The current value is kept if the table has no streaming destinations.
+func flattenKinesisStreamingDestinationsPrecision(destinations []awstypes.KinesisDataStreamDestination, current fwtypes.StringEnum[awstypes.ApproximateCreationDateTimePrecision]) fwtypes.StringEnum[awstypes.ApproximateCreationDateTimePrecision] {
+	for _, v := range destinations {
+		if v.ApproximateCreationDateTimePrecision != "" && v.ApproximateCreationDateTimePrecision != current.ValueEnum() {
+			return fwtypes.StringEnumValue(v.ApproximateCreationDateTimePrecision)
+		}
+	}
+
+	if current.IsUnknown() {
+		return fwtypes.StringEnumNull[awstypes.ApproximateCreationDateTimePrecision]()
+	}
+
+	return current
+}
+
+type kinesisStreamingDestinationsExclusiveResourceModel struct {
+	ApproximateCreationDateTimePrecision fwtypes.StringEnum[awstypes.ApproximateCreationDateTimePrecision] `tfsdk:"approximate_creation_date_time_precision"`
+	StreamARNs                           types.Set                                                         `tfsdk:"stream_arns"`
+	TableName                            types.String                                                      `tfsdk:"table_name"`
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBKinesisStreamingDestinationsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_kinesis_streaming_destinations_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKinesisStreamingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "stream_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "stream_arns.*", "aws_kinesis_stream.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccKinesisStreamingDestinationsExclusiveImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrTableName,
+			},
+			{
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationEnableOutOfBand(ctx, "aws_dynamodb_table.test", "aws_kinesis_stream.test.1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The out-of-band destination is disabled.
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "stream_arns.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBKinesisStreamingDestinationsExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_kinesis_streaming_destinations_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_streamARNs(rName, "aws_kinesis_stream.test[0].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "stream_arns.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_streamARNs(rName, "aws_kinesis_stream.test[0].arn", "aws_kinesis_stream.test[1].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "stream_arns.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_streamARNs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "stream_arns.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBKinesisStreamingDestinationsExclusive_approximateCreationDateTimePrecision(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_kinesis_streaming_destinations_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_approximateCreationDateTimePrecision(rName, "MICROSECOND"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "approximate_creation_date_time_precision", "MICROSECOND"),
+					resource.TestCheckResourceAttr(resourceName, "stream_arns.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccKinesisStreamingDestinationsExclusiveImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrTableName,
+			},
+			{
+				Config: testAccKinesisStreamingDestinationsExclusiveConfig_approximateCreationDateTimePrecision(rName, "MILLISECOND"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "approximate_creation_date_time_precision", "MILLISECOND"),
+					resource.TestCheckResourceAttr(resourceName, "stream_arns.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKinesisStreamingDestinationsExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		output, err := tfdynamodb.FindEnabledKinesisDataStreamDestinationARNs(ctx, conn, rs.Primary.Attributes[names.AttrTableName])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("DynamoDB Table (%s) has %d Kinesis Streaming Destinations, want %d", rs.Primary.Attributes[names.AttrTableName], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKinesisStreamingDestinationEnableOutOfBand(ctx context.Context, tableResourceName, streamResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		table, ok := s.RootModule().Resources[tableResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", tableResourceName)
+		}
+
+		stream, ok := s.RootModule().Resources[streamResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", streamResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		tableName, streamARN := table.Primary.Attributes[names.AttrName], stream.Primary.Attributes[names.AttrARN]
+		_, err := conn.EnableKinesisStreamingDestination(ctx, &dynamodb.EnableKinesisStreamingDestinationInput{
+			StreamArn: aws.String(streamARN),
+			TableName: aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfdynamodb.WaitKinesisStreamingDestinationActive(ctx, conn, streamARN, tableName)
+
+		return err
+	}
+}
+
+func testAccKinesisStreamingDestinationsExclusiveImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes[names.AttrTableName], nil
+	}
+}
+
+func testAccKinesisStreamingDestinationsExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "hk"
+
+  attribute {
+    name = "hk"
+    type = "S"
+  }
+}
+
+resource "aws_kinesis_stream" "test" {
+  count = 2
+
+  name        = "%[1]s-${count.index}"
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccKinesisStreamingDestinationsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccKinesisStreamingDestinationsExclusiveConfig_base(rName), `
+resource "aws_dynamodb_kinesis_streaming_destination" "test" {
+  table_name = aws_dynamodb_table.test.name
+  stream_arn = aws_kinesis_stream.test[0].arn
+}
+
+resource "aws_dynamodb_kinesis_streaming_destinations_exclusive" "test" {
+  table_name  = aws_dynamodb_table.test.name
+  stream_arns = [aws_dynamodb_kinesis_streaming_destination.test.stream_arn]
+}
+`)
+}
+
+func testAccKinesisStreamingDestinationsExclusiveConfig_streamARNs(rName string, streamARNs ...string) string {
+	return acctest.ConfigCompose(testAccKinesisStreamingDestinationsExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_kinesis_streaming_destinations_exclusive" "test" {
+  table_name  = aws_dynamodb_table.test.name
+  stream_arns = [%[1]s]
+}
+`, strings.Join(streamARNs, ", ")))
+}
+
+func testAccKinesisStreamingDestinationsExclusiveConfig_approximateCreationDateTimePrecision(rName, precision string) string {
+	return acctest.ConfigCompose(testAccKinesisStreamingDestinationsExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_kinesis_streaming_destinations_exclusive" "test" {
+  table_name  = aws_dynamodb_table.test.name
+  stream_arns = aws_kinesis_stream.test[*].arn
+
+  approximate_creation_date_time_precision = %[1]q
+}
+`, precision))
+}
@@ -84,6 +84,35 @@ func TestAccDynamoDBResourcePolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBResourcePolicy_stream(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourcepolicy dynamodb.GetResourcePolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_resource_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_stream(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(ctx, resourceName, &resourcepolicy),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, "aws_dynamodb_table.test", names.AttrStreamARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm_remove_self_resource_access", names.AttrPolicy},
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBResourcePolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var out dynamodb.GetResourcePolicyOutput
@@ -196,3 +225,38 @@ resource "aws_dynamodb_resource_policy" "test" {
 }
 `)
 }
+
+func testAccResourcePolicyConfig_stream(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  read_capacity    = 1
+  write_capacity   = 1
+  hash_key         = %[1]q
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["dynamodb:DescribeStream", "dynamodb:GetRecords", "dynamodb:GetShardIterator"]
+    principals {
+      type        = "AWS"
+      identifiers = [data.aws_caller_identity.current.account_id]
+    }
+    resources = [aws_dynamodb_table.test.stream_arn]
+  }
+}
+
+resource "aws_dynamodb_resource_policy" "test" {
+  resource_arn = aws_dynamodb_table.test.stream_arn
+  policy       = data.aws_iam_policy_document.test.json
+}
+`, rName)
+}
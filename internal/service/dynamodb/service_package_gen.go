@@ -18,6 +18,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newKinesisStreamingDestinationsExclusiveResource,
+			Name:    "Kinesis Streaming Destinations Exclusive",
+		},
 		{
 			Factory: newResourcePolicyResource,
 			Name:    "Resource Policy",
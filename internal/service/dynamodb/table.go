@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -98,6 +99,40 @@ func resourceTable() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// A new stream has no resource policy unless one is configured.
+				if diff.Id() == "" || !diff.HasChanges("stream_enabled", "stream_view_type") {
+					return nil
+				}
+
+				if v := diff.GetRawConfig().GetAttr("stream_resource_policy"); v.IsKnown() && v.IsNull() && diff.Get("stream_resource_policy").(string) != "" {
+					return diff.SetNew("stream_resource_policy", "")
+				}
+
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// on_demand_throughput is Optional+Computed, so removing the block doesn't produce a diff.
+				// Plan the removal of any configured maximums explicitly.
+				if diff.Id() == "" {
+					return nil
+				}
+
+				if v := diff.GetRawConfig().GetAttr("on_demand_throughput"); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+					return nil
+				}
+
+				if isOnDemandThroughputUnset(diff.Get("on_demand_throughput").([]interface{})) {
+					return nil
+				}
+
+				return diff.SetNew("on_demand_throughput", []interface{}{
+					map[string]interface{}{
+						"max_read_request_units":  -1,
+						"max_write_request_units": -1,
+					},
+				})
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				if v := diff.Get("restore_source_name"); v != "" {
 					return nil
@@ -184,6 +219,7 @@ func resourceTable() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"on_demand_throughput": onDemandThroughputSchema(),
 						"projection_type": {
 							Type:             schema.TypeString,
 							Required:         true,
@@ -197,6 +233,7 @@ func resourceTable() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"warm_throughput": warmThroughputSchema(),
 						"write_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -247,6 +284,7 @@ func resourceTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"on_demand_throughput": onDemandThroughputSchema(),
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -320,6 +358,14 @@ func resourceTable() *schema.Resource {
 				ConflictsWith: []string{"restore_source_name", "restore_source_table_arn"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"import_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"imported_item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"input_compression_type": {
 							Type:             schema.TypeString,
 							Optional:         true,
@@ -382,6 +428,18 @@ func resourceTable() *schema.Resource {
 					},
 				},
 			},
+			"resource_policy": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Computed:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			"restore_date_time": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -436,6 +494,18 @@ func resourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"stream_resource_policy": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Computed:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			"stream_view_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -477,6 +547,7 @@ func resourceTable() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			"warm_throughput": warmThroughputSchema(),
 			"write_capacity": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -486,6 +557,54 @@ func resourceTable() *schema.Resource {
 	}
 }
 
+func onDemandThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_read_request_units": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"max_write_request_units": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func warmThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"read_units_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(12000),
+				},
+				"write_units_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(4000),
+				},
+			},
+		},
+	}
+}
+
 func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
@@ -556,6 +675,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.GlobalSecondaryIndexOverride = globalSecondaryIndexes
 		}
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok {
+			input.OnDemandThroughputOverride = expandOnDemandThroughput(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("server_side_encryption"); ok {
 			input.SSESpecificationOverride = expandEncryptAtRestOptions(v.([]interface{}))
 		}
@@ -602,6 +725,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			tcp.AttributeDefinitions = expandAttributes(aSet.List())
 		}
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok {
+			tcp.OnDemandThroughput = expandOnDemandThroughput(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("server_side_encryption"); ok {
 			tcp.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
 		}
@@ -645,10 +772,21 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 
 		importARN := importTableOutput.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn
-		if _, err := waitImportComplete(ctx, conn, aws.ToString(importARN), d.Timeout(schema.TimeoutCreate)); err != nil {
+		importTable, err := waitImportComplete(ctx, conn, aws.ToString(importARN), d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
 			d.SetId(tableName)
 			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, tableName, err)
 		}
+
+		// The import job is only described on creation.
+		tfMap := vit.([]interface{})[0].(map[string]interface{})
+		tfMap["import_arn"] = aws.ToString(importTable.ImportArn)
+		tfMap["imported_item_count"] = importTable.ImportedItemCount
+
+		if err := d.Set("import_table", []interface{}{tfMap}); err != nil {
+			return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, tableName, "import_table", err)
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			BillingMode: awstypes.BillingMode(d.Get("billing_mode").(string)),
@@ -696,6 +834,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.GlobalSecondaryIndexes = globalSecondaryIndexes
 		}
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok {
+			input.OnDemandThroughput = expandOnDemandThroughput(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("stream_enabled"); ok {
 			input.StreamSpecification = &awstypes.StreamSpecification{
 				StreamEnabled:  aws.Bool(v.(bool)),
@@ -707,10 +849,23 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
 		}
 
+		if v, ok := d.GetOk("resource_policy"); ok {
+			policy, err := structure.NormalizeJsonString(v.(string))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.ResourcePolicy = aws.String(policy)
+		}
+
 		if v, ok := d.GetOk("table_class"); ok {
 			input.TableClass = awstypes.TableClass(v.(string))
 		}
 
+		if v, ok := d.GetOk("warm_throughput"); ok {
+			input.WarmThroughput = expandWarmThroughput(v.([]interface{}))
+		}
+
 		_, err := tfresource.RetryWhen(ctx, createTableTimeout, func() (interface{}, error) {
 			return conn.CreateTable(ctx, input)
 		}, func(err error) (bool, error) {
@@ -749,6 +904,37 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			if _, err := waitGSIActive(ctx, conn, d.Id(), gsi[names.AttrName].(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForCreation, resNameTable, d.Id(), fmt.Errorf("GSI (%s): %w", gsi[names.AttrName].(string), err))
 			}
+
+			if v, ok := gsi["warm_throughput"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				if _, err := waitGSIWarmThroughputActive(ctx, conn, d.Id(), gsi[names.AttrName].(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+					return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForCreation, resNameTable, d.Id(), fmt.Errorf("GSI (%s) warm throughput: %w", gsi[names.AttrName].(string), err))
+				}
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("warm_throughput"); ok {
+		// Restored and imported tables are created without warm throughput.
+		if nameOk || arnOk || d.Get("import_table.#").(int) > 0 {
+			if err := updateWarmThroughput(ctx, conn, d.Id(), v.([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), err)
+			}
+		} else if _, err := waitTableWarmThroughputActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForCreation, resNameTable, d.Id(), fmt.Errorf("warm throughput: %w", err))
+		}
+	}
+
+	// Restored and imported tables are created without a resource policy.
+	if v, ok := d.GetOk("resource_policy"); ok && (nameOk || arnOk || d.Get("import_table.#").(int) > 0) {
+		if err := putTableResourcePolicy(ctx, conn, aws.ToString(output.TableArn), v.(string)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), err)
+		}
+	}
+
+	// The stream's resource policy can't be specified at table creation.
+	if v, ok := d.GetOk("stream_resource_policy"); ok {
+		if err := putStreamResourcePolicy(ctx, conn, d.Id(), v.(string)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), err)
 		}
 	}
 
@@ -809,6 +995,14 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
 	}
 
+	if err := d.Set("on_demand_throughput", flattenOnDemandThroughput(table.OnDemandThroughput)); err != nil {
+		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "on_demand_throughput", err)
+	}
+
+	if err := d.Set("warm_throughput", flattenTableWarmThroughput(table.WarmThroughput)); err != nil {
+		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "warm_throughput", err)
+	}
+
 	if err := d.Set("attribute", flattenTableAttributeDefinitions(table.AttributeDefinitions)); err != nil {
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "attribute", err)
 	}
@@ -827,7 +1021,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "local_secondary_index", err)
 	}
 
-	if err := d.Set("global_secondary_index", flattenTableGlobalSecondaryIndex(table.GlobalSecondaryIndexes)); err != nil {
+	gsis := flattenTableGlobalSecondaryIndex(table.GlobalSecondaryIndexes)
+	gsis = clearGSIUnconfiguredThroughputs(d.Get("global_secondary_index").(*schema.Set), gsis)
+
+	if err := d.Set("global_secondary_index", gsis); err != nil {
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "global_secondary_index", err)
 	}
 
@@ -885,6 +1082,18 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "point_in_time_recovery", err)
 	}
 
+	if err := readTableResourcePolicy(ctx, conn, d, "resource_policy", aws.ToString(table.TableArn)); err != nil {
+		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionReading, resNameTable, d.Id(), fmt.Errorf("resource policy: %w", err))
+	}
+
+	if table.StreamSpecification != nil && aws.ToBool(table.StreamSpecification.StreamEnabled) {
+		if err := readTableResourcePolicy(ctx, conn, d, "stream_resource_policy", aws.ToString(table.LatestStreamArn)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionReading, resNameTable, d.Id(), fmt.Errorf("stream resource policy: %w", err))
+		}
+	} else {
+		d.Set("stream_resource_policy", "")
+	}
+
 	ttlOut, err := conn.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{
 		TableName: aws.String(d.Id()),
 	})
@@ -984,6 +1193,20 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.DeletionProtectionEnabled = aws.Bool(d.Get("deletion_protection_enabled").(bool))
 	}
 
+	if d.HasChange("on_demand_throughput") {
+		if v := expandOnDemandThroughput(d.Get("on_demand_throughput").([]interface{})); v != nil {
+			hasTableUpdate = true
+			input.OnDemandThroughput = v
+		} else {
+			// Removing the configuration removes the maximum.
+			hasTableUpdate = true
+			input.OnDemandThroughput = &awstypes.OnDemandThroughput{
+				MaxReadRequestUnits:  aws.Int64(-1),
+				MaxWriteRequestUnits: aws.Int64(-1),
+			}
+		}
+	}
+
 	// make change when
 	//   stream_enabled has change (below) OR
 	//   stream_view_type has change and stream_enabled is true (special case)
@@ -1011,17 +1234,20 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	// Phase 2 of Global Secondary Index Operations: Update Only
 	// Cannot create or delete index while updating table ProvisionedThroughput
-	// Must skip all index updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
+	// Must skip all index capacity updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
 	// Must update all indexes when switching BillingMode from PAY_PER_REQUEST to PROVISIONED
-	if newBillingMode == awstypes.BillingModeProvisioned {
-		for _, gsiUpdate := range gsiUpdates {
-			if gsiUpdate.Update == nil {
-				continue
-			}
+	// Warm throughput updates are applied separately once the table is active
+	for _, gsiUpdate := range gsiUpdates {
+		if gsiUpdate.Update == nil || gsiUpdate.Update.WarmThroughput != nil {
+			continue
+		}
 
-			hasTableUpdate = true
-			input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
+		if newBillingMode != awstypes.BillingModeProvisioned && gsiUpdate.Update.OnDemandThroughput == nil {
+			continue
 		}
+
+		hasTableUpdate = true
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
 	}
 
 	if hasTableUpdate {
@@ -1048,6 +1274,42 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Warm throughput is provisioned asynchronously, and the table or index
+	// can't be modified again until it is active.
+	if d.HasChange("warm_throughput") {
+		if v := d.Get("warm_throughput").([]interface{}); expandWarmThroughput(v) != nil {
+			if err := updateWarmThroughput(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), err)
+			}
+		}
+	}
+
+	for _, gsiUpdate := range gsiUpdates {
+		if gsiUpdate.Update == nil || gsiUpdate.Update.WarmThroughput == nil {
+			continue
+		}
+
+		idxName := aws.ToString(gsiUpdate.Update.IndexName)
+		input := &dynamodb.UpdateTableInput{
+			GlobalSecondaryIndexUpdates: []awstypes.GlobalSecondaryIndexUpdate{gsiUpdate},
+			TableName:                   aws.String(d.Id()),
+		}
+
+		if _, err := tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateTable(ctx, input)
+		}); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), fmt.Errorf("GSI (%s) warm throughput: %w", idxName, err))
+		}
+
+		if _, err := waitGSIActive(ctx, conn, d.Id(), idxName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForUpdate, resNameTable, d.Id(), fmt.Errorf("GSI (%s): %w", idxName, err))
+		}
+
+		if _, err := waitGSIWarmThroughputActive(ctx, conn, d.Id(), idxName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForUpdate, resNameTable, d.Id(), fmt.Errorf("GSI (%s) warm throughput: %w", idxName, err))
+		}
+	}
+
 	// Phase 3 of Global Secondary Index Operations: Create Only
 	// Only 1 online index can be created simultaneously per table
 	for _, gsiUpdate := range gsiUpdates {
@@ -1069,6 +1331,12 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		if _, err := waitGSIActive(ctx, conn, d.Id(), idxName, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), fmt.Errorf("%s GSI (%s): %w", create.ErrActionWaitingForCreation, idxName, err))
 		}
+
+		if gsiUpdate.Create.WarmThroughput != nil {
+			if _, err := waitGSIWarmThroughputActive(ctx, conn, d.Id(), idxName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), fmt.Errorf("%s GSI (%s) warm throughput: %w", create.ErrActionWaitingForCreation, idxName, err))
+			}
+		}
 	}
 
 	if d.HasChange("server_side_encryption") {
@@ -1167,6 +1435,25 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Resource policies are only managed when configured, so removing one from the configuration
+	// leaves the policy in place, e.g. for an aws_dynamodb_resource_policy resource to manage.
+	if d.HasChange("resource_policy") {
+		if v := d.Get("resource_policy").(string); v != "" {
+			if err := putTableResourcePolicy(ctx, conn, d.Get(names.AttrARN).(string), v); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), err)
+			}
+		}
+	}
+
+	// Enabling the stream or changing its view type creates a new stream without a resource policy.
+	if d.HasChanges("stream_enabled", "stream_view_type", "stream_resource_policy") {
+		if v := d.GetRawConfig().GetAttr("stream_resource_policy"); v.IsKnown() && !v.IsNull() && v.AsString() != "" {
+			if err := putStreamResourcePolicy(ctx, conn, d.Id(), d.Get("stream_resource_policy").(string)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceTableRead(ctx, d, meta)...)
 }
 
@@ -1204,6 +1491,103 @@ func resourceTableDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 // custom diff
 
+func putTableResourcePolicy(ctx context.Context, conn *dynamodb.Client, arn, policy string) error {
+	policy, err := structure.NormalizeJsonString(policy)
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.PutResourcePolicyInput{
+		Policy:      aws.String(policy),
+		ResourceArn: aws.String(arn),
+	}
+
+	if _, err := conn.PutResourcePolicy(ctx, input); err != nil {
+		return fmt.Errorf("putting resource policy: %w", err)
+	}
+
+	_, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findResourcePolicyByARN(ctx, conn, arn)
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for resource policy: %w", err)
+	}
+
+	return nil
+}
+
+func updateWarmThroughput(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []interface{}, timeout time.Duration) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName:      aws.String(tableName),
+		WarmThroughput: expandWarmThroughput(tfList),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, timeout, func() (interface{}, error) {
+		return conn.UpdateTable(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating warm throughput: %w", err)
+	}
+
+	if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for warm throughput update: %w", err)
+	}
+
+	if _, err := waitTableWarmThroughputActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for warm throughput update: %w", err)
+	}
+
+	return nil
+}
+
+// putStreamResourcePolicy attaches the resource policy to the table's current stream.
+func putStreamResourcePolicy(ctx context.Context, conn *dynamodb.Client, tableName, policy string) error {
+	table, err := findTableByName(ctx, conn, tableName)
+
+	if err != nil {
+		return err
+	}
+
+	if table.StreamSpecification == nil || !aws.ToBool(table.StreamSpecification.StreamEnabled) {
+		return errors.New("putting stream resource policy: stream is not enabled")
+	}
+
+	if err := putTableResourcePolicy(ctx, conn, aws.ToString(table.LatestStreamArn), policy); err != nil {
+		return fmt.Errorf("stream: %w", err)
+	}
+
+	return nil
+}
+
+// readTableResourcePolicy sets the specified attribute to the resource policy attached to the table or stream.
+// The policy is only read if it is managed by this resource, so that a policy managed by an
+// aws_dynamodb_resource_policy resource does not show as a difference.
+func readTableResourcePolicy(ctx context.Context, conn *dynamodb.Client, d *schema.ResourceData, key, arn string) error {
+	if d.Get(key).(string) == "" {
+		return nil
+	}
+
+	policy, err := findResourcePolicyByARN(ctx, conn, arn)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set(key, "")
+	case err != nil:
+		return err
+	default:
+		policyToSet, err := verify.PolicyToSet(d.Get(key).(string), aws.ToString(policy.Policy))
+		if err != nil {
+			return err
+		}
+
+		d.Set(key, policyToSet)
+	}
+
+	return nil
+}
+
 func isTableOptionDisabled(v interface{}) bool {
 	options := v.([]interface{})
 	if len(options) == 0 {
@@ -1581,16 +1965,8 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 		newName := newMap[names.AttrName].(string)
 
 		if _, exists := oldGsis[newName]; !exists {
-			m := data.(map[string]interface{})
-			idxName := m[names.AttrName].(string)
-
 			ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
-				Create: &awstypes.CreateGlobalSecondaryIndexAction{
-					IndexName:             aws.String(idxName),
-					KeySchema:             expandKeySchema(m),
-					ProvisionedThroughput: expandProvisionedThroughput(m, billingMode),
-					Projection:            expandProjection(m),
-				},
+				Create: expandCreateGlobalSecondaryIndexAction(newMap, billingMode),
 			})
 		}
 	}
@@ -1607,6 +1983,8 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 			oldWriteCapacity, oldReadCapacity := oldMap["write_capacity"].(int), oldMap["read_capacity"].(int)
 			newWriteCapacity, newReadCapacity := newMap["write_capacity"].(int), newMap["read_capacity"].(int)
 			capacityChanged := (oldWriteCapacity != newWriteCapacity || oldReadCapacity != newReadCapacity)
+			onDemandThroughputChanged := !reflect.DeepEqual(oldMap["on_demand_throughput"], newMap["on_demand_throughput"])
+			warmThroughputChanged := !reflect.DeepEqual(oldMap["warm_throughput"], newMap["warm_throughput"])
 
 			// pluck non_key_attributes from oldAttributes and newAttributes as reflect.DeepEquals will compare
 			// ordinal of elements in its equality (which we actually don't care about)
@@ -1630,15 +2008,7 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 			}
 			otherAttributesChanged := nonKeyAttributesChanged || !reflect.DeepEqual(oldAttributes, newAttributes)
 
-			if capacityChanged && !otherAttributesChanged {
-				update := awstypes.GlobalSecondaryIndexUpdate{
-					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
-						IndexName:             aws.String(idxName),
-						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
-					},
-				}
-				ops = append(ops, update)
-			} else if otherAttributesChanged {
+			if otherAttributesChanged {
 				// Other attributes cannot be updated
 				ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
 					Delete: &awstypes.DeleteGlobalSecondaryIndexAction{
@@ -1647,13 +2017,43 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 				})
 
 				ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
-					Create: &awstypes.CreateGlobalSecondaryIndexAction{
+					Create: expandCreateGlobalSecondaryIndexAction(newMap, billingMode),
+				})
+			} else {
+				if capacityChanged || onDemandThroughputChanged {
+					update := &awstypes.UpdateGlobalSecondaryIndexAction{
 						IndexName:             aws.String(idxName),
-						KeySchema:             expandKeySchema(newMap),
 						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
-						Projection:            expandProjection(newMap),
-					},
-				})
+					}
+
+					if onDemandThroughputChanged {
+						if v, ok := newMap["on_demand_throughput"].([]interface{}); ok {
+							update.OnDemandThroughput = expandOnDemandThroughput(v)
+						}
+
+						// Removing the configuration removes the maximum.
+						if update.OnDemandThroughput == nil {
+							update.OnDemandThroughput = &awstypes.OnDemandThroughput{
+								MaxReadRequestUnits:  aws.Int64(-1),
+								MaxWriteRequestUnits: aws.Int64(-1),
+							}
+						}
+					}
+
+					ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{Update: update})
+				}
+
+				// Warm throughput must be updated separately from other index changes.
+				if v, ok := newMap["warm_throughput"].([]interface{}); ok && warmThroughputChanged {
+					if warmThroughput := expandWarmThroughput(v); warmThroughput != nil {
+						ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
+							Update: &awstypes.UpdateGlobalSecondaryIndexAction{
+								IndexName:      aws.String(idxName),
+								WarmThroughput: warmThroughput,
+							},
+						})
+					}
+				}
 			}
 		} else {
 			idxName := oldName
@@ -2024,12 +2424,109 @@ func flattenTableGlobalSecondaryIndex(gsi []awstypes.GlobalSecondaryIndexDescrip
 			gsi["non_key_attributes"] = g.Projection.NonKeyAttributes
 		}
 
+		gsi["on_demand_throughput"] = flattenOnDemandThroughput(g.OnDemandThroughput)
+		gsi["warm_throughput"] = flattenGSIWarmThroughput(g.WarmThroughput)
+
 		output = append(output, gsi)
 	}
 
 	return output
 }
 
+// clearGSIUnconfiguredThroughputs removes on-demand and warm throughput values that aren't configured
+// for a global secondary index, as DynamoDB reports them for every index.
+func clearGSIUnconfiguredThroughputs(configGSIs *schema.Set, gsis []interface{}) []interface{} {
+	configGSIMaps := make(map[string]map[string]interface{})
+	for _, v := range configGSIs.List() {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			configGSIMaps[tfMap[names.AttrName].(string)] = tfMap
+		}
+	}
+
+	for _, v := range gsis {
+		gsi := v.(map[string]interface{})
+		configGSI := configGSIMaps[gsi[names.AttrName].(string)]
+
+		for _, key := range []string{"on_demand_throughput", "warm_throughput"} {
+			configList, ok := configGSI[key].([]interface{})
+			if !ok || len(configList) == 0 || configList[0] == nil {
+				delete(gsi, key)
+				continue
+			}
+
+			tfList, ok := gsi[key].([]interface{})
+			if !ok || len(tfList) == 0 {
+				continue
+			}
+
+			tfMap := tfList[0].(map[string]interface{})
+			for k, v := range configList[0].(map[string]interface{}) {
+				if v.(int) == 0 {
+					tfMap[k] = 0
+				}
+			}
+		}
+	}
+
+	return gsis
+}
+
+// isOnDemandThroughputUnset returns whether no maximum on-demand throughput is set.
+// DynamoDB reports an unset maximum as -1.
+func isOnDemandThroughputUnset(tfList []interface{}) bool {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return true
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	for _, key := range []string{"max_read_request_units", "max_write_request_units"} {
+		if v, ok := tfMap[key].(int); ok && v > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenOnDemandThroughput(apiObject *awstypes.OnDemandThroughput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"max_read_request_units":  aws.ToInt64(apiObject.MaxReadRequestUnits),
+		"max_write_request_units": aws.ToInt64(apiObject.MaxWriteRequestUnits),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTableWarmThroughput(apiObject *awstypes.TableWarmThroughputDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"read_units_per_second":  aws.ToInt64(apiObject.ReadUnitsPerSecond),
+		"write_units_per_second": aws.ToInt64(apiObject.WriteUnitsPerSecond),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenGSIWarmThroughput(apiObject *awstypes.GlobalSecondaryIndexWarmThroughputDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"read_units_per_second":  aws.ToInt64(apiObject.ReadUnitsPerSecond),
+		"write_units_per_second": aws.ToInt64(apiObject.WriteUnitsPerSecond),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenTableServerSideEncryption(description *awstypes.SSEDescription) []interface{} {
 	if description == nil {
 		return []interface{}{}
@@ -2171,12 +2668,73 @@ func expandImportTable(data map[string]interface{}) *dynamodb.ImportTableInput {
 }
 
 func expandGlobalSecondaryIndex(data map[string]interface{}, billingMode awstypes.BillingMode) *awstypes.GlobalSecondaryIndex {
-	return &awstypes.GlobalSecondaryIndex{
+	apiObject := &awstypes.GlobalSecondaryIndex{
 		IndexName:             aws.String(data[names.AttrName].(string)),
 		KeySchema:             expandKeySchema(data),
 		Projection:            expandProjection(data),
 		ProvisionedThroughput: expandProvisionedThroughput(data, billingMode),
 	}
+
+	if v, ok := data["on_demand_throughput"].([]interface{}); ok {
+		apiObject.OnDemandThroughput = expandOnDemandThroughput(v)
+	}
+
+	if v, ok := data["warm_throughput"].([]interface{}); ok {
+		apiObject.WarmThroughput = expandWarmThroughput(v)
+	}
+
+	return apiObject
+}
+
+func expandCreateGlobalSecondaryIndexAction(data map[string]interface{}, billingMode awstypes.BillingMode) *awstypes.CreateGlobalSecondaryIndexAction {
+	apiObject := expandGlobalSecondaryIndex(data, billingMode)
+
+	return &awstypes.CreateGlobalSecondaryIndexAction{
+		IndexName:             apiObject.IndexName,
+		KeySchema:             apiObject.KeySchema,
+		OnDemandThroughput:    apiObject.OnDemandThroughput,
+		ProvisionedThroughput: apiObject.ProvisionedThroughput,
+		Projection:            apiObject.Projection,
+		WarmThroughput:        apiObject.WarmThroughput,
+	}
+}
+
+func expandOnDemandThroughput(tfList []interface{}) *awstypes.OnDemandThroughput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.OnDemandThroughput{}
+
+	if v, ok := tfMap["max_read_request_units"].(int); ok && v != 0 {
+		apiObject.MaxReadRequestUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_write_request_units"].(int); ok && v != 0 {
+		apiObject.MaxWriteRequestUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandWarmThroughput(tfList []interface{}) *awstypes.WarmThroughput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.WarmThroughput{}
+
+	if v, ok := tfMap["read_units_per_second"].(int); ok && v != 0 {
+		apiObject.ReadUnitsPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["write_units_per_second"].(int); ok && v != 0 {
+		apiObject.WriteUnitsPerSecond = aws.Int64(int64(v))
+	}
+
+	return apiObject
 }
 
 func expandProvisionedThroughput(data map[string]interface{}, billingMode awstypes.BillingMode) *awstypes.ProvisionedThroughput {
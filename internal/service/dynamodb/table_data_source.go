@@ -70,6 +70,22 @@ func dataSourceTable() *schema.Resource {
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"on_demand_throughput": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_read_request_units": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"max_write_request_units": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"projection_type": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -82,6 +98,22 @@ func dataSourceTable() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"warm_throughput": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"read_units_per_second": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"write_units_per_second": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"write_capacity": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -122,6 +154,22 @@ func dataSourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"on_demand_throughput": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_read_request_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_write_request_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -213,6 +261,22 @@ func dataSourceTable() *schema.Resource {
 					},
 				},
 			},
+			"warm_throughput": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_units_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"write_units_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"write_capacity": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -248,6 +312,14 @@ func dataSourceTableRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
 	}
 
+	if err := d.Set("on_demand_throughput", flattenOnDemandThroughput(table.OnDemandThroughput)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting on_demand_throughput: %s", err)
+	}
+
+	if err := d.Set("warm_throughput", flattenTableWarmThroughput(table.WarmThroughput)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting warm_throughput: %s", err)
+	}
+
 	if err := d.Set("attribute", flattenTableAttributeDefinitions(table.AttributeDefinitions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting attribute: %s", err)
 	}
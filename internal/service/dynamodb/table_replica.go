@@ -64,6 +64,10 @@ func resourceTableReplica() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection_enabled": { // direct to replica
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			// global_secondary_index read capacity override can be set but not return by aws atm either through main/replica nor directly
 			"global_table_arn": {
 				Type:         schema.TypeString,
@@ -75,7 +79,6 @@ func resourceTableReplica() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			"point_in_time_recovery": { // direct to replica
@@ -87,7 +90,6 @@ func resourceTableReplica() *schema.Resource {
 			"table_class_override": { // through main table
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.TableClass](),
 			},
 			names.AttrTags:    tftags.TagsSchema(),         // direct to replica
@@ -265,6 +267,7 @@ func resourceTableReplicaReadReplica(ctx context.Context, d *schema.ResourceData
 	var
 	// handled direct to replica
 	// * arn
+	// * deletion_protection_enabled
 	// * point_in_time_recovery
 	// * tags
 	diags diag.Diagnostics
@@ -288,6 +291,7 @@ func resourceTableReplicaReadReplica(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set(names.AttrARN, table.TableArn)
+	d.Set("deletion_protection_enabled", table.DeletionProtectionEnabled)
 
 	pitrOut, err := conn.DescribeContinuousBackups(ctx, &dynamodb.DescribeContinuousBackupsInput{
 		TableName: aws.String(tableName),
@@ -361,6 +365,13 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("table_class_override") && !d.IsNewResource() {
+		if v, ok := d.GetOk("table_class_override"); ok {
+			viaMainChanges = true
+			viaMainInput.TableClassOverride = awstypes.TableClass(v.(string))
+		}
+	}
+
 	if viaMainChanges {
 		input := &dynamodb.UpdateTableInput{
 			ReplicaUpdates: []awstypes.ReplicationGroupUpdate{{
@@ -401,9 +412,10 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	// handled direct to replica
+	// * deletion_protection_enabled
 	// * point_in_time_recovery
 	// * tags
-	if d.HasChanges("point_in_time_recovery", names.AttrTagsAll) {
+	if d.HasChanges("deletion_protection_enabled", "point_in_time_recovery", names.AttrTagsAll) {
 		if d.HasChange(names.AttrTagsAll) {
 			o, n := d.GetChange(names.AttrTagsAll)
 			if err := updateTags(ctx, conn, d.Get(names.AttrARN).(string), o, n); err != nil {
@@ -411,6 +423,23 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 			}
 		}
 
+		if d.HasChange("deletion_protection_enabled") {
+			input := &dynamodb.UpdateTableInput{
+				DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+				TableName:                 aws.String(tableName),
+			}
+
+			if _, err := tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+				return conn.UpdateTable(ctx, input)
+			}); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTableReplica, d.Id(), fmt.Errorf("deletion protection: %w", err))
+			}
+
+			if _, err := waitTableActive(ctx, conn, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForUpdate, resNameTableReplica, d.Id(), err)
+			}
+		}
+
 		if d.HasChange("point_in_time_recovery") {
 			if err := updatePITR(ctx, conn, tableName, d.Get("point_in_time_recovery").(bool), replicaRegion, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTableReplica, d.Id(), err)
@@ -209,6 +209,70 @@ func TestUpdateDiffGSI(t *testing.T) {
 			},
 		},
 
+		{ // Update of on-demand and warm throughput
+			Old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"on_demand_throughput": []interface{}{
+						map[string]interface{}{
+							"max_read_request_units":  5,
+							"max_write_request_units": 5,
+						},
+					},
+					"warm_throughput": []interface{}{},
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"on_demand_throughput": []interface{}{
+						map[string]interface{}{
+							"max_read_request_units":  10,
+							"max_write_request_units": 5,
+						},
+					},
+					"warm_throughput": []interface{}{
+						map[string]interface{}{
+							"read_units_per_second":  12100,
+							"write_units_per_second": 4100,
+						},
+					},
+				},
+			},
+			ExpectedUpdates: []awstypes.GlobalSecondaryIndexUpdate{
+				{
+					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						OnDemandThroughput: &awstypes.OnDemandThroughput{
+							MaxReadRequestUnits:  aws.Int64(10),
+							MaxWriteRequestUnits: aws.Int64(5),
+						},
+						ProvisionedThroughput: &awstypes.ProvisionedThroughput{
+							WriteCapacityUnits: aws.Int64(10),
+							ReadCapacityUnits:  aws.Int64(10),
+						},
+					},
+				},
+				{
+					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						WarmThroughput: &awstypes.WarmThroughput{
+							ReadUnitsPerSecond:  aws.Int64(12100),
+							WriteUnitsPerSecond: aws.Int64(4100),
+						},
+					},
+				},
+			},
+		},
+
 		{ // Update of non-capacity attributes
 			Old: []interface{}{
 				map[string]interface{}{
@@ -434,6 +498,243 @@ func TestAccDynamoDBTable_deletion_protection(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_resourcePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_resourcePolicy(rName, "dynamodb:GetItem"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "resource_policy", regexache.MustCompile(`dynamodb:GetItem`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_policy"},
+			},
+			{
+				Config: testAccTableConfig_resourcePolicy(rName, "dynamodb:Query"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "resource_policy", regexache.MustCompile(`dynamodb:Query`)),
+				),
+			},
+			{
+				// Removing the argument leaves the policy in place.
+				Config: testAccTableConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "resource_policy", regexache.MustCompile(`dynamodb:Query`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_resourcePolicyManagedSeparately(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_resourcePolicyManagedSeparately(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "resource_policy", ""),
+				),
+			},
+			{
+				Config:   testAccTableConfig_resourcePolicyManagedSeparately(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_onDemandThroughput(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_onDemandThroughput(rName, 5, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_read_request_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_write_request_units", acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"on_demand_throughput.#":                         acctest.Ct1,
+						"on_demand_throughput.0.max_read_request_units":  "5",
+						"on_demand_throughput.0.max_write_request_units": acctest.Ct10,
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"global_secondary_index"},
+			},
+			{
+				Config: testAccTableConfig_onDemandThroughput(rName, 10, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_read_request_units", acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_write_request_units", "20"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"on_demand_throughput.0.max_read_request_units":  acctest.Ct10,
+						"on_demand_throughput.0.max_write_request_units": "20",
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_onDemandThroughputRemoved(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_read_request_units", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_write_request_units", "-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_warmThroughput(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_warmThroughput(rName, 12100, 4100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.read_units_per_second", "12100"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.write_units_per_second", "4100"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"warm_throughput.#":                        acctest.Ct1,
+						"warm_throughput.0.read_units_per_second":  "12100",
+						"warm_throughput.0.write_units_per_second": "4100",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"global_secondary_index"},
+			},
+			{
+				Config: testAccTableConfig_warmThroughput(rName, 12200, 4200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.read_units_per_second", "12200"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.write_units_per_second", "4200"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"warm_throughput.0.read_units_per_second":  "12200",
+						"warm_throughput.0.write_units_per_second": "4200",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_streamResourcePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_streamResourcePolicy(rName, "KEYS_ONLY", "dynamodb:DescribeStream"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "stream_resource_policy", regexache.MustCompile(`dynamodb:DescribeStream`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stream_resource_policy"},
+			},
+			{
+				Config: testAccTableConfig_streamResourcePolicy(rName, "KEYS_ONLY", "dynamodb:GetRecords"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "stream_resource_policy", regexache.MustCompile(`dynamodb:GetRecords`)),
+				),
+			},
+			{
+				// Changing the view type replaces the stream.
+				Config: testAccTableConfig_streamResourcePolicy(rName, "NEW_IMAGE", "dynamodb:GetRecords"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "stream_view_type", "NEW_IMAGE"),
+					resource.TestMatchResourceAttr(resourceName, "stream_resource_policy", regexache.MustCompile(`dynamodb:GetRecords`)),
+				),
+			},
+			{
+				// Removing the argument leaves the policy in place.
+				Config: testAccTableConfig_streamSpecification(rName, true, "NEW_IMAGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "stream_resource_policy", regexache.MustCompile(`dynamodb:GetRecords`)),
+				),
+			},
+			{
+				// A new stream has no resource policy.
+				Config: testAccTableConfig_streamSpecification(rName, true, "KEYS_ONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "stream_resource_policy", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.TableDescription
@@ -2726,6 +3027,9 @@ func TestAccDynamoDBTable_importTable(t *testing.T) {
 						names.AttrType: "S",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "table_class", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "import_table.0.import_arn"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.imported_item_count", acctest.Ct1),
 				),
 			},
 		},
@@ -2902,6 +3206,204 @@ resource "aws_dynamodb_table" "test" {
 `, rName)
 }
 
+func testAccTableConfig_resourcePolicy(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = %[1]q
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+
+  resource_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = %[2]q
+      Resource = "arn:${data.aws_partition.current.partition}:dynamodb:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:table/%[1]s"
+    }]
+  })
+}
+`, rName, action)
+}
+
+func testAccTableConfig_resourcePolicyManagedSeparately(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = %[1]q
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_resource_policy" "test" {
+  resource_arn = aws_dynamodb_table.test.arn
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "dynamodb:GetItem"
+      Resource = aws_dynamodb_table.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccTableConfig_onDemandThroughput(rName string, maxRead, maxWrite int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  on_demand_throughput {
+    max_read_request_units  = %[2]d
+    max_write_request_units = %[3]d
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+
+    on_demand_throughput {
+      max_read_request_units  = %[2]d
+      max_write_request_units = %[3]d
+    }
+  }
+}
+`, rName, maxRead, maxWrite)
+}
+
+func testAccTableConfig_onDemandThroughputRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+  }
+}
+`, rName)
+}
+
+func testAccTableConfig_warmThroughput(rName string, readUnits, writeUnits int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  warm_throughput {
+    read_units_per_second  = %[2]d
+    write_units_per_second = %[3]d
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+
+    warm_throughput {
+      read_units_per_second  = %[2]d
+      write_units_per_second = %[3]d
+    }
+  }
+}
+`, rName, readUnits, writeUnits)
+}
+
+func testAccTableConfig_streamResourcePolicy(rName, viewType, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  read_capacity    = 1
+  write_capacity   = 1
+  hash_key         = "TestTableHashKey"
+  stream_enabled   = true
+  stream_view_type = %[2]q
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  stream_resource_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = %[3]q
+      Resource = "arn:${data.aws_partition.current.partition}:dynamodb:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:table/%[1]s/stream/*"
+    }]
+  })
+}
+`, rName, viewType, action)
+}
+
 func testAccTableConfig_enable_deletion_protection(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
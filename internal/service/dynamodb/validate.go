@@ -36,6 +36,10 @@ func validStreamSpec(d *schema.ResourceDiff) error {
 		}
 		return errors.New("stream_view_type is required when stream_enabled = true")
 	}
+	// stream_resource_policy is Optional+Computed, so check the configuration rather than the planned value.
+	if v := d.GetRawConfig().GetAttr("stream_resource_policy"); v.IsKnown() && !v.IsNull() && v.AsString() != "" {
+		return errors.New("stream_resource_policy requires stream_enabled = true")
+	}
 	return nil
 }
 
@@ -283,6 +283,18 @@ func outsideIPAddressType_Values() []string {
 	}
 }
 
+const (
+	vpnConnectionPreSharedKeyStorageSecretsManager = "SecretsManager"
+	vpnConnectionPreSharedKeyStorageStandard       = "Standard"
+)
+
+func vpnConnectionPreSharedKeyStorage_Values() []string {
+	return []string{
+		vpnConnectionPreSharedKeyStorageSecretsManager,
+		vpnConnectionPreSharedKeyStorageStandard,
+	}
+}
+
 type securityGroupRuleType string
 
 const (
@@ -313,25 +325,31 @@ func verifiedAccessAttachmentType_Values() []string {
 }
 
 const (
+	verifiedAccessEndpointTypeCIDR             = "cidr"
 	verifiedAccessEndpointTypeLoadBalancer     = "load-balancer"
 	verifiedAccessEndpointTypeNetworkInterface = "network-interface"
+	verifiedAccessEndpointTypeRDS              = "rds"
 )
 
 func verifiedAccessEndpointType_Values() []string {
 	return []string{
+		verifiedAccessEndpointTypeCIDR,
 		verifiedAccessEndpointTypeLoadBalancer,
 		verifiedAccessEndpointTypeNetworkInterface,
+		verifiedAccessEndpointTypeRDS,
 	}
 }
 
 const (
 	verifiedAccessEndpointProtocolHTTP  = "http"
 	verifiedAccessEndpointProtocolHTTPS = "https"
+	verifiedAccessEndpointProtocolTCP   = "tcp"
 )
 
 func verifiedAccessEndpointProtocol_Values() []string {
 	return []string{
 		verifiedAccessEndpointProtocolHTTP,
 		verifiedAccessEndpointProtocolHTTPS,
+		verifiedAccessEndpointProtocolTCP,
 	}
 }
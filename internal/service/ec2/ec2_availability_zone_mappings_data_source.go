@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_availability_zone_mappings", name="Availability Zone Mappings")
+func dataSourceAvailabilityZoneMappings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAvailabilityZoneMappingsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"all_availability_zones": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrFilter: customFiltersSchema(),
+			"mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name_to_zone_id": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zone_id_to_name": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAvailabilityZoneMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.DescribeAvailabilityZonesInput{}
+
+	if v, ok := d.GetOk("all_availability_zones"); ok {
+		input.AllAvailabilityZones = aws.Bool(v.(bool))
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterList(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	azs, err := findAvailabilityZones(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Availability Zones: %s", err)
+	}
+
+	// Zone IDs identify the same physical location in every account, so order by them.
+	sort.Slice(azs, func(i, j int) bool {
+		return aws.ToString(azs[i].ZoneId) < aws.ToString(azs[j].ZoneId)
+	})
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("mappings", flattenAvailabilityZoneMappings(azs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mappings: %s", err)
+	}
+	nameToZoneID := make(map[string]string, len(azs))
+	zoneIDToName := make(map[string]string, len(azs))
+	for _, v := range azs {
+		name, zoneID := aws.ToString(v.ZoneName), aws.ToString(v.ZoneId)
+		nameToZoneID[name] = zoneID
+		zoneIDToName[zoneID] = name
+	}
+	d.Set("name_to_zone_id", nameToZoneID)
+	d.Set("zone_id_to_name", zoneIDToName)
+
+	return diags
+}
+
+func flattenAvailabilityZoneMappings(apiObjects []awstypes.AvailabilityZone) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"group_name":    aws.ToString(apiObject.GroupName),
+			names.AttrName:  aws.ToString(apiObject.ZoneName),
+			names.AttrState: string(apiObject.State),
+			"zone_id":       aws.ToString(apiObject.ZoneId),
+			"zone_type":     aws.ToString(apiObject.ZoneType),
+		})
+	}
+
+	return tfList
+}
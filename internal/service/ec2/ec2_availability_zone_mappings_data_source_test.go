@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2AvailabilityZoneMappingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_availability_zone_mappings.test"
+	azsDataSourceName := "data.aws_availability_zones.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZoneMappingsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "mappings.#", azsDataSourceName, "zone_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name_to_zone_id.%", azsDataSourceName, "names.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id_to_name.%", azsDataSourceName, "zone_ids.#"),
+					testAccCheckAvailabilityZoneMappingsConsistent(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AvailabilityZoneMappingsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_availability_zone_mappings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZoneMappingsDataSourceConfig_filter,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "mappings.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mappings.0.name", "data.aws_availability_zones.test", "names.0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mappings.0.zone_id", "data.aws_availability_zones.test", "zone_ids.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "mappings.0.zone_type", "availability-zone"),
+					testAccCheckAvailabilityZoneMappingsConsistent(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAvailabilityZoneMappingsConsistent(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attrs := rs.Primary.Attributes
+		for i := 0; ; i++ {
+			name, ok := attrs[fmt.Sprintf("mappings.%d.name", i)]
+			if !ok {
+				break
+			}
+			zoneID := attrs[fmt.Sprintf("mappings.%d.zone_id", i)]
+
+			if got := attrs["name_to_zone_id."+name]; got != zoneID {
+				return fmt.Errorf("name_to_zone_id[%s] = %q, expected %q", name, got, zoneID)
+			}
+			if got := attrs["zone_id_to_name."+zoneID]; got != name {
+				return fmt.Errorf("zone_id_to_name[%s] = %q, expected %q", zoneID, got, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccAvailabilityZoneMappingsDataSourceConfig_basic = `
+data "aws_availability_zone_mappings" "test" {}
+
+data "aws_availability_zones" "test" {}
+`
+
+const testAccAvailabilityZoneMappingsDataSourceConfig_filter = `
+data "aws_availability_zones" "test" {
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+data "aws_availability_zone_mappings" "test" {
+  filter {
+    name   = "zone-id"
+    values = [data.aws_availability_zones.test.zone_ids[0]]
+  }
+}
+`
@@ -128,6 +128,7 @@ func resourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"connection_tracking": networkInterfaceConnectionTrackingSchema(),
 			"cpu_options": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -312,6 +313,7 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"ena_srd": networkInterfaceENASRDSchema(),
 			"enclave_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -586,7 +588,7 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"network_interface": {
-				ConflictsWith: []string{"associate_public_ip_address", names.AttrSubnetID, "private_ip", "secondary_private_ips", names.AttrVPCSecurityGroupIDs, names.AttrSecurityGroups, "ipv6_addresses", "ipv6_address_count", "source_dest_check"},
+				ConflictsWith: []string{"associate_public_ip_address", names.AttrSubnetID, "private_ip", "secondary_private_ips", names.AttrVPCSecurityGroupIDs, names.AttrSecurityGroups, "ipv6_addresses", "ipv6_address_count", "source_dest_check", "connection_tracking", "ena_srd"},
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Computed:      true,
@@ -1052,6 +1054,19 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) create: %s", d.Id(), err)
 	}
 
+	// Without an explicit network interface specification (e.g. no subnet_id in a default VPC),
+	// connection tracking and ENA Express are applied to the primary network interface after launch.
+	if len(input.NetworkInterfaces) == 0 {
+		_, connectionTracking := d.GetOk("connection_tracking")
+		_, enaSRD := d.GetOk("ena_srd")
+
+		if connectionTracking || enaSRD {
+			if err := updateInstancePrimaryNetworkInterfaceAttributes(ctx, conn, d, connectionTracking, enaSRD); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	// Initialize the connection info
 	if instance.PublicIpAddress != nil {
 		d.SetConnInfo(map[string]string{
@@ -1284,6 +1299,20 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			if primaryNetworkInterface.SourceDestCheck != nil { // nosemgrep: ci.helper-schema-ResourceData-Set-extraneous-nil-check
 				d.Set("source_dest_check", primaryNetworkInterface.SourceDestCheck)
 			}
+			if v := primaryNetworkInterface.ConnectionTrackingConfiguration; v != nil {
+				if err := d.Set("connection_tracking", []interface{}{flattenConnectionTrackingSpecificationResponse(v)}); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting connection_tracking: %s", err)
+				}
+			} else {
+				d.Set("connection_tracking", nil)
+			}
+			if v := primaryNetworkInterface.Attachment; v != nil && v.EnaSrdSpecification != nil {
+				if err := d.Set("ena_srd", []interface{}{flattenInstanceAttachmentEnaSrdSpecification(v.EnaSrdSpecification)}); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting ena_srd: %s", err)
+				}
+			} else {
+				d.Set("ena_srd", nil)
+			}
 
 			d.Set("associate_public_ip_address", primaryNetworkInterface.Association != nil)
 
@@ -1628,6 +1657,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Connection tracking and ENA Express are configured on the primary network interface.
+	if d.HasChanges("connection_tracking", "ena_srd") {
+		if err := updateInstancePrimaryNetworkInterfaceAttributes(ctx, conn, d, d.HasChange("connection_tracking"), d.HasChange("ena_srd")); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("ipv6_address_count") && !d.IsNewResource() {
 		instance, err := findInstanceByID(ctx, conn, d.Id())
 		if err != nil {
@@ -2471,6 +2507,56 @@ func findRootDeviceName(ctx context.Context, conn *ec2.Client, amiID string) (*s
 	return rootDeviceName, nil
 }
 
+func updateInstancePrimaryNetworkInterfaceAttributes(ctx context.Context, conn *ec2.Client, d *schema.ResourceData, connectionTracking, enaSRD bool) error {
+	instance, err := findInstanceByID(ctx, conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance (%s): %w", d.Id(), err)
+	}
+
+	var primaryInterface awstypes.InstanceNetworkInterface
+	for _, ni := range instance.NetworkInterfaces {
+		if aws.ToInt32(ni.Attachment.DeviceIndex) == 0 {
+			primaryInterface = ni
+		}
+	}
+
+	if primaryInterface.NetworkInterfaceId == nil {
+		return fmt.Errorf("Failed to update connection_tracking or ena_srd on %q, which does not contain a primary network interface", d.Id())
+	}
+
+	if connectionTracking {
+		if v, ok := d.GetOk("connection_tracking"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &ec2.ModifyNetworkInterfaceAttributeInput{
+				ConnectionTrackingSpecification: expandConnectionTrackingSpecificationRequest(v.([]interface{})[0].(map[string]interface{})),
+				NetworkInterfaceId:              primaryInterface.NetworkInterfaceId,
+			}
+
+			_, err := conn.ModifyNetworkInterfaceAttribute(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("modifying EC2 Instance (%s) primary network interface ConnectionTrackingSpecification: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if enaSRD {
+		if v, ok := d.GetOk("ena_srd"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &ec2.ModifyNetworkInterfaceAttributeInput{
+				EnaSrdSpecification: expandEnaSrdSpecification(v.([]interface{})[0].(map[string]interface{})),
+				NetworkInterfaceId:  primaryInterface.NetworkInterfaceId,
+			}
+
+			_, err := conn.ModifyNetworkInterfaceAttribute(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("modifying EC2 Instance (%s) primary network interface EnaSrdSpecification: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []string, nInterfaces interface{}) []awstypes.InstanceNetworkInterfaceSpecification {
 	networkInterfaces := []awstypes.InstanceNetworkInterfaceSpecification{}
 	// Get necessary items
@@ -2523,6 +2609,14 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []string, nInterfa
 			}
 		}
 
+		if v, ok := d.GetOk("connection_tracking"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			ni.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("ena_srd"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			ni.EnaSrdSpecification = expandEnaSrdSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		networkInterfaces = append(networkInterfaces, ni)
 	} else {
 		// If we have manually specified network interfaces, build and attach those here.
@@ -3038,10 +3132,12 @@ func buildInstanceOpts(ctx context.Context, d *schema.ResourceData, meta interfa
 	_, assocPubIPA := d.GetOkExists("associate_public_ip_address")
 	_, privIP := d.GetOk("private_ip")
 	_, secPrivIP := d.GetOk("secondary_private_ips")
+	_, connectionTracking := d.GetOk("connection_tracking")
+	_, enaSRD := d.GetOk("ena_srd")
 	networkInterfaces, interfacesOk := d.GetOk("network_interface")
 
-	// If setting subnet and public address, OR manual network interfaces, populate those now.
-	if (hasSubnet && (assocPubIPA || privIP || secPrivIP)) || interfacesOk {
+	// If setting subnet and public address (or primary network interface attributes), OR manual network interfaces, populate those now.
+	if (hasSubnet && (assocPubIPA || privIP || secPrivIP || connectionTracking || enaSRD)) || interfacesOk {
 		// Otherwise we're attaching (a) network interface(s)
 		opts.NetworkInterfaces = buildNetworkInterfaceOpts(d, groups, networkInterfaces)
 	} else {
@@ -3708,6 +3804,46 @@ func flattenInstanceMaintenanceOptions(apiObject *awstypes.InstanceMaintenanceOp
 	return tfMap
 }
 
+func flattenConnectionTrackingSpecificationResponse(apiObject *awstypes.ConnectionTrackingSpecificationResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TcpEstablishedTimeout; v != nil {
+		tfMap["tcp_established_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpStreamTimeout; v != nil {
+		tfMap["udp_stream_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpTimeout; v != nil {
+		tfMap["udp_timeout"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func flattenInstanceAttachmentEnaSrdSpecification(apiObject *awstypes.InstanceAttachmentEnaSrdSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnaSrdEnabled; v != nil {
+		tfMap[names.AttrEnabled] = aws.ToBool(v)
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["udp_enabled"] = aws.ToBool(v.EnaSrdUdpEnabled)
+	}
+
+	return tfMap
+}
+
 func expandPrivateDNSNameOptionsRequest(tfMap map[string]interface{}) *awstypes.PrivateDnsNameOptionsRequest {
 	if tfMap == nil {
 		return nil
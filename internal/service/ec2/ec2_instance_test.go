@@ -1898,6 +1898,54 @@ func TestAccEC2Instance_privateIP(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_primaryNetworkInterfaceAttributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_primaryNetworkInterfaceAttributes(rName, 3600, 180, 60, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.tcp_established_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_stream_timeout", "180"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd.0.udp_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_primaryNetworkInterfaceAttributes(rName, 7200, 120, 30, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.tcp_established_timeout", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_stream_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_timeout", "30"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd.0.udp_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_associatePublicIPAndPrivateIP(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -7458,6 +7506,66 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_primaryNetworkInterfaceAttributes(rName string, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout int, enaSRDEnabled, enaSRDUDPEnabled bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+# ENA Express is only available on a subset of instance types.
+data "aws_ec2_instance_types" "test" {
+  filter {
+    name   = "network-info.ena-srd-supported"
+    values = ["true"]
+  }
+
+  filter {
+    name   = "processor-info.supported-architecture"
+    values = ["x86_64"]
+  }
+
+  filter {
+    name   = "instance-type"
+    values = ["c6in.*", "m6in.*", "r6in.*"]
+  }
+}
+
+data "aws_ec2_instance_type_offerings" "test" {
+  filter {
+    name   = "instance-type"
+    values = data.aws_ec2_instance_types.test.instance_types
+  }
+
+  filter {
+    name   = "location"
+    values = [aws_subnet.test.availability_zone]
+  }
+
+  location_type = "availability-zone"
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = sort(data.aws_ec2_instance_type_offerings.test.instance_types)[0]
+  subnet_id     = aws_subnet.test.id
+
+  connection_tracking {
+    tcp_established_timeout = %[2]d
+    udp_stream_timeout      = %[3]d
+    udp_timeout             = %[4]d
+  }
+
+  ena_srd {
+    enabled     = %[5]t
+    udp_enabled = %[6]t
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout, enaSRDEnabled, enaSRDUDPEnabled))
+}
+
 func testAccInstanceConfig_associatePublicIPAndPrivateIP(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
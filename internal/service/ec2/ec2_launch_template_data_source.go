@@ -613,6 +613,26 @@ func dataSourceLaunchTemplate() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"connection_tracking": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tcp_established_timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"udp_stream_timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"udp_timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						names.AttrDeleteOnTermination: {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -625,6 +645,22 @@ func dataSourceLaunchTemplate() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"ena_srd": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"udp_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"interface_type": {
 							Type:     schema.TypeString,
 							Computed: true,
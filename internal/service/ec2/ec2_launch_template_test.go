@@ -980,6 +980,50 @@ func TestAccEC2LaunchTemplate_networkInterfaceCardIndex(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_networkInterfaceConnectionTrackingAndENASRD(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_networkInterfaceConnectionTrackingAndENASRD(rName, 3600, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking.0.tcp_established_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking.0.udp_stream_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking.0.udp_timeout", "45"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd.0.udp_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchTemplateConfig_networkInterfaceConnectionTrackingAndENASRD(rName, 7200, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking.0.tcp_established_timeout", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd.0.udp_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_networkInterfaceIPv4PrefixCount(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template awstypes.LaunchTemplate
@@ -4000,6 +4044,28 @@ resource "aws_launch_template" "test" {
 `, rName)
 }
 
+func testAccLaunchTemplateConfig_networkInterfaceConnectionTrackingAndENASRD(rName string, tcpEstablishedTimeout int, enaSRDEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "c6in.large"
+
+  network_interfaces {
+    connection_tracking {
+      tcp_established_timeout = %[2]d
+      udp_stream_timeout      = 120
+      udp_timeout             = 45
+    }
+
+    ena_srd {
+      enabled     = %[3]t
+      udp_enabled = %[3]t
+    }
+  }
+}
+`, rName, tcpEstablishedTimeout, enaSRDEnabled)
+}
+
 func testAccLaunchTemplateConfig_networkInterfaceIPv4PrefixCount(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
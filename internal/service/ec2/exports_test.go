@@ -213,6 +213,7 @@ var (
 	FindTransitGatewayRouteTableByID                           = findTransitGatewayRouteTableByID
 	FindTransitGatewayRouteTablePropagationByTwoPartKey        = findTransitGatewayRouteTablePropagationByTwoPartKey
 	FindTransitGatewayStaticRoute                              = findTransitGatewayStaticRoute
+	FindTransitGatewayStaticRoutesByRouteTableID               = findTransitGatewayStaticRoutesByRouteTableID
 	FindTransitGatewayVPCAttachmentByID                        = findTransitGatewayVPCAttachmentByID
 	FindVPCCIDRBlockAssociationByID                            = findVPCCIDRBlockAssociationByID
 	FindVPCDHCPOptionsAssociation                              = findVPCDHCPOptionsAssociation
@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"slices"
 	"strconv"
 
@@ -4945,6 +4946,100 @@ func findTransitGatewayRoutes(ctx context.Context, conn *ec2.Client, input *ec2.
 	return output.Routes, err
 }
 
+// findTransitGatewayStaticRoutesByRouteTableID returns all static routes to CIDR block destinations in the specified route table.
+// SearchTransitGatewayRoutes does not support pagination, so when a search returns the maximum number of results
+// the address space being searched is split in two and each half is searched separately.
+func findTransitGatewayStaticRoutesByRouteTableID(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string) ([]awstypes.TransitGatewayRoute, error) {
+	var routes []awstypes.TransitGatewayRoute
+
+	for _, v := range []string{"0.0.0.0/0", "::/0"} {
+		output, err := findTransitGatewayStaticRoutesInCIDRBlock(ctx, conn, transitGatewayRouteTableID, netip.MustParsePrefix(v))
+
+		if err != nil {
+			return nil, err
+		}
+
+		routes = append(routes, output...)
+	}
+
+	return routes, nil
+}
+
+func findTransitGatewayStaticRoutesInCIDRBlock(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, prefix netip.Prefix) ([]awstypes.TransitGatewayRoute, error) {
+	routes, additionalRoutesAvailable, err := findTransitGatewayStaticRoutesByFilter(ctx, conn, transitGatewayRouteTableID, "route-search.subnet-of-match", prefix.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !additionalRoutesAvailable {
+		return routes, nil
+	}
+
+	if prefix.Bits() >= prefix.Addr().BitLen() {
+		return nil, fmt.Errorf("too many EC2 Transit Gateway Routes to %s", prefix)
+	}
+
+	// The route to the prefix itself is not part of either half.
+	routes, _, err = findTransitGatewayStaticRoutesByFilter(ctx, conn, transitGatewayRouteTableID, "route-search.exact-match", prefix.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	lower := netip.PrefixFrom(prefix.Addr(), prefix.Bits()+1)
+	b := prefix.Addr().AsSlice()
+	b[prefix.Bits()/8] |= 0x80 >> (prefix.Bits() % 8)
+	upperAddr, _ := netip.AddrFromSlice(b)
+	upper := netip.PrefixFrom(upperAddr, prefix.Bits()+1)
+
+	for _, v := range []netip.Prefix{lower, upper} {
+		output, err := findTransitGatewayStaticRoutesInCIDRBlock(ctx, conn, transitGatewayRouteTableID, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		routes = append(routes, output...)
+	}
+
+	return routes, nil
+}
+
+func findTransitGatewayStaticRoutesByFilter(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID, filterName, filterValue string) ([]awstypes.TransitGatewayRoute, bool, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			names.AttrType: string(awstypes.TransitGatewayRouteTypeStatic),
+			filterName:     filterValue,
+		}),
+		MaxResults:                 aws.Int32(transitGatewayRouteSearchMaxResults),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	output, err := conn.SearchTransitGatewayRoutes(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+		return nil, false, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if output == nil {
+		return nil, false, tfresource.NewEmptyResultError(input)
+	}
+
+	routes := tfslices.Filter(output.Routes, func(v awstypes.TransitGatewayRoute) bool {
+		return aws.ToString(v.DestinationCidrBlock) != "" && v.State != awstypes.TransitGatewayRouteStateDeleted
+	})
+
+	return routes, aws.ToBool(output.AdditionalRoutesAvailable), nil
+}
+
 func findTransitGatewayPolicyTable(ctx context.Context, conn *ec2.Client, input *ec2.DescribeTransitGatewayPolicyTablesInput) (*awstypes.TransitGatewayPolicyTable, error) {
 	output, err := findTransitGatewayPolicyTables(ctx, conn, input)
 
@@ -71,6 +71,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newTransitGatewayRouteTableRoutesExclusiveResource,
+			Name:    "Transit Gateway Route Table Routes Exclusive",
+		},
 		{
 			Factory: newVPCEndpointPrivateDNSResource,
 			Name:    "VPC Endpoint Private DNS",
@@ -100,6 +104,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_availability_zone",
 			Name:     "Availability Zone",
 		},
+		{
+			Factory:  dataSourceAvailabilityZoneMappings,
+			TypeName: "aws_availability_zone_mappings",
+			Name:     "Availability Zone Mappings",
+		},
 		{
 			Factory:  dataSourceAvailabilityZones,
 			TypeName: "aws_availability_zones",
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Maximum number of results returned by a single SearchTransitGatewayRoutes call.
+	transitGatewayRouteSearchMaxResults = 1000
+	// Maximum number of concurrent route create, replace or delete operations.
+	transitGatewayRoutesExclusiveMaxConcurrency = 10
+)
+
+// @FrameworkResource("aws_ec2_transit_gateway_route_table_routes_exclusive", name="Transit Gateway Route Table Routes Exclusive")
+func newTransitGatewayRouteTableRoutesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &transitGatewayRouteTableRoutesExclusiveResource{}, nil
+}
+
+type transitGatewayRouteTableRoutesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*transitGatewayRouteTableRoutesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_transit_gateway_route_table_routes_exclusive"
+}
+
+func (r *transitGatewayRouteTableRoutesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"transit_gateway_route_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"route": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[transitGatewayStaticRouteModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"blackhole": schema.BoolAttribute{
+							Optional: true,
+						},
+						"destination_cidr_block": schema.StringAttribute{
+							CustomType: fwtypes.CIDRBlockType,
+							Required:   true,
+						},
+						names.AttrTransitGatewayAttachmentID: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *transitGatewayRouteTableRoutesExclusiveResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data transitGatewayRouteTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Routes.IsNull() || data.Routes.IsUnknown() {
+		return
+	}
+
+	routes, diags := data.Routes.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	destinations := make(map[string]struct{})
+	for _, route := range routes {
+		if route.DestinationCIDRBlock.IsUnknown() || route.Blackhole.IsUnknown() || route.TransitGatewayAttachmentID.IsUnknown() {
+			continue
+		}
+
+		destination := itypes.CanonicalCIDRBlock(route.DestinationCIDRBlock.ValueString())
+		if _, ok := destinations[destination]; ok {
+			response.Diagnostics.AddAttributeError(path.Root("route"), "Duplicate route", fmt.Sprintf("Multiple routes to destination %s are configured.", destination))
+		}
+		destinations[destination] = struct{}{}
+
+		if route.Blackhole.ValueBool() == (route.TransitGatewayAttachmentID.ValueString() != "") {
+			response.Diagnostics.AddAttributeError(path.Root("route"), "Invalid route", fmt.Sprintf("Route to destination %s must specify exactly one of transit_gateway_attachment_id or blackhole = true.", destination))
+		}
+	}
+}
+
+func (r *transitGatewayRouteTableRoutesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data transitGatewayRouteTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncRoutes(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *transitGatewayRouteTableRoutesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data transitGatewayRouteTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := data.TransitGatewayRouteTableID.ValueString()
+	output, err := findTransitGatewayStaticRoutesByRouteTableID(ctx, conn, routeTableID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Transit Gateway Route Table Routes Exclusive (%s)", routeTableID), err.Error())
+
+		return
+	}
+
+	// Retain the prior representation of routes that are unchanged.
+	prior := make(map[string]transitGatewayStaticRouteModel)
+	if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
+		routes, diags := data.Routes.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, route := range routes {
+			prior[route.destination()] = *route
+		}
+	}
+
+	routes := make([]transitGatewayStaticRouteModel, 0, len(output))
+	for _, apiObject := range output {
+		route := flattenTransitGatewayStaticRoute(apiObject)
+
+		if v, ok := prior[route.destination()]; ok && v.equivalent(route) {
+			route = v
+		}
+
+		routes = append(routes, route)
+	}
+
+	data.Routes = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, routes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transitGatewayRouteTableRoutesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data transitGatewayRouteTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncRoutes(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transitGatewayRouteTableRoutesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("transit_gateway_route_table_id"), request, response)
+}
+
+// syncRoutes creates the configured static routes that do not exist, replaces those whose target differs
+// and deletes any existing static routes that are not configured.
+func (r *transitGatewayRouteTableRoutesExclusiveResource) syncRoutes(ctx context.Context, data transitGatewayRouteTableRoutesExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := data.TransitGatewayRouteTableID.ValueString()
+	output, err := findTransitGatewayStaticRoutesByRouteTableID(ctx, conn, routeTableID)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading EC2 Transit Gateway Route Table Routes Exclusive (%s)", routeTableID), err.Error())
+
+		return diags
+	}
+
+	have := make(map[string]transitGatewayStaticRouteModel)
+	for _, apiObject := range output {
+		route := flattenTransitGatewayStaticRoute(apiObject)
+		have[route.destination()] = route
+	}
+
+	routes, d := data.Routes.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	want := make(map[string]transitGatewayStaticRouteModel)
+	for _, route := range routes {
+		want[route.destination()] = *route
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(transitGatewayRoutesExclusiveMaxConcurrency)
+
+	for destination, route := range want {
+		v, ok := have[destination]
+
+		switch {
+		case !ok:
+			g.Go(func() error {
+				input := &ec2.CreateTransitGatewayRouteInput{
+					Blackhole:                  aws.Bool(route.Blackhole.ValueBool()),
+					DestinationCidrBlock:       aws.String(destination),
+					TransitGatewayAttachmentId: route.TransitGatewayAttachmentID.ValueStringPointer(),
+					TransitGatewayRouteTableId: aws.String(routeTableID),
+				}
+
+				if _, err := conn.CreateTransitGatewayRoute(ctx, input); err != nil {
+					return fmt.Errorf("creating EC2 Transit Gateway Route (%s): %w", transitGatewayRouteCreateResourceID(routeTableID, destination), err)
+				}
+
+				if _, err := waitTransitGatewayRouteCreated(ctx, conn, routeTableID, destination); err != nil {
+					return fmt.Errorf("waiting for EC2 Transit Gateway Route (%s) create: %w", transitGatewayRouteCreateResourceID(routeTableID, destination), err)
+				}
+
+				return nil
+			})
+		case !v.equivalent(route):
+			g.Go(func() error {
+				input := &ec2.ReplaceTransitGatewayRouteInput{
+					Blackhole:                  aws.Bool(route.Blackhole.ValueBool()),
+					DestinationCidrBlock:       aws.String(destination),
+					TransitGatewayAttachmentId: route.TransitGatewayAttachmentID.ValueStringPointer(),
+					TransitGatewayRouteTableId: aws.String(routeTableID),
+				}
+
+				if _, err := conn.ReplaceTransitGatewayRoute(ctx, input); err != nil {
+					return fmt.Errorf("replacing EC2 Transit Gateway Route (%s): %w", transitGatewayRouteCreateResourceID(routeTableID, destination), err)
+				}
+
+				return nil
+			})
+		}
+	}
+
+	for destination := range have {
+		if _, ok := want[destination]; ok {
+			continue
+		}
+
+		g.Go(func() error {
+			log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route: %s", transitGatewayRouteCreateResourceID(routeTableID, destination))
+			_, err := conn.DeleteTransitGatewayRoute(ctx, &ec2.DeleteTransitGatewayRouteInput{
+				DestinationCidrBlock:       aws.String(destination),
+				TransitGatewayRouteTableId: aws.String(routeTableID),
+			})
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting EC2 Transit Gateway Route (%s): %w", transitGatewayRouteCreateResourceID(routeTableID, destination), err)
+			}
+
+			if _, err := waitTransitGatewayRouteDeleted(ctx, conn, routeTableID, destination); err != nil {
+				return fmt.Errorf("waiting for EC2 Transit Gateway Route (%s) delete: %w", transitGatewayRouteCreateResourceID(routeTableID, destination), err)
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		diags.AddError(fmt.Sprintf("updating EC2 Transit Gateway Route Table Routes Exclusive (%s)", routeTableID), err.Error())
+	}
+
+	return diags
+}
+
+func flattenTransitGatewayStaticRoute(apiObject awstypes.TransitGatewayRoute) transitGatewayStaticRouteModel {
+	route := transitGatewayStaticRouteModel{
+		Blackhole:                  types.BoolNull(),
+		DestinationCIDRBlock:       fwtypes.CIDRBlockValue(itypes.CanonicalCIDRBlock(aws.ToString(apiObject.DestinationCidrBlock))),
+		TransitGatewayAttachmentID: types.StringNull(),
+	}
+
+	if len(apiObject.TransitGatewayAttachments) > 0 {
+		route.TransitGatewayAttachmentID = types.StringPointerValue(apiObject.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+	} else {
+		route.Blackhole = types.BoolValue(true)
+	}
+
+	return route
+}
+
+type transitGatewayRouteTableRoutesExclusiveResourceModel struct {
+	Routes                     fwtypes.SetNestedObjectValueOf[transitGatewayStaticRouteModel] `tfsdk:"route"`
+	TransitGatewayRouteTableID types.String                                                   `tfsdk:"transit_gateway_route_table_id"`
+}
+
+type transitGatewayStaticRouteModel struct {
+	Blackhole                  types.Bool        `tfsdk:"blackhole"`
+	DestinationCIDRBlock       fwtypes.CIDRBlock `tfsdk:"destination_cidr_block"`
+	TransitGatewayAttachmentID types.String      `tfsdk:"transit_gateway_attachment_id"`
+}
+
+func (m transitGatewayStaticRouteModel) destination() string {
+	return itypes.CanonicalCIDRBlock(m.DestinationCIDRBlock.ValueString())
+}
+
+// equivalent returns whether the two routes have the same target.
+func (m transitGatewayStaticRouteModel) equivalent(o transitGatewayStaticRouteModel) bool {
+	return m.Blackhole.ValueBool() == o.Blackhole.ValueBool() && m.TransitGatewayAttachmentID.ValueString() == o.TransitGatewayAttachmentID.ValueString()
+}
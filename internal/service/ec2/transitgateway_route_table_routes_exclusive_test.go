@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayRouteTableRoutesExclusive_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_routes_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExclusiveCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.1.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "2001:db8::/56",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              acctest.CtTrue,
+						"destination_cidr_block": "10.2.0.0/16",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", "aws_ec2_transit_gateway_route_table.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTransitGatewayRouteTableRoutesExclusiveImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "transit_gateway_route_table_id",
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutesExclusive_outOfBandRoute(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_routes_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExclusiveCount(ctx, resourceName, 3),
+					testAccCheckTransitGatewayRouteCreateBlackholeOutOfBand(ctx, resourceName, "10.3.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The out-of-band route is removed.
+				Config: testAccTransitGatewayRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExclusiveCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutesExclusive_updateRouteTarget(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_routes_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExclusiveCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableRoutesExclusiveConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              acctest.CtTrue,
+						"destination_cidr_block": "10.1.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.2.0.0/16",
+					}),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableRoutesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "route.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRouteTableRoutesExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindTransitGatewayStaticRoutesByRouteTableID(ctx, conn, rs.Primary.Attributes["transit_gateway_route_table_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Transit Gateway Route Table (%s) has %d static routes, want %d", rs.Primary.Attributes["transit_gateway_route_table_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteCreateBlackholeOutOfBand(ctx context.Context, n, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := conn.CreateTransitGatewayRoute(ctx, &ec2.CreateTransitGatewayRouteInput{
+			Blackhole:                  aws.Bool(true),
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(rs.Primary.Attributes["transit_gateway_route_table_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccTransitGatewayRouteTableRoutesExclusiveImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["transit_gateway_route_table_id"], nil
+	}
+}
+
+func testAccTransitGatewayRouteTableRoutesExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayRouteTableRoutesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesExclusiveConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_routes_exclusive" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  route {
+    destination_cidr_block        = "10.1.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    destination_cidr_block        = "2001:db8::/56"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    destination_cidr_block = "10.2.0.0/16"
+    blackhole              = true
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableRoutesExclusiveConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesExclusiveConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_routes_exclusive" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  route {
+    destination_cidr_block = "10.1.0.0/16"
+    blackhole              = true
+  }
+
+  route {
+    destination_cidr_block        = "10.2.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableRoutesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesExclusiveConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_routes_exclusive" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`)
+}
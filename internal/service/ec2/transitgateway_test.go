@@ -133,6 +133,11 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			acctest.CtBasic:      testAccTransitGatewayRouteTablePropagation_basic,
 			acctest.CtDisappears: testAccTransitGatewayRouteTablePropagation_disappears,
 		},
+		"RouteTableRoutesExclusive": {
+			acctest.CtBasic:     testAccTransitGatewayRouteTableRoutesExclusive_basic,
+			"outOfBandRoute":    testAccTransitGatewayRouteTableRoutesExclusive_outOfBandRoute,
+			"updateRouteTarget": testAccTransitGatewayRouteTableRoutesExclusive_updateRouteTarget,
+		},
 		"VpcAttachment": {
 			acctest.CtBasic:        testAccTransitGatewayVPCAttachment_basic,
 			acctest.CtDisappears:   testAccTransitGatewayVPCAttachment_disappears,
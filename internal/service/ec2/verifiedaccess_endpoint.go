@@ -48,7 +48,7 @@ func resourceVerifiedAccessEndpoint() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"application_domain": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 			"attachment_type": {
@@ -57,6 +57,34 @@ func resourceVerifiedAccessEndpoint() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(verifiedAccessAttachmentType_Values(), false),
 			},
+			"cidr_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"port_range": verifiedAccessEndpointPortRangeSchema(),
+						names.AttrProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(verifiedAccessEndpointProtocol_Values(), false),
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -67,13 +95,13 @@ func resourceVerifiedAccessEndpoint() *schema.Resource {
 			},
 			"domain_certificate_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			"endpoint_domain_prefix": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 			"endpoint_domain": {
@@ -103,6 +131,7 @@ func resourceVerifiedAccessEndpoint() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.IsPortNumber,
 						},
+						"port_range": verifiedAccessEndpointPortRangeSchema(),
 						names.AttrProtocol: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -132,6 +161,7 @@ func resourceVerifiedAccessEndpoint() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.IsPortNumber,
 						},
+						"port_range": verifiedAccessEndpointPortRangeSchema(),
 						names.AttrProtocol: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -144,6 +174,54 @@ func resourceVerifiedAccessEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rds_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrPort: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						names.AttrProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(verifiedAccessEndpointProtocol_Values(), false),
+						},
+						"rds_db_cluster_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rds_db_instance_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rds_db_proxy_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rds_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			names.AttrSecurityGroupIDs: {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -185,25 +263,59 @@ func resourceVerifiedAccessEndpoint() *schema.Resource {
 	}
 }
 
+func verifiedAccessEndpointPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+			},
+		},
+	}
+}
+
 func resourceVerifiedAccessEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	input := &ec2.CreateVerifiedAccessEndpointInput{
-		ApplicationDomain:     aws.String(d.Get("application_domain").(string)),
 		AttachmentType:        types.VerifiedAccessEndpointAttachmentType(d.Get("attachment_type").(string)),
 		ClientToken:           aws.String(id.UniqueId()),
-		DomainCertificateArn:  aws.String(d.Get("domain_certificate_arn").(string)),
-		EndpointDomainPrefix:  aws.String(d.Get("endpoint_domain_prefix").(string)),
 		EndpointType:          types.VerifiedAccessEndpointType(d.Get(names.AttrEndpointType).(string)),
 		TagSpecifications:     getTagSpecificationsIn(ctx, types.ResourceTypeVerifiedAccessEndpoint),
 		VerifiedAccessGroupId: aws.String(d.Get("verified_access_group_id").(string)),
 	}
 
+	if v, ok := d.GetOk("application_domain"); ok {
+		input.ApplicationDomain = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CidrOptions = expandCreateVerifiedAccessEndpointCidrOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("domain_certificate_arn"); ok {
+		input.DomainCertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("endpoint_domain_prefix"); ok {
+		input.EndpointDomainPrefix = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("load_balancer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.LoadBalancerOptions = expandCreateVerifiedAccessEndpointLoadBalancerOptions(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -216,6 +328,10 @@ func resourceVerifiedAccessEndpointCreate(ctx context.Context, d *schema.Resourc
 		input.PolicyDocument = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("rds_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RdsOptions = expandCreateVerifiedAccessEndpointRdsOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
 		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -257,6 +373,9 @@ func resourceVerifiedAccessEndpointRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set("application_domain", ep.ApplicationDomain)
 	d.Set("attachment_type", ep.AttachmentType)
+	if err := d.Set("cidr_options", flattenVerifiedAccessEndpointCidrOptions(ep.CidrOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cidr_options: %s", err)
+	}
 	d.Set(names.AttrDescription, ep.Description)
 	d.Set("device_validation_domain", ep.DeviceValidationDomain)
 	d.Set("domain_certificate_arn", ep.DomainCertificateArn)
@@ -268,6 +387,9 @@ func resourceVerifiedAccessEndpointRead(ctx context.Context, d *schema.ResourceD
 	if err := d.Set("network_interface_options", flattenVerifiedAccessEndpointEniOptions(ep.NetworkInterfaceOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_interface_options: %s", err)
 	}
+	if err := d.Set("rds_options", flattenVerifiedAccessEndpointRdsOptions(ep.RdsOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rds_options: %s", err)
+	}
 	d.Set(names.AttrSecurityGroupIDs, aws.StringSlice(ep.SecurityGroupIds))
 	if err := d.Set("sse_specification", flattenVerifiedAccessSseSpecificationRequest(ep.SseSpecification)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting sse_specification: %s", err)
@@ -296,6 +418,12 @@ func resourceVerifiedAccessEndpointUpdate(ctx context.Context, d *schema.Resourc
 			VerifiedAccessEndpointId: aws.String(d.Id()),
 		}
 
+		if d.HasChanges("cidr_options") {
+			if v, ok := d.GetOk("cidr_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CidrOptions = expandModifyVerifiedAccessEndpointCidrOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChanges(names.AttrDescription) {
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
@@ -312,6 +440,12 @@ func resourceVerifiedAccessEndpointUpdate(ctx context.Context, d *schema.Resourc
 			}
 		}
 
+		if d.HasChanges("rds_options") {
+			if v, ok := d.GetOk("rds_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.RdsOptions = expandModifyVerifiedAccessEndpointRdsOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChanges("verified_access_group_id") {
 			input.VerifiedAccessGroupId = aws.String(d.Get("verified_access_group_id").(string))
 		}
@@ -389,6 +523,10 @@ func flattenVerifiedAccessEndpointLoadBalancerOptions(apiObject *types.VerifiedA
 		tfmap[names.AttrPort] = aws.ToInt32(v)
 	}
 
+	if v := apiObject.PortRanges; v != nil {
+		tfmap["port_range"] = flattenVerifiedAccessEndpointPortRanges(v)
+	}
+
 	if v := apiObject.Protocol; v != "" {
 		tfmap[names.AttrProtocol] = v
 	}
@@ -415,13 +553,94 @@ func flattenVerifiedAccessEndpointEniOptions(apiObject *types.VerifiedAccessEndp
 		tfmap[names.AttrPort] = aws.ToInt32(v)
 	}
 
+	if v := apiObject.PortRanges; v != nil {
+		tfmap["port_range"] = flattenVerifiedAccessEndpointPortRanges(v)
+	}
+
+	if v := apiObject.Protocol; v != "" {
+		tfmap[names.AttrProtocol] = v
+	}
+
+	return []interface{}{tfmap}
+}
+
+func flattenVerifiedAccessEndpointCidrOptions(apiObject *types.VerifiedAccessEndpointCidrOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfmap := map[string]interface{}{}
+
+	if v := apiObject.Cidr; v != nil {
+		tfmap["cidr"] = aws.ToString(v)
+	}
+
+	if v := apiObject.PortRanges; v != nil {
+		tfmap["port_range"] = flattenVerifiedAccessEndpointPortRanges(v)
+	}
+
+	if v := apiObject.Protocol; v != "" {
+		tfmap[names.AttrProtocol] = v
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfmap[names.AttrSubnetIDs] = aws.StringSlice(v)
+	}
+
+	return []interface{}{tfmap}
+}
+
+func flattenVerifiedAccessEndpointRdsOptions(apiObject *types.VerifiedAccessEndpointRdsOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfmap := map[string]interface{}{}
+
+	if v := apiObject.Port; v != nil {
+		tfmap[names.AttrPort] = aws.ToInt32(v)
+	}
+
 	if v := apiObject.Protocol; v != "" {
 		tfmap[names.AttrProtocol] = v
 	}
 
+	if v := apiObject.RdsDbClusterArn; v != nil {
+		tfmap["rds_db_cluster_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.RdsDbInstanceArn; v != nil {
+		tfmap["rds_db_instance_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.RdsDbProxyArn; v != nil {
+		tfmap["rds_db_proxy_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.RdsEndpoint; v != nil {
+		tfmap["rds_endpoint"] = aws.ToString(v)
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfmap[names.AttrSubnetIDs] = aws.StringSlice(v)
+	}
+
 	return []interface{}{tfmap}
 }
 
+func flattenVerifiedAccessEndpointPortRanges(apiObjects []types.VerifiedAccessEndpointPortRange) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"from_port": aws.ToInt32(apiObject.FromPort),
+			"to_port":   aws.ToInt32(apiObject.ToPort),
+		})
+	}
+
+	return tfList
+}
+
 func flattenVerifiedAccessSseSpecificationRequest(apiObject *types.VerifiedAccessSseSpecificationResponse) []interface{} {
 	if apiObject == nil {
 		return nil
@@ -451,10 +670,14 @@ func expandCreateVerifiedAccessEndpointLoadBalancerOptions(tfMap map[string]inte
 		apiobject.LoadBalancerArn = aws.String(v)
 	}
 
-	if v, ok := tfMap[names.AttrPort].(int); ok {
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
 		apiobject.Port = aws.Int32(int32(v))
 	}
 
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiobject.PortRanges = expandCreateVerifiedAccessEndpointPortRanges(v.List())
+	}
+
 	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
 		apiobject.Protocol = types.VerifiedAccessEndpointProtocol(v)
 	}
@@ -477,10 +700,14 @@ func expandCreateVerifiedAccessEndpointEniOptions(tfMap map[string]interface{})
 		apiobject.NetworkInterfaceId = aws.String(v)
 	}
 
-	if v, ok := tfMap[names.AttrPort].(int); ok {
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
 		apiobject.Port = aws.Int32(int32(v))
 	}
 
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiobject.PortRanges = expandCreateVerifiedAccessEndpointPortRanges(v.List())
+	}
+
 	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
 		apiobject.Protocol = types.VerifiedAccessEndpointProtocol(v)
 	}
@@ -494,16 +721,20 @@ func expandModifyVerifiedAccessEndpointLoadBalancerOptions(tfMap map[string]inte
 
 	apiObject := &types.ModifyVerifiedAccessEndpointLoadBalancerOptions{}
 
-	if v, ok := tfMap[names.AttrPort].(int); ok {
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
 		apiObject.Port = aws.Int32(int32(v))
 	}
 
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRanges = expandModifyVerifiedAccessEndpointPortRanges(v.List())
+	}
+
 	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
 		apiObject.Protocol = types.VerifiedAccessEndpointProtocol(v)
 	}
 
-	if v, ok := tfMap[names.AttrSubnetIDs]; ok {
-		apiObject.SubnetIds = flex.ExpandStringValueList(v.([]interface{}))
+	if v, ok := tfMap[names.AttrSubnetIDs].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
 	}
 
 	return apiObject
@@ -516,16 +747,156 @@ func expandModifyVerifiedAccessEndpointEniOptions(tfMap map[string]interface{})
 
 	apiObject := &types.ModifyVerifiedAccessEndpointEniOptions{}
 
-	if v, ok := tfMap[names.AttrPort].(int); ok {
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRanges = expandModifyVerifiedAccessEndpointPortRanges(v.List())
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+		apiObject.Protocol = types.VerifiedAccessEndpointProtocol(v)
+	}
+	return apiObject
+}
+
+func expandCreateVerifiedAccessEndpointCidrOptions(tfMap map[string]interface{}) *types.CreateVerifiedAccessEndpointCidrOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CreateVerifiedAccessEndpointCidrOptions{}
+
+	if v, ok := tfMap["cidr"].(string); ok && v != "" {
+		apiObject.Cidr = aws.String(v)
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRanges = expandCreateVerifiedAccessEndpointPortRanges(v.List())
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+		apiObject.Protocol = types.VerifiedAccessEndpointProtocol(v)
+	}
+
+	if v, ok := tfMap[names.AttrSubnetIDs].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandModifyVerifiedAccessEndpointCidrOptions(tfMap map[string]interface{}) *types.ModifyVerifiedAccessEndpointCidrOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ModifyVerifiedAccessEndpointCidrOptions{}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRanges = expandModifyVerifiedAccessEndpointPortRanges(v.List())
+	}
+
+	return apiObject
+}
+
+func expandCreateVerifiedAccessEndpointRdsOptions(tfMap map[string]interface{}) *types.CreateVerifiedAccessEndpointRdsOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CreateVerifiedAccessEndpointRdsOptions{}
+
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
 		apiObject.Port = aws.Int32(int32(v))
 	}
 
 	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
 		apiObject.Protocol = types.VerifiedAccessEndpointProtocol(v)
 	}
+
+	if v, ok := tfMap["rds_db_cluster_arn"].(string); ok && v != "" {
+		apiObject.RdsDbClusterArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rds_db_instance_arn"].(string); ok && v != "" {
+		apiObject.RdsDbInstanceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rds_db_proxy_arn"].(string); ok && v != "" {
+		apiObject.RdsDbProxyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rds_endpoint"].(string); ok && v != "" {
+		apiObject.RdsEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrSubnetIDs].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
 	return apiObject
 }
 
+func expandModifyVerifiedAccessEndpointRdsOptions(tfMap map[string]interface{}) *types.ModifyVerifiedAccessEndpointRdsOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ModifyVerifiedAccessEndpointRdsOptions{}
+
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["rds_endpoint"].(string); ok && v != "" {
+		apiObject.RdsEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrSubnetIDs].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCreateVerifiedAccessEndpointPortRanges(tfList []interface{}) []types.CreateVerifiedAccessEndpointPortRange {
+	var apiObjects []types.CreateVerifiedAccessEndpointPortRange
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.CreateVerifiedAccessEndpointPortRange{
+			FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+			ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandModifyVerifiedAccessEndpointPortRanges(tfList []interface{}) []types.ModifyVerifiedAccessEndpointPortRange {
+	var apiObjects []types.ModifyVerifiedAccessEndpointPortRange
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.ModifyVerifiedAccessEndpointPortRange{
+			FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+			ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandCreateVerifiedAccessEndpointSseSpecification(tfMap map[string]interface{}) *types.VerifiedAccessSseSpecificationRequest {
 	if tfMap == nil {
 		return nil
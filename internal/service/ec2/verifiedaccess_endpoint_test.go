@@ -117,6 +117,58 @@ func testAccVerifiedAccessEndpoint_networkInterface(t *testing.T, semaphore tfsy
 	})
 }
 
+func testAccVerifiedAccessEndpoint_cidr(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v types.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVerifiedAccessSynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_cidr(rName, 22),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_domain", ""),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_options.0.cidr", "aws_subnet.test.0", names.AttrCIDRBlock),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.port_range.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cidr_options.0.port_range.*", map[string]string{
+						"from_port": "22",
+						"to_port":   "22",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.subnet_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEndpointType, "cidr"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessEndpointConfig_cidr(rName, 2222),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cidr_options.0.port_range.*", map[string]string{
+						"from_port": "2222",
+						"to_port":   "2222",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccVerifiedAccessEndpoint_tags(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessEndpoint
@@ -453,6 +505,83 @@ resource "aws_verifiedaccess_endpoint" "test" {
 `, rName, key, certificate))
 }
 
+func testAccVerifiedAccessEndpointConfig_cidr(rName string, port int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_verifiedaccess_instance" "test" {
+  cidr_endpoints_custom_subdomain = "%[1]s.example.com"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name    = "test"
+  trust_provider_type      = "user"
+  user_trust_provider_type = "oidc"
+
+  oidc_options {
+    authorization_endpoint = "https://example.com/authorization_endpoint"
+    client_id              = "s6BhdRkqt3"
+    client_secret          = "7Fjfp0ZBr1KtDRbnfVdmIw"
+    issuer                 = "https://example.com"
+    scope                  = "test"
+    token_endpoint         = "https://example.com/token_endpoint"
+    user_info_endpoint     = "https://example.com/user_info_endpoint"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_verifiedaccess_instance_trust_provider_attachment" "test" {
+  verifiedaccess_instance_id       = aws_verifiedaccess_instance.test.id
+  verifiedaccess_trust_provider_id = aws_verifiedaccess_trust_provider.test.id
+}
+
+resource "aws_verifiedaccess_group" "test" {
+  verifiedaccess_instance_id = aws_verifiedaccess_instance_trust_provider_attachment.test.verifiedaccess_instance_id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_verifiedaccess_endpoint" "test" {
+  attachment_type = "vpc"
+  endpoint_type   = "cidr"
+
+  cidr_options {
+    cidr       = aws_subnet.test[0].cidr_block
+    protocol   = "tcp"
+    subnet_ids = aws_subnet.test[*].id
+
+    port_range {
+      from_port = %[2]d
+      to_port   = %[2]d
+    }
+  }
+
+  security_group_ids       = [aws_security_group.test.id]
+  verified_access_group_id = aws_verifiedaccess_group.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, port))
+}
+
 func testAccVerifiedAccessEndpointConfig_tags1(rName, key, certificate, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccVerifiedAccessEndpointConfig_base(rName, key, certificate), fmt.Sprintf(`
 
@@ -37,6 +37,10 @@ func resourceVerifiedAccessInstance() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"cidr_endpoints_custom_subdomain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			names.AttrCreationTime: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -54,6 +58,11 @@ func resourceVerifiedAccessInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"verified_access_trust_providers": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -99,6 +108,10 @@ func resourceVerifiedAccessInstanceCreate(ctx context.Context, d *schema.Resourc
 		TagSpecifications: getTagSpecificationsIn(ctx, types.ResourceTypeVerifiedAccessInstance),
 	}
 
+	if v, ok := d.GetOk("cidr_endpoints_custom_subdomain"); ok {
+		input.CidrEndpointsCustomSubDomain = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -134,6 +147,13 @@ func resourceVerifiedAccessInstanceRead(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "reading Verified Access Instance (%s): %s", d.Id(), err)
 	}
 
+	if v := output.CidrEndpointsCustomSubDomain; v != nil {
+		d.Set("cidr_endpoints_custom_subdomain", v.SubDomain)
+		d.Set("name_servers", v.Nameservers)
+	} else {
+		d.Set("cidr_endpoints_custom_subdomain", nil)
+		d.Set("name_servers", nil)
+	}
 	d.Set(names.AttrCreationTime, output.CreationTime)
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("fips_enabled", output.FipsEnabled)
@@ -162,6 +182,10 @@ func resourceVerifiedAccessInstanceUpdate(ctx context.Context, d *schema.Resourc
 			VerifiedAccessInstanceId: aws.String(d.Id()),
 		}
 
+		if d.HasChange("cidr_endpoints_custom_subdomain") {
+			input.CidrEndpointsCustomSubDomain = aws.String(d.Get("cidr_endpoints_custom_subdomain").(string))
+		}
+
 		if d.HasChange(names.AttrDescription) {
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
@@ -18,6 +18,7 @@ func TestAccVerifiedAccess_serial(t *testing.T) {
 		"Endpoint": {
 			acctest.CtBasic:      testAccVerifiedAccessEndpoint_basic,
 			"networkInterface":   testAccVerifiedAccessEndpoint_networkInterface,
+			"cidr":               testAccVerifiedAccessEndpoint_cidr,
 			"tags":               testAccVerifiedAccessEndpoint_tags,
 			acctest.CtDisappears: testAccVerifiedAccessEndpoint_disappears,
 			"policyDocument":     testAccVerifiedAccessEndpoint_policyDocument,
@@ -72,10 +72,12 @@ func resourceNetworkInterface() *schema.Resource {
 					},
 				},
 			},
+			"connection_tracking": networkInterfaceConnectionTrackingSchema(),
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"ena_srd": networkInterfaceENASRDSchema(),
 			"interface_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -335,6 +337,60 @@ func resourceNetworkInterface() *schema.Resource {
 	}
 }
 
+func networkInterfaceConnectionTrackingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tcp_established_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(60, 432000),
+				},
+				"udp_stream_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(60, 180),
+				},
+				"udp_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(30, 60),
+				},
+			},
+		},
+	}
+}
+
+func networkInterfaceENASRDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrEnabled: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"udp_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -347,6 +403,10 @@ func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 		SubnetId:    aws.String(d.Get(names.AttrSubnetID).(string)),
 	}
 
+	if v, ok := d.GetOk("connection_tracking"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -484,6 +544,20 @@ func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+
+		// ENA Express settings apply to the attachment, so can only be configured once attached.
+		if v, ok := d.GetOk("ena_srd"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &ec2.ModifyNetworkInterfaceAttributeInput{
+				EnaSrdSpecification: expandEnaSrdSpecification(v.([]interface{})[0].(map[string]interface{})),
+				NetworkInterfaceId:  aws.String(d.Id()),
+			}
+
+			_, err := conn.ModifyNetworkInterfaceAttribute(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying EC2 Network Interface (%s) EnaSrdSpecification: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceNetworkInterfaceRead(ctx, d, meta)...)
@@ -525,7 +599,21 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	} else {
 		d.Set("attachment", nil)
 	}
+	if eni.ConnectionTrackingConfiguration != nil {
+		if err := d.Set("connection_tracking", []interface{}{flattenConnectionTrackingConfiguration(eni.ConnectionTrackingConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting connection_tracking: %s", err)
+		}
+	} else {
+		d.Set("connection_tracking", nil)
+	}
 	d.Set(names.AttrDescription, eni.Description)
+	if eni.Attachment != nil && eni.Attachment.EnaSrdSpecification != nil {
+		if err := d.Set("ena_srd", []interface{}{flattenAttachmentEnaSrdSpecification(eni.Attachment.EnaSrdSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ena_srd: %s", err)
+		}
+	} else {
+		d.Set("ena_srd", nil)
+	}
 	d.Set("interface_type", eni.InterfaceType)
 	if err := d.Set("ipv4_prefixes", flattenIPv4PrefixSpecifications(eni.Ipv4Prefixes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ipv4_prefixes: %s", err)
@@ -1033,6 +1121,36 @@ func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("connection_tracking") {
+		if v, ok := d.GetOk("connection_tracking"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &ec2.ModifyNetworkInterfaceAttributeInput{
+				ConnectionTrackingSpecification: expandConnectionTrackingSpecificationRequest(v.([]interface{})[0].(map[string]interface{})),
+				NetworkInterfaceId:              aws.String(d.Id()),
+			}
+
+			_, err := conn.ModifyNetworkInterfaceAttribute(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying EC2 Network Interface (%s) ConnectionTrackingSpecification: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("ena_srd") {
+		if v, ok := d.GetOk("ena_srd"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &ec2.ModifyNetworkInterfaceAttributeInput{
+				EnaSrdSpecification: expandEnaSrdSpecification(v.([]interface{})[0].(map[string]interface{})),
+				NetworkInterfaceId:  aws.String(d.Id()),
+			}
+
+			_, err := conn.ModifyNetworkInterfaceAttribute(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying EC2 Network Interface (%s) EnaSrdSpecification: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceNetworkInterfaceRead(ctx, d, meta)...)
 }
 
@@ -1181,6 +1299,88 @@ func flattenNetworkInterfaceAttachment(apiObject *types.NetworkInterfaceAttachme
 	return tfMap
 }
 
+func expandConnectionTrackingSpecificationRequest(tfMap map[string]interface{}) *types.ConnectionTrackingSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ConnectionTrackingSpecificationRequest{}
+
+	if v, ok := tfMap["tcp_established_timeout"].(int); ok && v != 0 {
+		apiObject.TcpEstablishedTimeout = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["udp_stream_timeout"].(int); ok && v != 0 {
+		apiObject.UdpStreamTimeout = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["udp_timeout"].(int); ok && v != 0 {
+		apiObject.UdpTimeout = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenConnectionTrackingConfiguration(apiObject *types.ConnectionTrackingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TcpEstablishedTimeout; v != nil {
+		tfMap["tcp_established_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpStreamTimeout; v != nil {
+		tfMap["udp_stream_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpTimeout; v != nil {
+		tfMap["udp_timeout"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func expandEnaSrdSpecification(tfMap map[string]interface{}) *types.EnaSrdSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EnaSrdSpecification{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.EnaSrdEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["udp_enabled"].(bool); ok {
+		apiObject.EnaSrdUdpSpecification = &types.EnaSrdUdpSpecification{
+			EnaSrdUdpEnabled: aws.Bool(v),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAttachmentEnaSrdSpecification(apiObject *types.AttachmentEnaSrdSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnaSrdEnabled; v != nil {
+		tfMap[names.AttrEnabled] = aws.ToBool(v)
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["udp_enabled"] = aws.ToBool(v.EnaSrdUdpEnabled)
+	}
+
+	return tfMap
+}
+
 func expandPrivateIPAddressSpecification(tfString string) *types.PrivateIpAddressSpecification {
 	if tfString == "" {
 		return nil
@@ -430,6 +430,48 @@ func TestAccVPCNetworkInterface_sourceDestCheck(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInterface_connectionTracking(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf types.NetworkInterface
+	resourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceConfig_connectionTracking(rName, 3600, 120, 45),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.tcp_established_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_stream_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_timeout", "45"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_ip_list_enabled", "ipv6_address_list_enabled"},
+			},
+			{
+				Config: testAccVPCNetworkInterfaceConfig_connectionTracking(rName, 7200, 180, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.tcp_established_timeout", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_stream_timeout", "180"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking.0.udp_timeout", "60"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCNetworkInterface_privateIPsCount(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf types.NetworkInterface
@@ -1296,6 +1338,25 @@ resource "aws_network_interface" "test" {
 `, rName, sourceDestCheck))
 }
 
+func testAccVPCNetworkInterfaceConfig_connectionTracking(rName string, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout int) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfaceConfig_baseIPV4(rName), fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id   = aws_subnet.test.id
+  private_ips = ["172.16.10.100"]
+
+  connection_tracking {
+    tcp_established_timeout = %[2]d
+    udp_stream_timeout      = %[3]d
+    udp_timeout             = %[4]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout))
+}
+
 func testAccVPCNetworkInterfaceConfig_attachment(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
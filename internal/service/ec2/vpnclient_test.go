@@ -29,6 +29,8 @@ func TestAccClientVPNEndpoint_serial(t *testing.T) {
 			"federatedAuthWithSelfService": testAccClientVPNEndpoint_federatedAuthWithSelfServiceProvider,
 			"withClientConnect":            testAccClientVPNEndpoint_withClientConnectOptions,
 			"withClientLoginBanner":        testAccClientVPNEndpoint_withClientLoginBannerOptions,
+			"withClientRouteEnforcement":   testAccClientVPNEndpoint_withClientRouteEnforcementOptions,
+			"disconnectOnSessionTimeout":   testAccClientVPNEndpoint_disconnectOnSessionTimeout,
 			"withLogGroup":                 testAccClientVPNEndpoint_withConnectionLogOptions,
 			"withDNSServers":               testAccClientVPNEndpoint_withDNSServers,
 			"tags":                         testAccClientVPNEndpoint_tags,
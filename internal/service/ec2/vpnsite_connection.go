@@ -93,6 +93,17 @@ func resourceVPNConnection() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(outsideIPAddressType_Values(), false),
 			},
+			"preshared_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preshared_key_storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(vpnConnectionPreSharedKeyStorage_Values(), false),
+			},
 			"remote_ipv4_network_cidr": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -688,6 +699,10 @@ func resourceVPNConnectionCreate(ctx context.Context, d *schema.ResourceData, me
 		Type:              aws.String(d.Get(names.AttrType).(string)),
 	}
 
+	if v, ok := d.GetOk("preshared_key_storage"); ok {
+		input.PreSharedKeyStorage = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrTransitGatewayID); ok {
 		input.TransitGatewayId = aws.String(v.(string))
 	}
@@ -739,6 +754,13 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("core_network_arn", vpnConnection.CoreNetworkArn)
 	d.Set("core_network_attachment_arn", vpnConnection.CoreNetworkAttachmentArn)
 	d.Set("customer_gateway_id", vpnConnection.CustomerGatewayId)
+	d.Set("preshared_key_arn", vpnConnection.PreSharedKeyArn)
+	// The storage mode is not returned by the API, so derive it from the presence of the secret.
+	if vpnConnection.PreSharedKeyArn != nil {
+		d.Set("preshared_key_storage", vpnConnectionPreSharedKeyStorageSecretsManager)
+	} else {
+		d.Set("preshared_key_storage", vpnConnectionPreSharedKeyStorageStandard)
+	}
 	d.Set(names.AttrType, vpnConnection.Type)
 	d.Set("vpn_gateway_id", vpnConnection.VpnGatewayId)
 
@@ -806,9 +828,16 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("customer_gateway_configuration", vpnConnection.CustomerGatewayConfiguration)
 
+	// Pre-shared keys stored in Secrets Manager are not included in the customer gateway configuration.
+	preSharedKeysInConfiguration := vpnConnection.PreSharedKeyArn == nil
+	tunnel1PreSharedKey := d.Get("tunnel1_preshared_key").(string) // Not currently available during import
+	if !preSharedKeysInConfiguration {
+		tunnel1PreSharedKey = ""
+	}
+
 	tunnelInfo, err := customerGatewayConfigurationToTunnelInfo(
 		aws.ToString(vpnConnection.CustomerGatewayConfiguration),
-		d.Get("tunnel1_preshared_key").(string), // Not currently available during import
+		tunnel1PreSharedKey,
 		d.Get("tunnel1_inside_cidr").(string),
 		d.Get("tunnel1_inside_ipv6_cidr").(string),
 	)
@@ -818,14 +847,17 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("tunnel1_bgp_asn", tunnelInfo.Tunnel1BGPASN)
 		d.Set("tunnel1_bgp_holdtime", tunnelInfo.Tunnel1BGPHoldTime)
 		d.Set("tunnel1_cgw_inside_address", tunnelInfo.Tunnel1CgwInsideAddress)
-		d.Set("tunnel1_preshared_key", tunnelInfo.Tunnel1PreSharedKey)
 		d.Set("tunnel1_vgw_inside_address", tunnelInfo.Tunnel1VgwInsideAddress)
 		d.Set("tunnel2_address", tunnelInfo.Tunnel2Address)
 		d.Set("tunnel2_bgp_asn", tunnelInfo.Tunnel2BGPASN)
 		d.Set("tunnel2_bgp_holdtime", tunnelInfo.Tunnel2BGPHoldTime)
 		d.Set("tunnel2_cgw_inside_address", tunnelInfo.Tunnel2CgwInsideAddress)
-		d.Set("tunnel2_preshared_key", tunnelInfo.Tunnel2PreSharedKey)
 		d.Set("tunnel2_vgw_inside_address", tunnelInfo.Tunnel2VgwInsideAddress)
+
+		if preSharedKeysInConfiguration {
+			d.Set("tunnel1_preshared_key", tunnelInfo.Tunnel1PreSharedKey)
+			d.Set("tunnel2_preshared_key", tunnelInfo.Tunnel2PreSharedKey)
+		}
 	} else {
 		// This element is present in the DescribeVpnConnections response only if the VPN connection is in the pending or available state.
 		if vpnConnection.CustomerGatewayConfiguration != nil {
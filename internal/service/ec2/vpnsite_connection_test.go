@@ -1340,6 +1340,45 @@ func TestAccSiteVPNConnection_enableAcceleration(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNConnection_preSharedKeyStorage(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_preSharedKeyStorage(rName, rBgpAsn, "SecretsManager"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "preshared_key_arn", "secretsmanager", regexache.MustCompile(`secret:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "preshared_key_storage", "SecretsManager"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vgw_telemetry"},
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_preSharedKeyStorage(rName, rBgpAsn, "Standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn),
+					resource.TestCheckResourceAttr(resourceName, "preshared_key_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "preshared_key_storage", "Standard"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNConnection_ipv6(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2176,6 +2215,36 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn)
 }
 
+func testAccSiteVPNConnectionConfig_preSharedKeyStorage(rName string, rBgpAsn int, storage string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  description = %[1]q
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id   = aws_customer_gateway.test.id
+  transit_gateway_id    = aws_ec2_transit_gateway.test.id
+  type                  = "ipsec.1"
+  static_routes_only    = false
+  preshared_key_storage = %[3]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, storage)
+}
+
 func testAccSiteVPNConnectionConfig_ipv6(rName string, rBgpAsn int, localIpv6NetworkCidr string, remoteIpv6NetworkCidr string, tunnel1InsideIpv6Cidr string, tunnel2InsideIpv6Cidr string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
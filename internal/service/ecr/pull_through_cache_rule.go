@@ -5,13 +5,16 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,8 +37,24 @@ func resourcePullThroughCacheRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			validatePullThroughCacheRuleCredentials,
+			// The custom role can't be removed from an existing rule.
+			customdiff.ForceNewIfChange("custom_role_arn", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && new.(string) == ""
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"credential_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					verify.ValidARN,
+					validation.StringMatch(regexache.MustCompile(`:secretsmanager:.+:secret:ecr-pullthroughcache/`), "must be the ARN of a Secrets Manager secret whose name starts with ecr-pullthroughcache/"),
+				),
+			},
+			"custom_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
@@ -59,6 +78,23 @@ func resourcePullThroughCacheRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validation.StringInSlice(pullThroughCacheUpstreamRegistryURLs(), false),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z]+\.azurecr\.io$`), "must be a supported upstream registry URL"),
+					validation.StringMatch(regexache.MustCompile(`^\d{12}\.dkr\.ecr(-fips)?\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`), "must be a supported upstream registry URL"),
+				),
+			},
+			"upstream_repository_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 30),
+					validation.StringMatch(
+						regexache.MustCompile(`^(?:ROOT|(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*)$`),
+						"must only include alphanumeric, underscore, period, hyphen, or slash characters, or be the string `ROOT`"),
+				),
 			},
 		},
 	}
@@ -78,6 +114,14 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 		input.CredentialArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("upstream_repository_prefix"); ok {
+		input.UpstreamRepositoryPrefix = aws.String(v.(string))
+	}
+
 	_, err := conn.CreatePullThroughCacheRule(ctx, input)
 
 	if err != nil {
@@ -106,9 +150,11 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.Set("credential_arn", rule.CredentialArn)
+	d.Set("custom_role_arn", rule.CustomRoleArn)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
+	d.Set("upstream_repository_prefix", rule.UpstreamRepositoryPrefix)
 
 	return diags
 }
@@ -119,10 +165,17 @@ func resourcePullThroughCacheRuleUpdate(ctx context.Context, d *schema.ResourceD
 
 	repositoryPrefix := d.Get("ecr_repository_prefix").(string)
 	input := &ecr.UpdatePullThroughCacheRuleInput{
-		CredentialArn:       aws.String(d.Get("credential_arn").(string)),
 		EcrRepositoryPrefix: aws.String(repositoryPrefix),
 	}
 
+	if v, ok := d.GetOk("credential_arn"); ok {
+		input.CredentialArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
 	_, err := conn.UpdatePullThroughCacheRule(ctx, input)
 
 	if err != nil {
@@ -196,3 +249,61 @@ func findPullThroughCacheRules(ctx context.Context, conn *ecr.Client, input *ecr
 
 	return output, nil
 }
+
+// pullThroughCacheUpstreamRegistryURLs returns the fixed upstream registry URLs supported by pull through cache rules.
+// Azure Container Registry and Amazon ECR private registries use account-specific URLs and are validated separately.
+func pullThroughCacheUpstreamRegistryURLs() []string {
+	return []string{
+		"ghcr.io",
+		"public.ecr.aws",
+		"quay.io",
+		"registry-1.docker.io",
+		"registry.gitlab.com",
+		"registry.k8s.io",
+	}
+}
+
+// pullThroughCacheUpstreamRegistryRequiresCredential returns whether the specified upstream registry requires authentication.
+func pullThroughCacheUpstreamRegistryRequiresCredential(upstreamRegistryURL string) bool {
+	switch {
+	case upstreamRegistryURL == "ghcr.io", upstreamRegistryURL == "registry-1.docker.io", upstreamRegistryURL == "registry.gitlab.com":
+		return true
+	case strings.HasSuffix(upstreamRegistryURL, ".azurecr.io"):
+		return true
+	default:
+		return false
+	}
+}
+
+// pullThroughCacheUpstreamRegistryIsECR returns whether the specified upstream registry is an Amazon ECR private registry.
+func pullThroughCacheUpstreamRegistryIsECR(upstreamRegistryURL string) bool {
+	return strings.Contains(upstreamRegistryURL, ".dkr.ecr")
+}
+
+func validatePullThroughCacheRuleCredentials(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("upstream_registry_url") || !d.NewValueKnown("credential_arn") || !d.NewValueKnown("custom_role_arn") {
+		return nil
+	}
+
+	upstreamRegistryURL := d.Get("upstream_registry_url").(string)
+	credentialARN := d.Get("credential_arn").(string)
+	customRoleARN := d.Get("custom_role_arn").(string)
+
+	if pullThroughCacheUpstreamRegistryRequiresCredential(upstreamRegistryURL) && credentialARN == "" {
+		return fmt.Errorf("credential_arn is required for upstream registry %s", upstreamRegistryURL)
+	}
+
+	if pullThroughCacheUpstreamRegistryIsECR(upstreamRegistryURL) {
+		if credentialARN != "" {
+			return fmt.Errorf("credential_arn is not supported for Amazon ECR upstream registry %s, use custom_role_arn", upstreamRegistryURL)
+		}
+
+		if customRoleARN == "" {
+			return fmt.Errorf("custom_role_arn is required for Amazon ECR upstream registry %s", upstreamRegistryURL)
+		}
+	} else if v := d.GetRawConfig().GetAttr("upstream_repository_prefix"); v.IsKnown() && !v.IsNull() && v.AsString() != "ROOT" {
+		return fmt.Errorf("upstream_repository_prefix is only supported for Amazon ECR upstream registries")
+	}
+
+	return nil
+}
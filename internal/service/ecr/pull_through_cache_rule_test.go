@@ -79,6 +79,60 @@ func TestAccECRPullThroughCacheRule_credentialARN(t *testing.T) {
 	})
 }
 
+func TestAccECRPullThroughCacheRule_customRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig_customRoleARN(repositoryPrefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "credential_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_role_arn", roleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "ecr_repository_prefix", repositoryPrefix),
+					resource.TestMatchResourceAttr(resourceName, "upstream_registry_url", regexache.MustCompile(`^\d{12}\.dkr\.ecr\.`)),
+					resource.TestCheckResourceAttr(resourceName, "upstream_repository_prefix", "upstream"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECRPullThroughCacheRule_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, "registry.example.com"),
+				ExpectError: regexache.MustCompile(`must be a supported upstream registry URL`),
+			},
+			{
+				Config:      testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, "registry-1.docker.io"),
+				ExpectError: regexache.MustCompile(`credential_arn is required for upstream registry registry-1.docker.io`),
+			},
+		},
+	})
+}
+
 func TestAccECRPullThroughCacheRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
@@ -222,6 +276,47 @@ resource "aws_ecr_pull_through_cache_rule" "test" {
 `, repositoryPrefix)
 }
 
+func testAccPullThroughCacheRuleConfig_customRoleARN(repositoryPrefix string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "pullthroughcache.ecr.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix      = %[1]q
+  upstream_registry_url      = "${data.aws_caller_identity.current.account_id}.dkr.ecr.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  custom_role_arn            = aws_iam_role.test.arn
+  upstream_repository_prefix = "upstream"
+}
+`, repositoryPrefix)
+}
+
+func testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, upstreamRegistryURL string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = %[2]q
+}
+`, repositoryPrefix, upstreamRegistryURL)
+}
+
 func testAccPullThroughCacheRuleConfig_failWhenAlreadyExist(repositoryPrefix string) string {
 	return fmt.Sprintf(`
 resource "aws_ecr_pull_through_cache_rule" "test" {
@@ -62,6 +62,7 @@ func resourceRepositoryCreationTemplate() *schema.Resource {
 			names.AttrEncryptionConfiguration: {
 				Type:     schema.TypeList,
 				Optional: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"encryption_type": {
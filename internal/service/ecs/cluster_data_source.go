@@ -30,6 +30,74 @@ func dataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execute_command_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKMSKeyID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"log_configuration": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cloud_watch_encryption_enabled": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+												"cloud_watch_log_group_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"s3_bucket_encryption_enabled": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+												names.AttrS3BucketName: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrS3KeyPrefix: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"logging": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"managed_storage_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fargate_ephemeral_storage_kms_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrKMSKeyID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"pending_tasks_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -93,6 +161,13 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 	arn := aws.ToString(cluster.ClusterArn)
 	d.SetId(arn)
 	d.Set(names.AttrARN, arn)
+	if cluster.Configuration != nil {
+		if err := d.Set(names.AttrConfiguration, flattenClusterConfiguration(cluster.Configuration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+		}
+	} else {
+		d.Set(names.AttrConfiguration, nil)
+	}
 	d.Set("pending_tasks_count", cluster.PendingTasksCount)
 	d.Set("registered_container_instances_count", cluster.RegisteredContainerInstancesCount)
 	d.Set("running_tasks_count", cluster.RunningTasksCount)
@@ -66,6 +66,30 @@ func TestAccECSClusterDataSource_ecsClusterContainerInsights(t *testing.T) {
 	})
 }
 
+func TestAccECSClusterDataSource_managedStorageConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_cluster.test"
+	resourceName := "aws_ecs_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterDataSourceConfig_managedStorageConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration.0.managed_storage_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.managed_storage_configuration.0.fargate_ephemeral_storage_kms_key_id", resourceName, "configuration.0.managed_storage_configuration.0.fargate_ephemeral_storage_kms_key_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSClusterDataSource_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ecs_cluster.test"
@@ -138,3 +162,11 @@ data "aws_ecs_cluster" "test" {
 }
 `, rName, tagKey, tagValue)
 }
+
+func testAccClusterDataSourceConfig_managedStorageConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_managedStorageConfiguration(rName, "aws_kms_key.test.arn", "null"), `
+data "aws_ecs_cluster" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+}
+`)
+}
@@ -14,6 +14,7 @@ var (
 	ResourceTaskDefinition           = resourceTaskDefinition
 	ResourceTaskSet                  = resourceTaskSet
 
+	CheckDeploymentRollout                  = checkDeploymentRollout
 	ClusterNameFromARN                      = clusterNameFromARN
 	FindCapacityProviderByARN               = findCapacityProviderByARN
 	FindClusterByNameOrARN                  = findClusterByNameOrARN
@@ -22,6 +23,7 @@ var (
 	FindTag                                 = findTag
 	FindTaskDefinitionByFamilyOrARN         = findTaskDefinitionByFamilyOrARN
 	FindTaskSetNoTagsByThreePartKey         = findTaskSetNoTagsByThreePartKey
+	PrimaryDeploymentID                     = primaryDeploymentID
 	RoleNameFromARN                         = roleNameFromARN
 	TaskDefinitionARNStripRevision          = taskDefinitionARNStripRevision
 	ValidTaskDefinitionContainerDefinitions = validTaskDefinitionContainerDefinitions
@@ -929,8 +929,9 @@ func resourceService() *schema.Resource {
 													},
 												},
 												names.AttrKMSKey: {
-													Type:     schema.TypeString,
-													Optional: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
 												},
 												names.AttrRoleARN: {
 													Type:         schema.TypeString,
@@ -1041,6 +1042,29 @@ func resourceService() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
+									"tag_specifications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrPropagateTags: {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.PropagateTags](),
+												},
+												names.AttrResourceType: {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.EBSResourceType](),
+												},
+												names.AttrTags: {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
 									names.AttrThroughput: {
 										Type:         schema.TypeInt,
 										Optional:     true,
@@ -1202,7 +1226,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 {
-		input.VolumeConfigurations = expandVolumeConfigurations(v.([]interface{}))
+		input.VolumeConfigurations = expandVolumeConfigurations(ctx, v.([]interface{}))
 	}
 
 	output, err := retryServiceCreate(ctx, conn, input)
@@ -1220,11 +1244,12 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.ToString(output.Service.ServiceArn))
 
-	fn := waitServiceActive
 	if d.Get("wait_for_steady_state").(bool) {
-		fn = waitServiceStable
+		_, err = waitServiceStable(ctx, conn, d.Id(), d.Get("cluster").(string), primaryDeploymentID(output.Service), d.Timeout(schema.TimeoutCreate))
+	} else {
+		_, err = waitServiceActive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate))
 	}
-	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
@@ -1486,7 +1511,12 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if d.HasChange("volume_configuration") {
-			input.VolumeConfigurations = expandVolumeConfigurations(d.Get("volume_configuration").([]interface{}))
+			if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 {
+				input.VolumeConfigurations = expandVolumeConfigurations(ctx, v.([]interface{}))
+			} else {
+				// Send an empty list to remove the volume configuration from new deployments.
+				input.VolumeConfigurations = []awstypes.ServiceVolumeConfiguration{}
+			}
 		}
 
 		// Retry due to IAM eventual consistency.
@@ -1494,7 +1524,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			serviceUpdateTimeout = 2 * time.Minute
 			timeout              = propagationTimeout + serviceUpdateTimeout
 		)
-		_, err := tfresource.RetryWhen(ctx, timeout,
+		outputRaw, err := tfresource.RetryWhen(ctx, timeout,
 			func() (interface{}, error) {
 				return conn.UpdateService(ctx, input)
 			},
@@ -1515,11 +1545,12 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
 		}
 
-		fn := waitServiceActive
 		if d.Get("wait_for_steady_state").(bool) {
-			fn = waitServiceStable
+			_, err = waitServiceStable(ctx, conn, d.Id(), cluster, primaryDeploymentID(outputRaw.(*ecs.UpdateServiceOutput).Service), d.Timeout(schema.TimeoutUpdate))
+		} else {
+			_, err = waitServiceActive(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate))
 		}
-		if _, err := fn(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -1809,7 +1840,7 @@ func statusService(ctx context.Context, conn *ecs.Client, serviceName, clusterNa
 	}
 }
 
-func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string) retry.StateRefreshFunc {
+func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN, deploymentID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		outputRaw, status, err := statusService(ctx, conn, serviceName, clusterNameOrARN)()
 
@@ -1823,6 +1854,12 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceNa
 
 		output := outputRaw.(*awstypes.Service)
 
+		if deploymentID != "" {
+			if err := checkDeploymentRollout(output.Deployments, deploymentID); err != nil {
+				return output, "", err
+			}
+		}
+
 		if n, dc, rc := len(output.Deployments), output.DesiredCount, output.RunningCount; n == 1 && dc == rc {
 			status = serviceStatusStable
 		} else {
@@ -1833,13 +1870,59 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceNa
 	}
 }
 
+const (
+	deploymentStatusPrimary = "PRIMARY"
+)
+
+func findPrimaryDeployment(deployments []awstypes.Deployment) *awstypes.Deployment {
+	for _, v := range deployments {
+		if aws.ToString(v.Status) == deploymentStatusPrimary {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+// primaryDeploymentID returns the ID of the service's primary deployment, as returned by CreateService or UpdateService.
+func primaryDeploymentID(service *awstypes.Service) string {
+	if service == nil {
+		return ""
+	}
+
+	if v := findPrimaryDeployment(service.Deployments); v != nil {
+		return aws.ToString(v.Id)
+	}
+
+	return ""
+}
+
+// checkDeploymentRollout returns an error if the specified deployment has failed or has been rolled back,
+// for example by the deployment circuit breaker or a CloudWatch alarm.
+func checkDeploymentRollout(deployments []awstypes.Deployment, deploymentID string) error {
+	for _, v := range deployments {
+		if aws.ToString(v.Id) != deploymentID {
+			continue
+		}
+
+		if v.RolloutState == awstypes.DeploymentRolloutStateFailed {
+			return fmt.Errorf("deployment (%s) failed: %s", deploymentID, aws.ToString(v.RolloutStateReason))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("deployment (%s) is no longer present, it may have been rolled back", deploymentID)
+}
+
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running.
+// Fails if the specified deployment fails or is rolled back.
 // Does not return tags.
-func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, timeout time.Duration) (*awstypes.Service, error) {
+func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN, deploymentID string, timeout time.Duration) (*awstypes.Service, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, serviceName, clusterNameOrARN),
+		Refresh: statusServiceWaitForStable(ctx, conn, serviceName, clusterNameOrARN, deploymentID),
 		Timeout: timeout,
 	}
 
@@ -2258,7 +2341,7 @@ func expandSecretOptions(sop []interface{}) []awstypes.Secret {
 	return out
 }
 
-func expandVolumeConfigurations(vc []interface{}) []awstypes.ServiceVolumeConfiguration {
+func expandVolumeConfigurations(ctx context.Context, vc []interface{}) []awstypes.ServiceVolumeConfiguration {
 	if len(vc) == 0 {
 		return nil
 	}
@@ -2273,7 +2356,7 @@ func expandVolumeConfigurations(vc []interface{}) []awstypes.ServiceVolumeConfig
 		}
 
 		if v, ok := p["managed_ebs_volume"].([]interface{}); ok && len(v) > 0 {
-			config.ManagedEBSVolume = expandManagedEBSVolume(v)
+			config.ManagedEBSVolume = expandManagedEBSVolume(ctx, v)
 		}
 		vcs = append(vcs, config)
 	}
@@ -2281,7 +2364,7 @@ func expandVolumeConfigurations(vc []interface{}) []awstypes.ServiceVolumeConfig
 	return vcs
 }
 
-func expandManagedEBSVolume(ebs []interface{}) *awstypes.ServiceManagedEBSVolumeConfiguration {
+func expandManagedEBSVolume(ctx context.Context, ebs []interface{}) *awstypes.ServiceManagedEBSVolumeConfiguration {
 	if len(ebs) == 0 {
 		return &awstypes.ServiceManagedEBSVolumeConfiguration{}
 	}
@@ -2309,6 +2392,9 @@ func expandManagedEBSVolume(ebs []interface{}) *awstypes.ServiceManagedEBSVolume
 	if v, ok := raw[names.AttrSnapshotID].(string); ok && v != "" {
 		config.SnapshotId = aws.String(v)
 	}
+	if v, ok := raw["tag_specifications"].([]interface{}); ok && len(v) > 0 {
+		config.TagSpecifications = expandEBSTagSpecifications(ctx, v)
+	}
 	if v, ok := raw[names.AttrThroughput].(int); ok && v != 0 {
 		config.Throughput = aws.Int32(int32(v))
 	}
@@ -2319,6 +2405,37 @@ func expandManagedEBSVolume(ebs []interface{}) *awstypes.ServiceManagedEBSVolume
 	return config
 }
 
+func expandEBSTagSpecifications(ctx context.Context, tfList []interface{}) []awstypes.EBSTagSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.EBSTagSpecification, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.EBSTagSpecification{
+			ResourceType: awstypes.EBSResourceType(tfMap[names.AttrResourceType].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrPropagateTags].(string); ok && v != "" {
+			apiObject.PropagateTags = awstypes.PropagateTags(v)
+		}
+
+		if v, ok := tfMap[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Tags = Tags(tftags.New(ctx, v).IgnoreAWS())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandServices(srv []interface{}) []awstypes.ServiceConnectService {
 	if len(srv) == 0 {
 		return nil
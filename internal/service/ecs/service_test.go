@@ -84,6 +84,75 @@ func TestClustereNameFromARN(t *testing.T) {
 	}
 }
 
+func TestCheckDeploymentRollout(t *testing.T) {
+	t.Parallel()
+
+	deployments := []awstypes.Deployment{
+		{
+			Id:           aws.String("ecs-svc/2"),
+			RolloutState: awstypes.DeploymentRolloutStateInProgress,
+			Status:       aws.String("PRIMARY"),
+		},
+		{
+			Id:                 aws.String("ecs-svc/1"),
+			RolloutState:       awstypes.DeploymentRolloutStateFailed,
+			RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+			Status:             aws.String("ACTIVE"),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		deploymentID string
+		wantErr      bool
+	}{
+		{"in progress", "ecs-svc/2", false},
+		{"failed", "ecs-svc/1", true},
+		{"rolled back", "ecs-svc/0", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tfecs.CheckDeploymentRollout(deployments, tt.deploymentID); (err != nil) != tt.wantErr {
+				t.Errorf("CheckDeploymentRollout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrimaryDeploymentID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		service *awstypes.Service
+		want    string
+	}{
+		{"nil service", nil, ""},
+		{"no deployments", &awstypes.Service{}, ""},
+		{"primary deployment", &awstypes.Service{
+			Deployments: []awstypes.Deployment{
+				{
+					Id:     aws.String("ecs-svc/1"),
+					Status: aws.String("ACTIVE"),
+				},
+				{
+					Id:     aws.String("ecs-svc/2"),
+					Status: aws.String("PRIMARY"),
+				},
+			},
+		}, "ecs-svc/2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tfecs.PrimaryDeploymentID(tt.service); got != tt.want {
+				t.Errorf("PrimaryDeploymentID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAccECSService_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service awstypes.Service
@@ -359,6 +428,33 @@ func TestAccECSService_VolumeConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccECSService_VolumeConfiguration_tagSpecifications(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_volumeConfiguration_tagSpecifications(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.0.resource_type", string(awstypes.EBSResourceTypeVolume)),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.0.propagate_tags", string(awstypes.PropagateTagsService)),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.0.tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/38475
 func TestAccECSService_VolumeConfiguration_throughputTypeChange(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1475,6 +1571,14 @@ func TestAccECSService_ServiceConnect_full(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.0.idle_timeout_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.0.per_request_timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.tls.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.issuer_cert_authority.0.aws_pca_authority_arn", "aws_acmpca_certificate_authority.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.kms_key", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.role_arn", "aws_iam_role.test", names.AttrARN),
 				),
 			},
 		},
@@ -2342,6 +2446,36 @@ resource "aws_ecs_service" "test" {
 `, rName))
 }
 
+func testAccServiceConfig_volumeConfiguration_tagSpecifications(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_baseVolumeConfiguration(rName), fmt.Sprintf(`
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+
+  volume_configuration {
+    name = "vol1"
+    managed_ebs_volume {
+      role_arn   = aws_iam_role.ecs_service.arn
+      size_in_gb = 8
+
+      tag_specifications {
+        resource_type  = "volume"
+        propagate_tags = "SERVICE"
+
+        tags = {
+          Name = %[1]q
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.ecs_service]
+}
+`, rName))
+}
+
 func testAccServiceConfig_forceNewDeployment(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -10,9 +10,11 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -1215,6 +1217,26 @@ func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy, "track_latest"},
 			},
+			{
+				// Simulate external tooling registering a new revision of the same family.
+				Config: testAccTaskDefinitionConfig_trackLatest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					testAccCheckTaskDefinitionRegisterNewRevision(ctx, &def),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_trackLatest(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct2),
+				),
+			},
 		},
 	})
 }
@@ -1608,6 +1630,42 @@ func testAccCheckTaskDefinitionExists(ctx context.Context, n string, v *awstypes
 	}
 }
 
+// testAccCheckTaskDefinitionRegisterNewRevision registers a copy of the specified task definition
+// as a new revision of the same family and deregisters the original revision.
+func testAccCheckTaskDefinitionRegisterNewRevision(ctx context.Context, v *awstypes.TaskDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		_, err := conn.RegisterTaskDefinition(ctx, &ecs.RegisterTaskDefinitionInput{
+			ContainerDefinitions:    v.ContainerDefinitions,
+			Cpu:                     v.Cpu,
+			EphemeralStorage:        v.EphemeralStorage,
+			ExecutionRoleArn:        v.ExecutionRoleArn,
+			Family:                  v.Family,
+			IpcMode:                 v.IpcMode,
+			Memory:                  v.Memory,
+			NetworkMode:             v.NetworkMode,
+			PidMode:                 v.PidMode,
+			PlacementConstraints:    v.PlacementConstraints,
+			ProxyConfiguration:      v.ProxyConfiguration,
+			RequiresCompatibilities: v.RequiresCompatibilities,
+			RuntimePlatform:         v.RuntimePlatform,
+			TaskRoleArn:             v.TaskRoleArn,
+			Volumes:                 v.Volumes,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: v.TaskDefinitionArn,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckTaskDefinitionDockerVolumeConfigurationAutoprovisionNil(def *awstypes.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(def.Volumes) != 1 {
@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 // @SDKResource("aws_eks_addon", name="Add-On")
@@ -43,7 +46,10 @@ func resourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateAddonConfigurationValues,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -90,6 +96,23 @@ func resourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pod_identity_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"preserve": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -148,6 +171,10 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ConfigurationValues = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("pod_identity_association"); ok && v.(*schema.Set).Len() > 0 {
+		input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("resolve_conflicts"); ok {
 		input.ResolveConflicts = types.ResolveConflicts(v.(string))
 	} else if v, ok := d.GetOk("resolve_conflicts_on_create"); ok {
@@ -226,6 +253,13 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("configuration_values", addon.ConfigurationValues)
 	d.Set(names.AttrCreatedAt, aws.ToTime(addon.CreatedAt).Format(time.RFC3339))
 	d.Set("modified_at", aws.ToTime(addon.ModifiedAt).Format(time.RFC3339))
+	podIdentityAssociations, err := findAddonPodIdentityAssociations(ctx, conn, clusterName, addon.PodIdentityAssociations)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) pod identity associations: %s", d.Id(), err)
+	}
+	if err := d.Set("pod_identity_association", flattenAddonPodIdentityAssociations(podIdentityAssociations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pod_identity_association: %s", err)
+	}
 	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
 
 	setTagsOut(ctx, addon.Tags)
@@ -242,7 +276,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChanges("addon_version", "service_account_role_arn", "configuration_values") {
+	if d.HasChanges("addon_version", "service_account_role_arn", "configuration_values", "pod_identity_association") {
 		input := &eks.UpdateAddonInput{
 			AddonName:          aws.String(addonName),
 			ClientRequestToken: aws.String(sdkid.UniqueId()),
@@ -257,6 +291,11 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
 		}
 
+		if d.HasChange("pod_identity_association") {
+			// An empty list removes all pod identity associations from the add-on.
+			input.PodIdentityAssociations = expandAddonPodIdentityAssociations(d.Get("pod_identity_association").(*schema.Set).List())
+		}
+
 		var conflictResolutionAttr string
 		var conflictResolution types.ResolveConflicts
 
@@ -356,6 +395,56 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	return output.Addon, nil
 }
 
+func findAddonConfigurationByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (*eks.DescribeAddonConfigurationOutput, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findAddonPodIdentityAssociations returns the pod identity associations with the specified ARNs.
+func findAddonPodIdentityAssociations(ctx context.Context, conn *eks.Client, clusterName string, associationARNs []string) ([]types.PodIdentityAssociation, error) {
+	var output []types.PodIdentityAssociation
+
+	for _, associationARN := range associationARNs {
+		// The association ID is the last part of the ARN.
+		associationID := associationARN[strings.LastIndex(associationARN, "/")+1:]
+
+		association, err := findPodIdentityAssociationByTwoPartKey(ctx, conn, associationID, clusterName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, *association)
+	}
+
+	return output, nil
+}
+
 func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -513,3 +602,95 @@ func errorDetailsError(apiObjects []types.ErrorDetail) error {
 
 	return errors.Join(errs...)
 }
+
+func validateAddonConfigurationValues(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("configuration_values") || !diff.NewValueKnown("addon_version") {
+		return nil
+	}
+
+	if !diff.HasChanges("configuration_values", "addon_version") {
+		return nil
+	}
+
+	configurationValues := diff.Get("configuration_values").(string)
+	addonVersion := diff.Get("addon_version").(string)
+
+	// Only JSON configuration values for a known add-on version are validated at plan time.
+	if configurationValues == "" || addonVersion == "" || !json.Valid([]byte(configurationValues)) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	addonName := diff.Get("addon_name").(string)
+	output, err := findAddonConfigurationByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Add-On (%s) version (%s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	if err := validateAddonConfigurationValuesSchema(aws.ToString(output.ConfigurationSchema), configurationValues); err != nil {
+		return fmt.Errorf("configuration_values are not valid for EKS Add-On (%s) version (%s): %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
+
+// validateAddonConfigurationValuesSchema validates JSON configuration values against an add-on's JSON configuration schema.
+func validateAddonConfigurationValuesSchema(configurationSchema, configurationValues string) error {
+	if configurationSchema == "" {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewStringLoader(configurationValues))
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, v := range result.Errors() {
+		errs = append(errs, errors.New(v.String()))
+	}
+
+	return errors.Join(errs...)
+}
+
+func expandAddonPodIdentityAssociations(tfList []interface{}) []types.AddonPodIdentityAssociations {
+	apiObjects := make([]types.AddonPodIdentityAssociations, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AddonPodIdentityAssociations{
+			RoleArn:        aws.String(tfMap[names.AttrRoleARN].(string)),
+			ServiceAccount: aws.String(tfMap["service_account"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAddonPodIdentityAssociations(apiObjects []types.PodIdentityAssociation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrRoleARN: aws.ToString(apiObject.RoleArn),
+			"service_account": aws.ToString(apiObject.ServiceAccount),
+		})
+	}
+
+	return tfList
+}
@@ -21,6 +21,73 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidateAddonConfigurationValuesSchema(t *testing.T) {
+	t.Parallel()
+
+	const configurationSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "env": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "WARM_ENI_TARGET": {"type": "string"}
+      }
+    },
+    "replicaCount": {"type": "integer"}
+  }
+}`
+
+	testCases := []struct {
+		testName            string
+		configurationSchema string
+		configurationValues string
+		expectError         bool
+	}{
+		{
+			testName:            "empty schema",
+			configurationSchema: "",
+			configurationValues: `{"anything": true}`,
+		},
+		{
+			testName:            "empty object",
+			configurationSchema: configurationSchema,
+			configurationValues: `{}`,
+		},
+		{
+			testName:            "valid",
+			configurationSchema: configurationSchema,
+			configurationValues: `{"env": {"WARM_ENI_TARGET": "2"}, "replicaCount": 3}`,
+		},
+		{
+			testName:            "additional property",
+			configurationSchema: configurationSchema,
+			configurationValues: `{"env": {"INVALID_FIELD": "2"}}`,
+			expectError:         true,
+		},
+		{
+			testName:            "wrong type",
+			configurationSchema: configurationSchema,
+			configurationValues: `{"replicaCount": "three"}`,
+			expectError:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfeks.ValidateAddonConfigurationValuesSchema(testCase.configurationSchema, testCase.configurationValues)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateAddonConfigurationValuesSchema() err = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
 func TestAccEKSAddon_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var addon types.Addon
@@ -298,6 +365,47 @@ func TestAccEKSAddon_serviceAccountRoleARN(t *testing.T) {
 	})
 }
 
+func TestAccEKSAddon_podIdentityAssociation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon types.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	podIdentityRoleResourceName := "aws_iam_role.test_pod_identity"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfig_podIdentityAssociation(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "pod_identity_association.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pod_identity_association.*.role_arn", podIdentityRoleResourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "pod_identity_association.*", map[string]string{
+						"service_account": "aws-node",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAddonConfig_basic(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "pod_identity_association.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEKSAddon_configurationValues(t *testing.T) {
 	ctx := acctest.Context(t)
 	var addon types.Addon
@@ -345,7 +453,7 @@ func TestAccEKSAddon_configurationValues(t *testing.T) {
 			},
 			{
 				Config:      testAccAddonConfig_configurationValues(rName, addonName, addonVersion, invalidConfigurationValues, string(types.ResolveConflictsOverwrite)),
-				ExpectError: regexache.MustCompile(`InvalidParameterException: ConfigurationValue provided in request is not supported`),
+				ExpectError: regexache.MustCompile(`configuration_values are not valid for EKS Add-On`),
 			},
 		},
 	})
@@ -615,6 +723,44 @@ resource "aws_eks_addon" "test" {
 `, rName, addonName))
 }
 
+func testAccAddonConfig_podIdentityAssociation(rName, addonName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
+data "aws_iam_policy_document" "pod_identity_assume_role" {
+  statement {
+    effect  = "Allow"
+    actions = ["sts:AssumeRole", "sts:TagSession"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["pods.eks.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test_pod_identity" {
+  name               = "%[1]s-pod-identity"
+  assume_role_policy = data.aws_iam_policy_document.pod_identity_assume_role.json
+}
+
+resource "aws_iam_role_policy_attachment" "test_pod_identity" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonEKS_CNI_Policy"
+  role       = aws_iam_role.test_pod_identity.name
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[2]q
+
+  pod_identity_association {
+    role_arn        = aws_iam_role.test_pod_identity.arn
+    service_account = "aws-node"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test_pod_identity]
+}
+`, rName, addonName))
+}
+
 func testAccAddonConfig_tags1(rName, addonName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
 resource "aws_eks_addon" "test" {
@@ -960,6 +960,91 @@ func TestAccEKSCluster_upgradePolicy(t *testing.T) {
 	})
 }
 
+func TestAccEKSCluster_zonalShiftConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_zonalShiftConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "zonal_shift_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "zonal_shift_config.0.enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bootstrap_self_managed_addons"},
+			},
+			{
+				Config: testAccClusterConfig_zonalShiftConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "zonal_shift_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "zonal_shift_config.0.enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSCluster_remoteNetworkConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_remoteNetworkConfig(rName, "10.90.0.0/22", "10.80.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_node_networks.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_node_networks.0.cidrs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_network_config.0.remote_node_networks.0.cidrs.*", "10.90.0.0/22"),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_pod_networks.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_pod_networks.0.cidrs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_network_config.0.remote_pod_networks.0.cidrs.*", "10.80.0.0/16"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bootstrap_self_managed_addons"},
+			},
+			{
+				Config: testAccClusterConfig_remoteNetworkConfig(rName, "10.91.0.0/22", "10.80.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_network_config.0.remote_node_networks.0.cidrs.*", "10.91.0.0/22"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterExists(ctx context.Context, n string, v *types.Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1539,3 +1624,51 @@ resource "aws_eks_cluster" "test" {
 }
 `, rName, supportType))
 }
+
+func testAccClusterConfig_zonalShiftConfig(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  zonal_shift_config {
+    enabled = %[2]t
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName, enabled))
+}
+
+func testAccClusterConfig_remoteNetworkConfig(rName, remoteNodeCIDR, remotePodCIDR string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  access_config {
+    authentication_mode = "API"
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  remote_network_config {
+    remote_node_networks {
+      cidrs = [%[2]q]
+    }
+
+    remote_pod_networks {
+      cidrs = [%[3]q]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName, remoteNodeCIDR, remotePodCIDR))
+}
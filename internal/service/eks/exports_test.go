@@ -23,4 +23,5 @@ var (
 	FindNodegroupByTwoPartKey                  = findNodegroupByTwoPartKey
 	FindOIDCIdentityProviderConfigByTwoPartKey = findOIDCIdentityProviderConfigByTwoPartKey
 	FindPodIdentityAssociationByTwoPartKey     = findPodIdentityAssociationByTwoPartKey
+	ValidateAddonConfigurationValuesSchema     = validateAddonConfigurationValuesSchema
 )
@@ -146,6 +146,21 @@ func resourceNodeGroup() *schema.Resource {
 				ConflictsWith: []string{"node_group_name"},
 				ValidateFunc:  validation.StringLenBetween(0, 63-id.UniqueIDSuffixLength),
 			},
+			"node_repair_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"node_role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -288,6 +303,12 @@ func resourceNodeGroup() *schema.Resource {
 								"update_config.0.max_unavailable_percentage",
 							},
 						},
+						"update_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.NodegroupUpdateStrategies](),
+						},
 					},
 				},
 			},
@@ -341,6 +362,10 @@ func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.LaunchTemplate = expandLaunchTemplateSpecification(v)
 	}
 
+	if v, ok := d.GetOk("node_repair_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NodeRepairConfig = expandNodeRepairConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("release_version"); ok {
 		input.ReleaseVersion = aws.String(v.(string))
 	}
@@ -414,6 +439,13 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	d.Set("node_group_name", nodeGroup.NodegroupName)
 	d.Set("node_group_name_prefix", create.NamePrefixFromName(aws.ToString(nodeGroup.NodegroupName)))
+	if nodeGroup.NodeRepairConfig != nil {
+		if err := d.Set("node_repair_config", []interface{}{flattenNodeRepairConfig(nodeGroup.NodeRepairConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting node_repair_config: %s", err)
+		}
+	} else {
+		d.Set("node_repair_config", nil)
+	}
 	d.Set("node_role_arn", nodeGroup.NodeRole)
 	d.Set("release_version", nodeGroup.ReleaseVersion)
 	if err := d.Set("remote_access", flattenRemoteAccessConfig(nodeGroup.RemoteAccess)); err != nil {
@@ -508,7 +540,7 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChanges("labels", "scaling_config", "taint", "update_config") {
+	if d.HasChanges("labels", "node_repair_config", "scaling_config", "taint", "update_config") {
 		oldLabelsRaw, newLabelsRaw := d.GetChange("labels")
 		oldTaintsRaw, newTaintsRaw := d.GetChange("taint")
 
@@ -520,6 +552,12 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			Taints:             expandUpdateTaintsPayload(oldTaintsRaw.(*schema.Set).List(), newTaintsRaw.(*schema.Set).List()),
 		}
 
+		if d.HasChange("node_repair_config") {
+			if v, ok := d.GetOk("node_repair_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.NodeRepairConfig = expandNodeRepairConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("scaling_config") {
 			if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.ScalingConfig = expandNodegroupScalingConfig(v.([]interface{})[0].(map[string]interface{}))
@@ -718,7 +756,10 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, cluste
 
 	if output, ok := outputRaw.(*types.Update); ok {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
-			tfresource.SetLastError(err, errorDetailsError(output.Errors))
+			tfresource.SetLastError(err, errors.Join(errorDetailsError(output.Errors), nodegroupHealthError(ctx, conn, clusterName, nodeGroupName)))
+		} else if err != nil {
+			// The update stalled. Report any nodes that are failing health checks.
+			tfresource.SetLastError(err, nodegroupHealthError(ctx, conn, clusterName, nodeGroupName))
 		}
 
 		return output, err
@@ -727,6 +768,17 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, cluste
 	return nil, err
 }
 
+// nodegroupHealthError returns an error describing the node group's current health issues, including the IDs of the affected nodes.
+func nodegroupHealthError(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string) error {
+	nodeGroup, err := findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
+
+	if err != nil || nodeGroup.Health == nil {
+		return nil
+	}
+
+	return issuesError(nodeGroup.Health.Issues)
+}
+
 func issueError(apiObject types.Issue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
@@ -912,6 +964,24 @@ func expandNodegroupUpdateConfig(tfMap map[string]interface{}) *types.NodegroupU
 		apiObject.MaxUnavailablePercentage = aws.Int32(int32(v))
 	}
 
+	if v, ok := tfMap["update_strategy"].(string); ok && v != "" {
+		apiObject.UpdateStrategy = types.NodegroupUpdateStrategies(v)
+	}
+
+	return apiObject
+}
+
+func expandNodeRepairConfig(tfMap map[string]interface{}) *types.NodeRepairConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.NodeRepairConfig{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
 	return apiObject
 }
 
@@ -1030,6 +1100,22 @@ func flattenNodeGroupUpdateConfig(apiObject *types.NodegroupUpdateConfig) map[st
 		tfMap["max_unavailable_percentage"] = aws.ToInt32(v)
 	}
 
+	tfMap["update_strategy"] = apiObject.UpdateStrategy
+
+	return tfMap
+}
+
+func flattenNodeRepairConfig(apiObject *types.NodeRepairConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap[names.AttrEnabled] = aws.ToBool(v)
+	}
+
 	return tfMap
 }
 
@@ -927,6 +927,7 @@ func TestAccEKSNodeGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "update_config.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable_percentage", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "update_config.0.update_strategy", string(types.NodegroupUpdateStrategiesDefault)),
 				),
 			},
 			{
@@ -941,6 +942,45 @@ func TestAccEKSNodeGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "update_config.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable_percentage", "40"),
+					resource.TestCheckResourceAttr(resourceName, "update_config.0.update_strategy", string(types.NodegroupUpdateStrategiesMinimal)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSNodeGroup_nodeRepairConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var nodeGroup1, nodeGroup2 types.Nodegroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeGroupConfig_nodeRepairConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup1),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNodeGroupConfig_nodeRepairConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup2),
+					testAccCheckNodeGroupNotRecreated(&nodeGroup1, &nodeGroup2),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "node_repair_config.0.enabled", acctest.CtFalse),
 				),
 			},
 		},
@@ -2087,6 +2127,7 @@ resource "aws_eks_node_group" "test" {
 
   update_config {
     max_unavailable_percentage = 40
+    update_strategy            = "MINIMAL"
   }
 
   depends_on = [
@@ -2098,6 +2139,33 @@ resource "aws_eks_node_group" "test" {
 `, rName))
 }
 
+func testAccNodeGroupConfig_nodeRepairConfig(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccNodeGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 1
+    min_size     = 1
+  }
+
+  node_repair_config {
+    enabled = %[2]t
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+  ]
+}
+`, rName, enabled))
+}
+
 func testAccNodeGroupConfig_version(rName, version string) string {
 	return acctest.ConfigCompose(testAccNodeGroupBaseVersionConfig(rName, version), fmt.Sprintf(`
 resource "aws_eks_node_group" "test" {
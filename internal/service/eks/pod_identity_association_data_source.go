@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_eks_pod_identity_association", name="Pod Identity Association")
+func newPodIdentityAssociationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &podIdentityAssociationDataSource{}
+
+	return d, nil
+}
+
+const (
+	DSNamePodIdentityAssociation = "Pod Identity Association Data Source"
+)
+
+type podIdentityAssociationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*podIdentityAssociationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_eks_pod_identity_association"
+}
+
+func (d *podIdentityAssociationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"association_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAssociationID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrNamespace: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			"service_account": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *podIdentityAssociationDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrAssociationID),
+			path.MatchRoot(names.AttrNamespace),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot(names.AttrNamespace),
+			path.MatchRoot("service_account"),
+		),
+	}
+}
+
+func (d *podIdentityAssociationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data podIdentityAssociationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EKSClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	clusterName := data.ClusterName.ValueString()
+	associationID := data.AssociationID.ValueString()
+
+	if associationID == "" {
+		input := &eks.ListPodIdentityAssociationsInput{
+			ClusterName:    aws.String(clusterName),
+			Namespace:      fwflex.StringFromFramework(ctx, data.Namespace),
+			ServiceAccount: fwflex.StringFromFramework(ctx, data.ServiceAccount),
+		}
+
+		summary, err := findPodIdentityAssociationSummary(ctx, conn, input)
+
+		if err != nil {
+			err = tfresource.SingularDataSourceFindError("EKS Pod Identity Association", err)
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EKS, create.ErrActionReading, DSNamePodIdentityAssociation, clusterName, err),
+				err.Error(),
+			)
+
+			return
+		}
+
+		associationID = aws.ToString(summary.AssociationId)
+	}
+
+	output, err := findPodIdentityAssociationByTwoPartKey(ctx, conn, associationID, clusterName)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, DSNamePodIdentityAssociation, associationID, err),
+			err.Error(),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.AssociationId)
+	data.Tags = tftags.FlattenStringValueMap(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type podIdentityAssociationDataSourceModel struct {
+	AssociationARN types.String `tfsdk:"association_arn"`
+	AssociationID  types.String `tfsdk:"association_id"`
+	ClusterName    types.String `tfsdk:"cluster_name"`
+	ID             types.String `tfsdk:"id"`
+	Namespace      types.String `tfsdk:"namespace"`
+	RoleARN        fwtypes.ARN  `tfsdk:"role_arn"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+	Tags           tftags.Map   `tfsdk:"tags"`
+}
+
+func findPodIdentityAssociationSummary(ctx context.Context, conn *eks.Client, input *eks.ListPodIdentityAssociationsInput) (*awstypes.PodIdentityAssociationSummary, error) {
+	output, err := findPodIdentityAssociationSummaries(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPodIdentityAssociationSummaries(ctx context.Context, conn *eks.Client, input *eks.ListPodIdentityAssociationsInput) ([]awstypes.PodIdentityAssociationSummary, error) {
+	var output []awstypes.PodIdentityAssociationSummary
+
+	pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Associations...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSPodIdentityAssociationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_pod_identity_association.test"
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "association_arn", dataSourceName, "association_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAssociationID, dataSourceName, names.AttrAssociationID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, dataSourceName, names.AttrClusterName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrNamespace, dataSourceName, names.AttrNamespace),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, dataSourceName, names.AttrRoleARN),
+					resource.TestCheckResourceAttrPair(resourceName, "service_account", dataSourceName, "service_account"),
+					resource.TestCheckResourceAttrPair(resourceName, acctest.CtTagsPercent, dataSourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociationDataSource_serviceAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_pod_identity_association.test"
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationDataSourceConfig_serviceAccount(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "association_arn", dataSourceName, "association_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAssociationID, dataSourceName, names.AttrAssociationID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, dataSourceName, names.AttrRoleARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccPodIdentityAssociationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_basic(rName), `
+data "aws_eks_pod_identity_association" "test" {
+  cluster_name   = aws_eks_pod_identity_association.test.cluster_name
+  association_id = aws_eks_pod_identity_association.test.association_id
+}
+`)
+}
+
+func testAccPodIdentityAssociationDataSourceConfig_serviceAccount(rName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationConfig_basic(rName), `
+data "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_pod_identity_association.test.cluster_name
+  namespace       = aws_eks_pod_identity_association.test.namespace
+  service_account = aws_eks_pod_identity_association.test.service_account
+}
+`)
+}
@@ -401,6 +401,9 @@ func resourceReplicationGroup() *schema.Resource {
 					diff.HasChange("num_node_groups") ||
 					diff.HasChange("replicas_per_node_group")
 			}),
+			replicationGroupValidateClusterModeMigration,
+			customdiff.ComputedIf("cluster_enabled", replicationGroupClusterModeChanged),
+			customdiff.ComputedIf("configuration_endpoint_address", replicationGroupClusterModeChanged),
 			customdiff.ForceNewIf("transit_encryption_enabled", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				// For Redis engine versions < 7.0.5, transit_encryption_enabled can only
 				// be configured during creation of the cluster.
@@ -774,6 +777,13 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		o, n := d.GetChange("num_cache_clusters")
 		oldCacheClusterCount, newCacheClusterCount := o.(int), n.(int)
 
+		// Migrate between cluster modes before any shard changes, which may depend on the new mode.
+		if d.HasChange("cluster_mode") {
+			if err := modifyReplicationGroupClusterMode(ctx, conn, d); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		if d.HasChanges("num_node_groups", "replicas_per_node_group") {
 			if err := modifyReplicationGroupShardConfiguration(ctx, conn, d); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
@@ -811,11 +821,6 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
-		if d.HasChange("cluster_mode") {
-			input.ClusterMode = awstypes.ClusterMode(d.Get("cluster_mode").(string))
-			requestUpdate = true
-		}
-
 		if d.HasChange(names.AttrEngineVersion) {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 			requestUpdate = true
@@ -876,7 +881,8 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
-		if d.HasChange(names.AttrParameterGroupName) {
+		// A parameter group change accompanying a cluster mode change has already been applied.
+		if d.HasChange(names.AttrParameterGroupName) && !d.HasChange("cluster_mode") {
 			input.CacheParameterGroupName = aws.String(d.Get(names.AttrParameterGroupName).(string))
 			requestUpdate = true
 		}
@@ -1092,6 +1098,40 @@ func modifyReplicationGroupShardConfiguration(ctx context.Context, conn *elastic
 	return nil
 }
 
+// modifyReplicationGroupClusterMode migrates a replication group online between cluster modes.
+// Any parameter group change is applied in the same request, as the target mode typically
+// requires a parameter group with a matching cluster-enabled setting.
+func modifyReplicationGroupClusterMode(ctx context.Context, conn *elasticache.Client, d *schema.ResourceData) error {
+	input := &elasticache.ModifyReplicationGroupInput{
+		ApplyImmediately:   aws.Bool(true),
+		ClusterMode:        awstypes.ClusterMode(d.Get("cluster_mode").(string)),
+		ReplicationGroupId: aws.String(d.Id()),
+	}
+
+	if d.HasChange(names.AttrParameterGroupName) {
+		input.CacheParameterGroupName = aws.String(d.Get(names.AttrParameterGroupName).(string))
+	}
+
+	const (
+		delay = 30 * time.Second
+	)
+	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) update: %w", d.Id(), err)
+	}
+
+	_, err := conn.ModifyReplicationGroup(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("modifying ElastiCache Replication Group (%s) cluster mode: %w", d.Id(), err)
+	}
+
+	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) update: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
 func modifyReplicationGroupShardConfigurationNumNodeGroups(ctx context.Context, conn *elasticache.Client, d *schema.ResourceData, argument string) error {
 	o, n := d.GetChange(argument)
 	oldNodeGroupCount, newNodeGroupCount := o.(int), n.(int)
@@ -1425,6 +1465,35 @@ func replicationGroupValidateMultiAZAutomaticFailover(_ context.Context, diff *s
 	return nil
 }
 
+// replicationGroupClusterModeChanged returns true when an existing replication group's cluster mode is changing.
+func replicationGroupClusterModeChanged(_ context.Context, diff *schema.ResourceDiff, _ interface{}) bool {
+	return diff.Id() != "" && diff.HasChange("cluster_mode")
+}
+
+// replicationGroupValidateClusterModeMigration enforces the supported online migration path:
+// disabled -> compatible -> enabled. Compatible may be reverted to disabled, but enabled is final.
+func replicationGroupValidateClusterModeMigration(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("cluster_mode") {
+		return nil
+	}
+
+	o, n := diff.GetChange("cluster_mode")
+	old, new := awstypes.ClusterMode(o.(string)), awstypes.ClusterMode(n.(string))
+
+	if old == "" || new == "" {
+		return nil
+	}
+
+	switch {
+	case old == awstypes.ClusterModeDisabled && new == awstypes.ClusterModeEnabled:
+		return fmt.Errorf(`"cluster_mode": cannot change from %q to %q directly, change to %q first`, old, new, awstypes.ClusterModeCompatible)
+	case old == awstypes.ClusterModeEnabled:
+		return fmt.Errorf(`"cluster_mode": cannot change from %q to %q`, old, new)
+	}
+
+	return nil
+}
+
 // replicationGroupValidateAutomaticFailoverNumCacheClusters validates that `automatic_failover_enabled` is set when `multi_az_enabled` is true
 func replicationGroupValidateAutomaticFailoverNumCacheClusters(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	if v := diff.Get("automatic_failover_enabled").(bool); !v {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -211,6 +212,9 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 						},
 						"ecpu_per_second": schema.ListNestedBlock{
 							CustomType: fwtypes.NewListNestedObjectTypeOf[ecpuPerSecondModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"maximum": schema.Int64Attribute{
@@ -342,6 +346,13 @@ func (r *serverlessCacheResource) Update(ctx context.Context, request resource.U
 			return
 		}
 
+		if !new.CacheUsageLimits.Equal(old.CacheUsageLimits) {
+			response.Diagnostics.Append(expandRemovedCacheUsageLimits(ctx, old, new, input)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		_, err := conn.ModifyServerlessCache(ctx, input)
 
 		if err != nil {
@@ -592,3 +603,43 @@ func serverlessCacheHasChanges(_ context.Context, plan, state serverlessCacheRes
 		!plan.SecurityGroupIDs.Equal(state.SecurityGroupIDs) ||
 		!plan.SnapshotRetentionLimit.Equal(state.SnapshotRetentionLimit)
 }
+
+// expandRemovedCacheUsageLimits sends empty limits for any previously configured usage limit
+// that has been removed, as omitting a limit from the request leaves it unchanged.
+func expandRemovedCacheUsageLimits(ctx context.Context, old, new serverlessCacheResourceModel, input *elasticache.ModifyServerlessCacheInput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldLimits, d := old.CacheUsageLimits.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || oldLimits == nil {
+		return diags
+	}
+
+	newLimits, d := new.CacheUsageLimits.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if input.CacheUsageLimits == nil {
+		input.CacheUsageLimits = &awstypes.CacheUsageLimits{}
+	}
+
+	oldDataStorage, d := oldLimits.DataStorage.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if oldDataStorage != nil && (newLimits == nil || newLimits.DataStorage.IsNull() || len(newLimits.DataStorage.Elements()) == 0) {
+		input.CacheUsageLimits.DataStorage = &awstypes.DataStorage{
+			Unit: oldDataStorage.Unit.ValueEnum(),
+		}
+	}
+
+	if !oldLimits.ECPUPerSecond.IsNull() && len(oldLimits.ECPUPerSecond.Elements()) > 0 && (newLimits == nil || newLimits.ECPUPerSecond.IsNull() || len(newLimits.ECPUPerSecond.Elements()) == 0) {
+		input.CacheUsageLimits.ECPUPerSecond = &awstypes.ECPUPerSecond{}
+	}
+
+	return diags
+}
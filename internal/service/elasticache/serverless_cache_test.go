@@ -362,6 +362,57 @@ func TestAccElastiCacheServerlessCache_updatesc(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheServerlessCache_cacheUsageLimitsRemoved(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := "Memcached Serverless Cluster"
+	resourceName := "aws_elasticache_serverless_cache.test"
+	var v1, v2, v3 awstypes.ServerlessCache
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckServerlessCacheDestroy(ctx),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_updatesc(rName, description, 1, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.ecpu_per_second.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccServerlessCacheConfig_cacheUsageLimitsDataStorage(rName, description, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &v2),
+					testAccCheckServerlessCacheNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.0.maximum", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.ecpu_per_second.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccServerlessCacheConfig_update(rName, description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &v3),
+					testAccCheckServerlessCacheNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheServerlessCache_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -655,6 +706,22 @@ resource "aws_elasticache_serverless_cache" "test" {
 `, rName, desc, d1, d2)
 }
 
+func testAccServerlessCacheConfig_cacheUsageLimitsDataStorage(rName, desc string, maximum int64) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine      = "memcached"
+  name        = %[1]q
+  description = %[2]q
+  cache_usage_limits {
+    data_storage {
+      maximum = %[3]d
+      unit    = "GB"
+    }
+  }
+}
+`, rName, desc, maximum)
+}
+
 func testAccServerlessCacheConfig_tags(rName, tags string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_serverless_cache" "test" {
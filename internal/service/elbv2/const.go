@@ -22,10 +22,11 @@ const (
 	loadBalancerAttributeLoadBalancingCrossZoneEnabled = "load_balancing.cross_zone.enabled"
 
 	// The following attributes are supported by both Application Load Balancers and Network Load Balancers:
-	loadBalancerAttributeAccessLogsS3Enabled   = "access_logs.s3.enabled"
-	loadBalancerAttributeAccessLogsS3Bucket    = "access_logs.s3.bucket"
-	loadBalancerAttributeAccessLogsS3Prefix    = "access_logs.s3.prefix"
-	loadBalancerAttributeIPv6DenyAllIGWTraffic = "ipv6.deny_all_igw_traffic"
+	loadBalancerAttributeAccessLogsS3Enabled     = "access_logs.s3.enabled"
+	loadBalancerAttributeAccessLogsS3Bucket      = "access_logs.s3.bucket"
+	loadBalancerAttributeAccessLogsS3Prefix      = "access_logs.s3.prefix"
+	loadBalancerAttributeIPv6DenyAllIGWTraffic   = "ipv6.deny_all_igw_traffic"
+	loadBalancerAttributeZonalShiftConfigEnabled = "zonal_shift.config.enabled"
 
 	// The following attributes are supported by only Application Load Balancers:
 	loadBalancerAttributeIdleTimeoutTimeoutSeconds                       = "idle_timeout.timeout_seconds"
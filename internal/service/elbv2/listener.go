@@ -394,6 +394,7 @@ func resourceListener() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			validateListenerActionsCustomDiff(names.AttrDefaultAction),
+			validateListenerMutualAuthenticationCustomDiff,
 		),
 	}
 }
@@ -905,10 +906,15 @@ func expandMutualAuthenticationAttributes(l []interface{}) *awstypes.MutualAuthe
 			Mode: aws.String(mode),
 		}
 	case mutualAuthenticationPassthrough:
-		return &awstypes.MutualAuthenticationAttributes{
-			Mode:          aws.String(mode),
-			TrustStoreArn: aws.String(tfMap["trust_store_arn"].(string)),
+		apiObject := &awstypes.MutualAuthenticationAttributes{
+			Mode: aws.String(mode),
+		}
+
+		if v, ok := tfMap["trust_store_arn"].(string); ok && v != "" {
+			apiObject.TrustStoreArn = aws.String(v)
 		}
+
+		return apiObject
 	default:
 		return &awstypes.MutualAuthenticationAttributes{
 			Mode:                          aws.String(mode),
@@ -1242,6 +1248,58 @@ func validateListenerActionsCustomDiff(attrName string) schema.CustomizeDiffFunc
 	}
 }
 
+func validateListenerMutualAuthenticationCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	var diags diag.Diagnostics
+
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	mutualAuthenticationPath := cty.GetAttrPath("mutual_authentication")
+	mutualAuthentication := configRaw.GetAttr("mutual_authentication")
+	if !mutualAuthentication.IsKnown() || mutualAuthentication.IsNull() {
+		return nil
+	}
+
+	it := mutualAuthentication.ElementIterator()
+	for it.Next() {
+		i, v := it.Element()
+		path := mutualAuthenticationPath.Index(i)
+
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		mode := v.GetAttr(names.AttrMode)
+		if !mode.IsKnown() || mode.IsNull() {
+			continue
+		}
+
+		switch m := strings.ToLower(mode.AsString()); m {
+		case mutualAuthenticationVerify:
+			if tsa := v.GetAttr("trust_store_arn"); tsa.IsKnown() && (tsa.IsNull() || tsa.AsString() == "") {
+				diags = append(diags, errs.NewAttributeRequiredWhenError(
+					path.GetAttr("trust_store_arn"),
+					path.GetAttr(names.AttrMode),
+					m,
+				))
+			}
+
+		default:
+			if icce := v.GetAttr("ignore_client_certificate_expiry"); icce.IsKnown() && !icce.IsNull() && icce.True() {
+				diags = append(diags, errs.NewAttributeConflictsWhenError(
+					path.GetAttr("ignore_client_certificate_expiry"),
+					path.GetAttr(names.AttrMode),
+					m,
+				))
+			}
+		}
+	}
+
+	return sdkdiag.DiagnosticsError(diags)
+}
+
 func listenerActionsPlantimeValidate(actionsPath cty.Path, actions cty.Value, diags *diag.Diagnostics) {
 	it := actions.ElementIterator()
 	for it.Next() {
@@ -1138,6 +1138,28 @@ func TestAccELBV2Listener_mutualAuthenticationPassthrough(t *testing.T) {
 	})
 }
 
+func TestAccELBV2Listener_mutualAuthenticationValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccListenerConfig_mutualAuthenticationInvalid(rName, tfelbv2.MutualAuthenticationVerify, false),
+				ExpectError: regexache.MustCompile(regexp.QuoteMeta(`Attribute "mutual_authentication[0].trust_store_arn" must be specified when "mutual_authentication[0].mode" is "verify"`)),
+			},
+			{
+				Config:      testAccListenerConfig_mutualAuthenticationInvalid(rName, tfelbv2.MutualAuthenticationPassthrough, true),
+				ExpectError: regexache.MustCompile(regexp.QuoteMeta(`Attribute "mutual_authentication[0].ignore_client_certificate_expiry" cannot be specified when "mutual_authentication[0].mode" is "passthrough"`)),
+			},
+		},
+	})
+}
+
 func TestAccELBV2Listener_LoadBalancerARN_gatewayLoadBalancer(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Listener
@@ -3634,3 +3656,42 @@ resource "aws_lb_target_group" "test" {
 }
 `, rName))
 }
+
+func testAccListenerConfig_mutualAuthenticationInvalid(rName, mode string, ignoreClientCertificateExpiry bool) string {
+	return acctest.ConfigCompose(testAccListenerConfig_base(rName), fmt.Sprintf(`
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = aws_lb.test.id
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2016-08"
+  certificate_arn   = "arn:${data.aws_partition.current.partition}:iam::123456789012:server-certificate/%[1]s"
+
+  default_action {
+    type = "fixed-response"
+
+    fixed_response {
+      content_type = "text/plain"
+      status_code  = "200"
+    }
+  }
+
+  mutual_authentication {
+    mode                             = %[2]q
+    ignore_client_certificate_expiry = %[3]t
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_lb" "test" {
+  name            = %[1]q
+  internal        = true
+  security_groups = [aws_security_group.test.id]
+  subnets         = aws_subnet.test[*].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, mode, ignoreClientCertificateExpiry))
+}
@@ -56,6 +56,7 @@ func resourceTargetGroup() *schema.Resource {
 			resourceTargetGroupCustomizeDiff,
 			customizeDiffTargetGroupTargetTypeLambda,
 			customizeDiffTargetGroupTargetTypeNotLambda,
+			customizeDiffTargetGroupLoadBalancingAnomalyMitigation,
 			verify.SetTagsDiff,
 		),
 
@@ -1106,6 +1107,40 @@ func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDi
 	return nil
 }
 
+func customizeDiffTargetGroupLoadBalancingAnomalyMitigation(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	config := diff.GetRawConfig()
+
+	if v := config.GetAttr("load_balancing_anomaly_mitigation"); !v.IsKnown() || v.IsNull() || v.AsString() != loadBalancingAnomalyMitigationOn {
+		return nil
+	}
+
+	if v := config.GetAttr("load_balancing_algorithm_type"); !v.IsKnown() {
+		return nil
+	}
+
+	// Target anomaly mitigation (automatic target weights) is only supported by the weighted random algorithm.
+	algorithmType := diff.Get("load_balancing_algorithm_type").(string)
+	if algorithmType == "" {
+		algorithmType = loadBalancingAlgorithmTypeRoundRobin
+	}
+
+	if algorithmType != loadBalancingAlgorithmTypeWeightedRandom {
+		return sdkdiag.DiagnosticError(errs.NewAttributeErrorDiagnostic(
+			cty.GetAttrPath("load_balancing_anomaly_mitigation"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q can only be %q when %q is %q, got %q.",
+				errs.PathString(cty.GetAttrPath("load_balancing_anomaly_mitigation")),
+				loadBalancingAnomalyMitigationOn,
+				errs.PathString(cty.GetAttrPath("load_balancing_algorithm_type")),
+				loadBalancingAlgorithmTypeWeightedRandom,
+				algorithmType,
+			),
+		))
+	}
+
+	return nil
+}
+
 func customizeDiffTargetGroupTargetTypeLambda(_ context.Context, diff *schema.ResourceDiff, meta any) error {
 	targetType := awstypes.TargetTypeEnum(diff.Get("target_type").(string))
 	if targetType != awstypes.TargetTypeEnumLambda {
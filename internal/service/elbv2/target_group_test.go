@@ -2314,11 +2314,11 @@ func TestAccELBV2TargetGroup_ALBAlias_InvalidAnomalyMitigation(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccTargetGroupConfig_albLoadBalancingAnomalyMitigation(rName, true, "round_robin", "on"),
-				ExpectError: regexache.MustCompile("You cannot enable both anomaly mitigation and round robin algorithm on a target group"),
+				ExpectError: regexache.MustCompile(regexp.QuoteMeta(`Attribute "load_balancing_anomaly_mitigation" can only be "on" when "load_balancing_algorithm_type" is "weighted_random", got "round_robin"`)),
 			},
 			{
 				Config:      testAccTargetGroupConfig_albLoadBalancingAnomalyMitigation(rName, true, "least_outstanding_requests", "on"),
-				ExpectError: regexache.MustCompile("You cannot enable both anomaly mitigation and least outstanding requests algorithm on a target group"),
+				ExpectError: regexache.MustCompile(regexp.QuoteMeta(`Attribute "load_balancing_anomaly_mitigation" can only be "on" when "load_balancing_algorithm_type" is "weighted_random", got "least_outstanding_requests"`)),
 			},
 		},
 	})
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/fsx"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -341,10 +342,130 @@ func resourceONTAPVolume() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.VolumeType](),
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			resourceONTAPVolumeSnaplockConfigurationCustomizeDiff,
+		),
+	}
+}
+
+func resourceONTAPVolumeSnaplockConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// A volume can't be converted to or from a SnapLock volume after creation.
+	if d.Id() != "" && d.HasChange("snaplock_configuration.#") {
+		if o, n := d.GetChange("snaplock_configuration.#"); o.(int) != n.(int) {
+			return errors.New("snaplock_configuration can't be added to or removed from an existing volume")
+		}
+	}
+
+	if d.Id() != "" && d.HasChange("snaplock_configuration.0.privileged_delete") {
+		if o, n := d.GetChange("snaplock_configuration.0.privileged_delete"); o.(string) == string(awstypes.PrivilegedDeletePermanentlyDisabled) && n.(string) != "" {
+			return fmt.Errorf("snaplock_configuration.0.privileged_delete can't be changed once it is set to %s", awstypes.PrivilegedDeletePermanentlyDisabled)
+		}
+	}
+
+	v, ok := d.GetOk("snaplock_configuration.0.retention_period")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	return validateSnaplockRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+}
+
+// validateSnaplockRetentionPeriod checks that the default retention period lies between the minimum and maximum retention periods.
+// Periods are normalized to a common unit before being compared; infinite and unspecified periods are not compared.
+func validateSnaplockRetentionPeriod(tfMap map[string]interface{}) error {
+	retentionPeriod := func(key string) (snaplockRetentionPeriod, bool) {
+		v, ok := tfMap[key].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			return snaplockRetentionPeriod{}, false
+		}
+
+		tfMap := v[0].(map[string]interface{})
+		typ, _ := tfMap[names.AttrType].(string)
+		value, _ := tfMap[names.AttrValue].(int)
+
+		switch awstypes.RetentionPeriodType(typ) {
+		case "", awstypes.RetentionPeriodTypeInfinite, awstypes.RetentionPeriodTypeUnspecified:
+			return snaplockRetentionPeriod{}, false
+		}
+
+		return snaplockRetentionPeriod{typ: awstypes.RetentionPeriodType(typ), value: value}, true
+	}
+
+	defaultRetention, ok := retentionPeriod("default_retention")
+	if !ok {
+		return nil
+	}
+
+	if v, ok := retentionPeriod("minimum_retention"); ok && v.longerThan(defaultRetention) {
+		return fmt.Errorf("snaplock_configuration.0.retention_period.0.default_retention (%s) must be greater than or equal to minimum_retention (%s)", defaultRetention, v)
+	}
+
+	if v, ok := retentionPeriod("maximum_retention"); ok && defaultRetention.longerThan(v) {
+		return fmt.Errorf("snaplock_configuration.0.retention_period.0.default_retention (%s) must be less than or equal to maximum_retention (%s)", defaultRetention, v)
+	}
+
+	return nil
+}
+
+type snaplockRetentionPeriod struct {
+	typ   awstypes.RetentionPeriodType
+	value int
+}
+
+func (p snaplockRetentionPeriod) String() string {
+	return fmt.Sprintf("%d %s", p.value, p.typ)
+}
+
+// months returns the period in months, if it is expressed in months or years.
+func (p snaplockRetentionPeriod) months() (int64, bool) {
+	switch p.typ {
+	case awstypes.RetentionPeriodTypeMonths:
+		return int64(p.value), true
+	case awstypes.RetentionPeriodTypeYears:
+		return int64(p.value) * 12, true
+	default:
+		return 0, false
+	}
+}
+
+// seconds returns the shortest and longest number of seconds that the period can span.
+// Months and years vary in length, so they span a range.
+func (p snaplockRetentionPeriod) seconds() (int64, int64) {
+	const day = 24 * 60 * 60
+	v := int64(p.value)
+
+	switch p.typ {
+	case awstypes.RetentionPeriodTypeMinutes:
+		return v * 60, v * 60
+	case awstypes.RetentionPeriodTypeHours:
+		return v * 60 * 60, v * 60 * 60
+	case awstypes.RetentionPeriodTypeDays:
+		return v * day, v * day
+	case awstypes.RetentionPeriodTypeMonths:
+		return v * 28 * day, v * 31 * day
+	case awstypes.RetentionPeriodTypeYears:
+		return v * 365 * day, v * 366 * day
+	default:
+		return v, v
 	}
 }
 
+// longerThan reports whether the period is certainly longer than another period.
+func (p snaplockRetentionPeriod) longerThan(other snaplockRetentionPeriod) bool {
+	if m, ok := p.months(); ok {
+		if n, ok := other.months(); ok {
+			return m > n
+		}
+	}
+
+	shortest, _ := p.seconds()
+	_, longest := other.seconds()
+
+	return shortest > longest
+}
+
 func resourceONTAPVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FSxClient(ctx)
@@ -504,6 +504,67 @@ func TestAccFSxONTAPVolume_snaplock(t *testing.T) {
 	})
 }
 
+func TestAccFSxONTAPVolume_snaplockAddToExisting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var volume awstypes.Volume
+	resourceName := "aws_fsx_ontap_volume.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckONTAPVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccONTAPVolumeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckONTAPVolumeExists(ctx, resourceName, &volume),
+					resource.TestCheckResourceAttr(resourceName, "snaplock_configuration.#", acctest.Ct0),
+				),
+			},
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockCreate(rName),
+				ExpectError: regexache.MustCompile(`snaplock_configuration can't be added to or removed from an existing volume`),
+			},
+		},
+	})
+}
+
+func TestAccFSxONTAPVolume_snaplockInvalidRetention(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckONTAPVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockRetention(rName, 40, "DAYS", 30, "DAYS"),
+				ExpectError: regexache.MustCompile(`must be less than or equal to maximum_retention`),
+			},
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockRetention(rName, 5, "DAYS", 30, "DAYS"),
+				ExpectError: regexache.MustCompile(`must be greater than or equal to minimum_retention`),
+			},
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockRetention(rName, 2, "MONTHS", 30, "DAYS"),
+				ExpectError: regexache.MustCompile(`must be less than or equal to maximum_retention`),
+			},
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockRetention(rName, 2, "YEARS", 18, "MONTHS"),
+				ExpectError: regexache.MustCompile(`must be less than or equal to maximum_retention`),
+			},
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockRetention(rName, 200, "HOURS", 1, "MONTHS"),
+				ExpectError: regexache.MustCompile(`must be greater than or equal to minimum_retention`),
+			},
+		},
+	})
+}
+
 func TestAccFSxONTAPVolume_snapshotPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var volume1, volume2 awstypes.Volume
@@ -1048,6 +1109,42 @@ resource "aws_fsx_ontap_volume" "test" {
 `, rName))
 }
 
+func testAccONTAPVolumeConfig_snaplockRetention(rName string, defaultRetention int, defaultRetentionType string, maximumRetention int, maximumRetentionType string) string {
+	return acctest.ConfigCompose(testAccONTAPVolumeConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_ontap_volume" "test" {
+  name                       = %[1]q
+  junction_path              = "/%[1]s"
+  size_in_megabytes          = 1024
+  skip_final_backup          = true
+  storage_efficiency_enabled = true
+  storage_virtual_machine_id = aws_fsx_ontap_storage_virtual_machine.test.id
+
+  snaplock_configuration {
+    snaplock_type = "ENTERPRISE"
+
+    retention_period {
+      default_retention {
+        type  = %[3]q
+        value = %[2]d
+      }
+
+      maximum_retention {
+        type  = %[5]q
+        value = %[4]d
+      }
+
+      minimum_retention {
+        type  = "DAYS"
+        value = 10
+      }
+    }
+  }
+
+  bypass_snaplock_enterprise_retention = true
+}
+`, rName, defaultRetention, defaultRetentionType, maximumRetention, maximumRetentionType))
+}
+
 /*
 func testAccONTAPVolumeConfig_snaplockUpdate(rName string) string {
 	return acctest.ConfigCompose(testAccONTAPVolumeConfig_base(rName), fmt.Sprintf(`
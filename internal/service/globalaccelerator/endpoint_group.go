@@ -53,6 +53,11 @@ func resourceEndpointGroup() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"attachment_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
 						"client_ip_preservation_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -231,7 +236,23 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set(names.AttrARN, endpointGroup.EndpointGroupArn)
-	if err := d.Set("endpoint_configuration", flattenEndpointDescriptions(endpointGroup.EndpointDescriptions)); err != nil {
+	endpointConfigurations := flattenEndpointDescriptions(endpointGroup.EndpointDescriptions)
+	// The cross-account attachment ARN isn't returned by the API, so carry it over from state.
+	attachmentARNs := make(map[string]string)
+	for _, tfMapRaw := range d.Get("endpoint_configuration").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			if v, ok := tfMap["attachment_arn"].(string); ok && v != "" {
+				attachmentARNs[tfMap["endpoint_id"].(string)] = v
+			}
+		}
+	}
+	for _, tfMapRaw := range endpointConfigurations {
+		tfMap := tfMapRaw.(map[string]interface{})
+		if v, ok := attachmentARNs[tfMap["endpoint_id"].(string)]; ok {
+			tfMap["attachment_arn"] = v
+		}
+	}
+	if err := d.Set("endpoint_configuration", endpointConfigurations); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting endpoint_configuration: %s", err)
 	}
 	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
@@ -372,6 +393,10 @@ func expandEndpointConfiguration(tfMap map[string]interface{}) *awstypes.Endpoin
 
 	apiObject := &awstypes.EndpointConfiguration{}
 
+	if v, ok := tfMap["attachment_arn"].(string); ok && v != "" {
+		apiObject.AttachmentArn = aws.String(v)
+	}
+
 	if v, ok := tfMap["client_ip_preservation_enabled"].(bool); ok {
 		apiObject.ClientIPPreservationEnabled = aws.Bool(v)
 	}
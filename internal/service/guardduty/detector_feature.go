@@ -33,7 +33,6 @@ func ResourceDetectorFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -124,7 +123,12 @@ func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+	additionalConfigurations := feature.AdditionalConfiguration
+	// RUNTIME_MONITORING reports every agent management configuration; only track those configured.
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		additionalConfigurations = filterDetectorAdditionalConfigurationResults(additionalConfigurations, v.([]interface{}))
+	}
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(additionalConfigurations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
 	}
 	d.Set("detector_id", detectorID)
@@ -201,6 +205,30 @@ func expandDetectorAdditionalConfigurations(tfList []interface{}) []awstypes.Det
 	return apiObjects
 }
 
+// filterDetectorAdditionalConfigurationResults returns the API objects whose names appear in tfList, in configured order.
+func filterDetectorAdditionalConfigurationResults(apiObjects []awstypes.DetectorAdditionalConfigurationResult, tfList []interface{}) []awstypes.DetectorAdditionalConfigurationResult {
+	var results []awstypes.DetectorAdditionalConfigurationResult
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name, _ := tfMap[names.AttrName].(string)
+
+		for _, apiObject := range apiObjects {
+			if string(apiObject.Name) == name {
+				results = append(results, apiObject)
+				break
+			}
+		}
+	}
+
+	return results
+}
+
 func flattenDetectorFeatureConfigurationResult(apiObject awstypes.DetectorFeatureConfigurationResult) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
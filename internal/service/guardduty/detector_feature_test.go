@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -93,6 +94,54 @@ func testAccDetectorFeature_additionalConfiguration(t *testing.T) {
 	})
 }
 
+func testAccDetectorFeature_runtimeMonitoring(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_runtimeMonitoring("ENABLED", "ENABLED", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.name", "EC2_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+				),
+			},
+			{
+				Config: testAccDetectorFeatureConfig_runtimeMonitoring("ENABLED", "DISABLED", "ENABLED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.name", "EC2_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDetectorFeature_multiple(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource1Name := "aws_guardduty_detector_feature.test1"
@@ -211,6 +260,30 @@ resource "aws_guardduty_detector_feature" "test" {
 `, featureStatus, additionalConfigurationStatus)
 }
 
+func testAccDetectorFeatureConfig_runtimeMonitoring(featureStatus, ecsStatus, ec2Status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "RUNTIME_MONITORING"
+  status      = %[1]q
+
+  additional_configuration {
+    name   = "ECS_FARGATE_AGENT_MANAGEMENT"
+    status = %[2]q
+  }
+
+  additional_configuration {
+    name   = "EC2_AGENT_MANAGEMENT"
+    status = %[3]q
+  }
+}
+`, featureStatus, ecsStatus, ec2Status)
+}
+
 func testAccDetectorFeatureConfig_multiple(status1, status2, status3 string) string {
 	return fmt.Sprintf(`
 resource "aws_guardduty_detector" "test" {
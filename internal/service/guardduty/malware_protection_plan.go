@@ -87,6 +87,13 @@ func (r *resourceMalwareProtectionPlan) Schema(ctx context.Context, req resource
 			names.AttrStatus: schema.StringAttribute{
 				Computed: true,
 			},
+			"status_reasons": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[statusReasonModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[statusReasonModel](ctx),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -328,6 +335,7 @@ type malwareProtectionPlanResourceModel struct {
 	ProtectedResource fwtypes.ListNestedObjectValueOf[protectedResourceModel] `tfsdk:"protected_resource"`
 	Role              fwtypes.ARN                                             `tfsdk:"role"`
 	Status            types.String                                            `tfsdk:"status"`
+	StatusReasons     fwtypes.ListNestedObjectValueOf[statusReasonModel]      `tfsdk:"status_reasons"`
 	Tags              tftags.Map                                              `tfsdk:"tags"`
 	TagsAll           tftags.Map                                              `tfsdk:"tags_all"`
 }
@@ -344,6 +352,11 @@ type protectedResourceModel struct {
 	S3Bucket fwtypes.ListNestedObjectValueOf[s3BucketModel] `tfsdk:"s3_bucket"`
 }
 
+type statusReasonModel struct {
+	Code    types.String `tfsdk:"code"`
+	Message types.String `tfsdk:"message"`
+}
+
 type s3BucketModel struct {
 	BucketName     types.String                     `tfsdk:"bucket_name"`
 	ObjectPrefixes fwtypes.SetValueOf[types.String] `tfsdk:"object_prefixes"`
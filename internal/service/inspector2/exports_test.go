@@ -5,6 +5,9 @@ package inspector2
 
 // Exports for use in tests only.
 var (
-	EnablerID      = enablerID
-	ParseEnablerID = parseEnablerID
+	ResourceFilter = newFilterResource
+
+	EnablerID       = enablerID
+	FindFilterByARN = findFilterByARN
+	ParseEnablerID  = parseEnablerID
 )
@@ -0,0 +1,573 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Filter")
+// @Tags(identifierAttribute="arn")
+func newFilterResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &filterResource{}, nil
+}
+
+type filterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*filterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_filter"
+}
+
+func (r *filterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FilterAction](),
+				Required:   true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrOwnerID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[filterCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAWSAccountID:               stringFilterSchemaFramework(ctx),
+						"code_vulnerability_detector_name":   stringFilterSchemaFramework(ctx),
+						"code_vulnerability_detector_tags":   stringFilterSchemaFramework(ctx),
+						"code_vulnerability_file_path":       stringFilterSchemaFramework(ctx),
+						"component_id":                       stringFilterSchemaFramework(ctx),
+						"component_type":                     stringFilterSchemaFramework(ctx),
+						"ec2_instance_image_id":              stringFilterSchemaFramework(ctx),
+						"ec2_instance_subnet_id":             stringFilterSchemaFramework(ctx),
+						"ec2_instance_vpc_id":                stringFilterSchemaFramework(ctx),
+						"ecr_image_architecture":             stringFilterSchemaFramework(ctx),
+						"ecr_image_hash":                     stringFilterSchemaFramework(ctx),
+						"ecr_image_pushed_at":                dateFilterSchemaFramework(ctx),
+						"ecr_image_registry":                 stringFilterSchemaFramework(ctx),
+						"ecr_image_repository_name":          stringFilterSchemaFramework(ctx),
+						"ecr_image_tags":                     stringFilterSchemaFramework(ctx),
+						"epss_score":                         numberFilterSchemaFramework(ctx),
+						"exploit_available":                  stringFilterSchemaFramework(ctx),
+						"finding_arn":                        stringFilterSchemaFramework(ctx),
+						"finding_status":                     stringFilterSchemaFramework(ctx),
+						"finding_type":                       stringFilterSchemaFramework(ctx),
+						"first_observed_at":                  dateFilterSchemaFramework(ctx),
+						"fix_available":                      stringFilterSchemaFramework(ctx),
+						"inspector_score":                    numberFilterSchemaFramework(ctx),
+						"lambda_function_execution_role_arn": stringFilterSchemaFramework(ctx),
+						"lambda_function_last_modified_at":   dateFilterSchemaFramework(ctx),
+						"lambda_function_layers":             stringFilterSchemaFramework(ctx),
+						"lambda_function_name":               stringFilterSchemaFramework(ctx),
+						"lambda_function_runtime":            stringFilterSchemaFramework(ctx),
+						"last_observed_at":                   dateFilterSchemaFramework(ctx),
+						"network_protocol":                   stringFilterSchemaFramework(ctx),
+						"port_range":                         portRangeFilterSchemaFramework(ctx),
+						"related_vulnerabilities":            stringFilterSchemaFramework(ctx),
+						names.AttrResourceID:                 stringFilterSchemaFramework(ctx),
+						names.AttrResourceTags:               mapFilterSchemaFramework(ctx),
+						names.AttrResourceType:               stringFilterSchemaFramework(ctx),
+						"severity":                           stringFilterSchemaFramework(ctx),
+						"title":                              stringFilterSchemaFramework(ctx),
+						"updated_at":                         dateFilterSchemaFramework(ctx),
+						"vendor_severity":                    stringFilterSchemaFramework(ctx),
+						"vulnerability_id":                   stringFilterSchemaFramework(ctx),
+						"vulnerability_source":               stringFilterSchemaFramework(ctx),
+						"vulnerable_packages":                packageFilterSchemaFramework(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dateFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[dateFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"start_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+			},
+		},
+	}
+}
+
+func mapFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[mapFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.MapComparison](),
+					Required:   true,
+				},
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterAttributesFramework() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"lower_inclusive": schema.Float64Attribute{
+			Optional: true,
+		},
+		"upper_inclusive": schema.Float64Attribute{
+			Optional: true,
+		},
+	}
+}
+
+func numberFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[numberFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: numberFilterAttributesFramework(),
+		},
+	}
+}
+
+func packageFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[packageFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"architecture": singleStringFilterSchemaFramework(ctx),
+				"epoch": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[numberFilterModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: numberFilterAttributesFramework(),
+					},
+				},
+				names.AttrName:            singleStringFilterSchemaFramework(ctx),
+				"release":                 singleStringFilterSchemaFramework(ctx),
+				"source_lambda_layer_arn": singleStringFilterSchemaFramework(ctx),
+				"source_layer_hash":       singleStringFilterSchemaFramework(ctx),
+				names.AttrVersion:         singleStringFilterSchemaFramework(ctx),
+			},
+		},
+	}
+}
+
+func portRangeFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[portRangeFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"begin_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+				"end_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func stringFilterAttributesFramework() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"comparison": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.StringComparison](),
+			Required:   true,
+		},
+		names.AttrValue: schema.StringAttribute{
+			Required: true,
+		},
+	}
+}
+
+func singleStringFilterSchemaFramework(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[stringFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: stringFilterAttributesFramework(),
+		},
+	}
+}
+
+func stringFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[stringFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: stringFilterAttributesFramework(),
+		},
+	}
+}
+
+func (r *filterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	input := &inspector2.CreateFilterInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFilter(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 Filter (%s)", aws.ToString(input.Name)), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.Arn)
+	data.ARN = types.StringValue(arn)
+	data.setID()
+
+	filter, err := findFilterByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Filter (%s)", arn), err.Error())
+
+		return
+	}
+
+	data.OwnerID = fwflex.StringToFramework(ctx, filter.OwnerId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *filterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	arn := data.ID.ValueString()
+	filter, err := findFilterByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Filter (%s)", arn), err.Error())
+
+		return
+	}
+
+	// The filter's criteria are returned as "Criteria" rather than "FilterCriteria".
+	response.Diagnostics.Append(fwflex.Flatten(ctx, filter, &data, fwflex.WithFieldNamePrefix("Filter"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// A cleared description or reason is returned as an empty string.
+	data.Description = fwflex.StringValueToFramework(ctx, aws.ToString(filter.Description))
+	data.Reason = fwflex.StringValueToFramework(ctx, aws.ToString(filter.Reason))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *filterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.Action.Equal(old.Action) ||
+		!new.Description.Equal(old.Description) ||
+		!new.FilterCriteria.Equal(old.FilterCriteria) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Reason.Equal(old.Reason) {
+		input := &inspector2.UpdateFilterInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.FilterArn = aws.String(new.ID.ValueString())
+
+		// Send empty values explicitly so that removed settings are cleared.
+		if new.Description.IsNull() && !old.Description.IsNull() {
+			input.Description = aws.String("")
+		}
+		if new.Reason.IsNull() && !old.Reason.IsNull() {
+			input.Reason = aws.String("")
+		}
+
+		_, err := conn.UpdateFilter(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 Filter (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *filterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	arn := data.ID.ValueString()
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(arn),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 Filter (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *filterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	return findFilter(ctx, conn, input)
+}
+
+func findFilter(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) (*awstypes.Filter, error) {
+	output, err := findFilters(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFilters(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) ([]awstypes.Filter, error) {
+	var output []awstypes.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	return output, nil
+}
+
+type filterResourceModel struct {
+	Action         fwtypes.StringEnum[awstypes.FilterAction]            `tfsdk:"action"`
+	ARN            types.String                                         `tfsdk:"arn"`
+	Description    types.String                                         `tfsdk:"description"`
+	FilterCriteria fwtypes.ListNestedObjectValueOf[filterCriteriaModel] `tfsdk:"filter_criteria"`
+	ID             types.String                                         `tfsdk:"id"`
+	Name           types.String                                         `tfsdk:"name"`
+	OwnerID        types.String                                         `tfsdk:"owner_id"`
+	Reason         types.String                                         `tfsdk:"reason"`
+	Tags           tftags.Map                                           `tfsdk:"tags"`
+	TagsAll        tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+func (data *filterResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *filterResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+type filterCriteriaModel struct {
+	AWSAccountID                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"aws_account_id"`
+	CodeVulnerabilityDetectorName  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_name"`
+	CodeVulnerabilityDetectorTags  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_tags"`
+	CodeVulnerabilityFilePath      fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_file_path"`
+	ComponentID                    fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_id"`
+	ComponentType                  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_type"`
+	Ec2InstanceImageID             fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_image_id"`
+	Ec2InstanceSubnetID            fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_subnet_id"`
+	Ec2InstanceVPCID               fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_vpc_id"`
+	EcrImageArchitecture           fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_architecture"`
+	EcrImageHash                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_hash"`
+	EcrImagePushedAt               fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"ecr_image_pushed_at"`
+	EcrImageRegistry               fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_registry"`
+	EcrImageRepositoryName         fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_repository_name"`
+	EcrImageTags                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_tags"`
+	EpssScore                      fwtypes.SetNestedObjectValueOf[numberFilterModel]    `tfsdk:"epss_score"`
+	ExploitAvailable               fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"exploit_available"`
+	FindingARN                     fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_arn"`
+	FindingStatus                  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_status"`
+	FindingType                    fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_type"`
+	FirstObservedAt                fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"first_observed_at"`
+	FixAvailable                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"fix_available"`
+	InspectorScore                 fwtypes.SetNestedObjectValueOf[numberFilterModel]    `tfsdk:"inspector_score"`
+	LambdaFunctionExecutionRoleARN fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_execution_role_arn"`
+	LambdaFunctionLastModifiedAt   fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"lambda_function_last_modified_at"`
+	LambdaFunctionLayers           fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_layers"`
+	LambdaFunctionName             fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_name"`
+	LambdaFunctionRuntime          fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_runtime"`
+	LastObservedAt                 fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"last_observed_at"`
+	NetworkProtocol                fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"network_protocol"`
+	PortRange                      fwtypes.SetNestedObjectValueOf[portRangeFilterModel] `tfsdk:"port_range"`
+	RelatedVulnerabilities         fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"related_vulnerabilities"`
+	ResourceID                     fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_id"`
+	ResourceTags                   fwtypes.SetNestedObjectValueOf[mapFilterModel]       `tfsdk:"resource_tags"`
+	ResourceType                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_type"`
+	Severity                       fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"severity"`
+	Title                          fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"title"`
+	UpdatedAt                      fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"updated_at"`
+	VendorSeverity                 fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"vendor_severity"`
+	VulnerabilityID                fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_id"`
+	VulnerabilitySource            fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_source"`
+	VulnerablePackages             fwtypes.SetNestedObjectValueOf[packageFilterModel]   `tfsdk:"vulnerable_packages"`
+}
+
+type dateFilterModel struct {
+	EndInclusive   timetypes.RFC3339 `tfsdk:"end_inclusive"`
+	StartInclusive timetypes.RFC3339 `tfsdk:"start_inclusive"`
+}
+
+type mapFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.MapComparison] `tfsdk:"comparison"`
+	Key        types.String                               `tfsdk:"key"`
+	Value      types.String                               `tfsdk:"value"`
+}
+
+type numberFilterModel struct {
+	LowerInclusive types.Float64 `tfsdk:"lower_inclusive"`
+	UpperInclusive types.Float64 `tfsdk:"upper_inclusive"`
+}
+
+type packageFilterModel struct {
+	Architecture         fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"architecture"`
+	Epoch                fwtypes.ListNestedObjectValueOf[numberFilterModel] `tfsdk:"epoch"`
+	Name                 fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"name"`
+	Release              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"release"`
+	SourceLambdaLayerARN fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_lambda_layer_arn"`
+	SourceLayerHash      fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_layer_hash"`
+	Version              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"version"`
+}
+
+type portRangeFilterModel struct {
+	BeginInclusive types.Int64 `tfsdk:"begin_inclusive"`
+	EndInclusive   types.Int64 `tfsdk:"end_inclusive"`
+}
+
+type stringFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.StringComparison] `tfsdk:"comparison"`
+	Value      types.String                                  `tfsdk:"value"`
+}
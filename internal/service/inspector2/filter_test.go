@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFilter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filter types.Filter
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, string(types.FilterActionSuppress)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "inspector2", regexache.MustCompile(`owner/.+/filter/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerability_id.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.vulnerability_id.*", map[string]string{
+						"comparison":    string(types.StringComparisonEquals),
+						names.AttrValue: "CVE-2023-4863",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filter types.Filter
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceFilter, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filter types.Filter
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, string(types.FilterActionSuppress)),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerability_id.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccFilterConfig_full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, string(types.FilterActionNone)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "accepted risk"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.ecr_image_repository_name.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.ecr_image_repository_name.*", map[string]string{
+						"comparison":    string(types.StringComparisonPrefix),
+						names.AttrValue: "legacy/",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.inspector_score.*", map[string]string{
+						"lower_inclusive": acctest.Ct0,
+						"upper_inclusive": "3.9",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.resource_tags.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.resource_tags.*", map[string]string{
+						"comparison":    string(types.MapComparisonEquals),
+						names.AttrKey:   "Environment",
+						names.AttrValue: "sandbox",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerability_id.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "reason", "reviewed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckNoResourceAttr(resourceName, "reason"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFilter_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filter types.Filter
+	resourceName := "aws_inspector2_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccFilterConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFilterExists(ctx context.Context, n string, v *types.Filter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		output, err := tfinspector2.FindFilterByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFilterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_filter" {
+				continue
+			}
+
+			_, err := tfinspector2.FindFilterByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Inspector2 Filter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFilterConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    vulnerability_id {
+      comparison = "EQUALS"
+      value      = "CVE-2023-4863"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_full(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name        = %[1]q
+  action      = "NONE"
+  description = "accepted risk"
+  reason      = "reviewed"
+
+  filter_criteria {
+    ecr_image_repository_name {
+      comparison = "PREFIX"
+      value      = "legacy/"
+    }
+
+    inspector_score {
+      lower_inclusive = 0
+      upper_inclusive = 3.9
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "sandbox"
+    }
+
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+
+    severity {
+      comparison = "EQUALS"
+      value      = "INFORMATIONAL"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    vulnerability_id {
+      comparison = "EQUALS"
+      value      = "CVE-2023-4863"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFilterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    vulnerability_id {
+      comparison = "EQUALS"
+      value      = "CVE-2023-4863"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
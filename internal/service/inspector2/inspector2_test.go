@@ -26,6 +26,12 @@ func TestAccInspector2_serial(t *testing.T) {
 			"memberAccount_updateMemberAccountsAndScanTypes": testAccEnabler_memberAccount_updateMemberAccountsAndScanTypes,
 			"memberAccount_disappearsMemberAssociation":      testAccEnabler_memberAccount_disappearsMemberAssociation,
 		},
+		"Filter": {
+			acctest.CtBasic:      testAccFilter_basic,
+			acctest.CtDisappears: testAccFilter_disappears,
+			"update":             testAccFilter_update,
+			"tags":               testAccFilter_tags,
+		},
 		"DelegatedAdminAccount": {
 			acctest.CtBasic:      testAccDelegatedAdminAccount_basic,
 			acctest.CtDisappears: testAccDelegatedAdminAccount_disappears,
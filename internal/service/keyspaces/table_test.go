@@ -178,6 +178,49 @@ func TestAccKeyspacesTable_clientSideTimestamps(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_autoScaling(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_autoScaling(rName1, rName2, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.maximum_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.target_tracking_scaling_policy_configuration.0.target_value", "70"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.maximum_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.target_tracking_scaling_policy_configuration.0.target_value", "70"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_autoScaling(rName1, rName2, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.target_tracking_scaling_policy_configuration.0.target_value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.target_tracking_scaling_policy_configuration.0.target_value", "50"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesTable_multipleColumns(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v keyspaces.GetTableOutput
@@ -672,6 +715,56 @@ resource "aws_keyspaces_table" "test" {
 `, rName1, rName2)
 }
 
+func testAccTableConfig_autoScaling(rName1, rName2 string, targetValue int) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  capacity_specification {
+    read_capacity_units  = 5
+    write_capacity_units = 5
+    throughput_mode      = "PROVISIONED"
+  }
+
+  auto_scaling_specification {
+    read_capacity_auto_scaling {
+      minimum_units = 5
+      maximum_units = 10
+
+      target_tracking_scaling_policy_configuration {
+        target_value = %[3]d
+      }
+    }
+
+    write_capacity_auto_scaling {
+      minimum_units = 5
+      maximum_units = 10
+
+      target_tracking_scaling_policy_configuration {
+        target_value = %[3]d
+      }
+    }
+  }
+}
+`, rName1, rName2, targetValue)
+}
+
 func testAccTableConfig_multipleColumns(rName1, rName2 string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
@@ -7,14 +7,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -42,14 +45,17 @@ func resourceKey() *schema.Resource {
 		DeleteWithoutTimeout: resourceKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceKeyImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(iamPropagationTimeout),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceKeyCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -112,6 +118,10 @@ func resourceKey() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"on_demand_rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Optional:              true,
@@ -124,6 +134,10 @@ func resourceKey() *schema.Resource {
 					return json
 				},
 			},
+			"primary_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"rotation_period_in_days": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -244,10 +258,6 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Id(), err)
 	}
 
-	if aws.ToBool(key.metadata.MultiRegion) && key.metadata.MultiRegionConfiguration.MultiRegionKeyType != awstypes.MultiRegionKeyTypePrimary {
-		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) is not a multi-Region primary key", d.Id())
-	}
-
 	d.Set(names.AttrARN, key.metadata.Arn)
 	d.Set("custom_key_store_id", key.metadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", key.metadata.CustomerMasterKeySpec)
@@ -257,6 +267,12 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set(names.AttrKeyID, key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
+	// A multi-Region primary key may since have been demoted to a replica by a primary Region update.
+	if v := key.metadata.MultiRegionConfiguration; v != nil && v.PrimaryKey != nil {
+		d.Set("primary_key_arn", v.PrimaryKey.Arn)
+	} else {
+		d.Set("primary_key_arn", nil)
+	}
 	d.Set("rotation_period_in_days", key.rotationPeriodInDays)
 	if key.metadata.XksKeyConfiguration != nil {
 		d.Set("xks_key_id", key.metadata.XksKeyConfiguration.Id)
@@ -309,6 +325,12 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if hasChange, trigger := d.HasChange("on_demand_rotation_trigger"), d.Get("on_demand_rotation_trigger").(string); hasChange && trigger != "" {
+		if err := rotateKeyOnDemand(ctx, conn, "KMS Key", d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if hasChange, enabled := d.HasChange("is_enabled"), d.Get("is_enabled").(bool); hasChange && !enabled {
 		// Only disable after all attributes have been modified because we cannot modify disabled keys.
 		if err := updateKeyEnabled(ctx, conn, "KMS Key", d.Id(), enabled); err != nil {
@@ -319,6 +341,35 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	return append(diags, resourceKeyRead(ctx, d, meta)...)
 }
 
+func resourceKeyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Only elliptic curve (NIST-recommended) and SM2 key pairs can be used for key agreement.
+	if keyUsage, keySpec := awstypes.KeyUsageType(diff.Get("key_usage").(string)), awstypes.CustomerMasterKeySpec(diff.Get("customer_master_key_spec").(string)); keyUsage == awstypes.KeyUsageTypeKeyAgreement {
+		switch keySpec {
+		case awstypes.CustomerMasterKeySpecEccNistP256, awstypes.CustomerMasterKeySpecEccNistP384, awstypes.CustomerMasterKeySpecEccNistP521, awstypes.CustomerMasterKeySpecSm2:
+		default:
+			return fmt.Errorf("key_usage %s is not supported with customer_master_key_spec %s", keyUsage, keySpec)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	key, err := findKeyByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading KMS Key (%s): %w", d.Id(), err)
+	}
+
+	if aws.ToBool(key.MultiRegion) && key.MultiRegionConfiguration.MultiRegionKeyType != awstypes.MultiRegionKeyTypePrimary {
+		return nil, fmt.Errorf("KMS Key (%s) is not a multi-Region primary key", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -647,6 +698,59 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.Client, resourceTyp
 	return nil
 }
 
+func rotateKeyOnDemand(ctx context.Context, conn *kms.Client, resourceTypeName, keyID string) error {
+	input := &kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err := conn.RotateKeyOnDemand(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("rotating %s (%s) on demand: %w", resourceTypeName, keyID, err)
+	}
+
+	return nil
+}
+
+func updatePrimaryRegion(ctx context.Context, conn *kms.Client, resourceTypeName, currentPrimaryKeyARN, newPrimaryKeyARN string) error {
+	currentARN, err := arn.Parse(currentPrimaryKeyARN)
+
+	if err != nil {
+		return fmt.Errorf("parsing primary key ARN: %w", err)
+	}
+
+	newARN, err := arn.Parse(newPrimaryKeyARN)
+
+	if err != nil {
+		return fmt.Errorf("parsing primary key ARN: %w", err)
+	}
+
+	if currentARN.Region == newARN.Region {
+		return nil
+	}
+
+	keyID := strings.TrimPrefix(currentARN.Resource, "key/")
+	input := &kms.UpdatePrimaryRegionInput{
+		KeyId:         aws.String(keyID),
+		PrimaryRegion: aws.String(newARN.Region),
+	}
+
+	// The primary Region is updated from the current primary key's Region.
+	_, err = conn.UpdatePrimaryRegion(ctx, input, func(o *kms.Options) {
+		o.Region = currentARN.Region
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating %s (%s) primary Region (%s): %w", resourceTypeName, keyID, newARN.Region, err)
+	}
+
+	if err := waitKeyPrimaryRegionUpdated(ctx, conn, keyID, currentARN.Region, newARN.Region); err != nil {
+		return fmt.Errorf("waiting for %s (%s) primary Region update: %w", resourceTypeName, keyID, err)
+	}
+
+	return nil
+}
+
 func statusKeyState(ctx context.Context, conn *kms.Client, keyID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findKeyByID(ctx, conn, keyID)
@@ -789,3 +893,41 @@ func waitKeyStatePropagated(ctx context.Context, conn *kms.Client, keyID string,
 
 	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
 }
+
+// waitKeyPrimaryRegionUpdated waits until the key in the new primary Region reports itself as the primary
+// key and both the old and new primary keys have left the Updating state.
+func waitKeyPrimaryRegionUpdated(ctx context.Context, conn *kms.Client, keyID, oldPrimaryRegion, newPrimaryRegion string) error {
+	checkFunc := func() (bool, error) {
+		for region, keyType := range map[string]awstypes.MultiRegionKeyType{
+			oldPrimaryRegion: awstypes.MultiRegionKeyTypeReplica,
+			newPrimaryRegion: awstypes.MultiRegionKeyTypePrimary,
+		} {
+			output, err := findKeyByID(ctx, conn, keyID, func(o *kms.Options) {
+				o.Region = region
+			})
+
+			if tfresource.NotFound(err) {
+				return false, nil
+			}
+
+			if err != nil {
+				return false, err
+			}
+
+			if output.KeyState == awstypes.KeyStateUpdating || output.MultiRegionConfiguration == nil || output.MultiRegionConfiguration.MultiRegionKeyType != keyType {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 5,
+		MinTimeout:                2 * time.Second,
+	}
+	const (
+		timeout = 10 * time.Minute
+	)
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
@@ -514,6 +514,71 @@ func TestAccKMSKey_rotation(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_keyAgreement(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyConfig_keySpecAndUsage(rName, "RSA_2048", "KEY_AGREEMENT"),
+				ExpectError: regexache.MustCompile(`key_usage KEY_AGREEMENT is not supported`),
+			},
+			{
+				Config: testAccKeyConfig_keySpecAndUsage(rName, "ECC_NIST_P256", "KEY_AGREEMENT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "customer_master_key_spec", "ECC_NIST_P256"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "KEY_AGREEMENT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKey_rotateOnDemand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_onDemandRotationTrigger(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_rotation_trigger", "first"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "on_demand_rotation_trigger"},
+			},
+			{
+				Config: testAccKeyConfig_onDemandRotationTrigger(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_rotation_trigger", "second"),
+					testAccCheckKeyOnDemandRotationStarted(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/26174.
 func TestAccKMSKey_tags_IgnoreTags_ModifyOutOfBand(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -727,6 +792,46 @@ func testAccCheckKeyHasPolicy(ctx context.Context, name string, expectedPolicyTe
 	}
 }
 
+func testAccCheckKeyOnDemandRotationStarted(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		// An on-demand rotation is either still in progress or has completed.
+		status, err := conn.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if status.OnDemandRotationStartDate != nil {
+			return nil
+		}
+
+		output, err := conn.ListKeyRotations(ctx, &kms.ListKeyRotationsInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Rotations {
+			if v.RotationType == awstypes.RotationTypeOnDemand {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("KMS Key (%s) has not been rotated on demand", rs.Primary.ID)
+	}
+}
+
 func testAccCheckKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
@@ -831,6 +936,18 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_keySpecAndUsage(rName, keySpec, keyUsage string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  customer_master_key_spec = %[2]q
+  key_usage                = %[3]q
+}
+`, rName, keySpec, keyUsage)
+}
+
 func testAccKeyConfig_asymmetric(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -1225,6 +1342,17 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_onDemandRotationTrigger(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  on_demand_rotation_trigger = %[2]q
+}
+`, rName, trigger)
+}
+
 func testAccKeyConfig_enabledRotationPeriod(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -47,6 +47,11 @@ func dataSourcePublicKey() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validateKeyOrAlias,
 			},
+			"key_agreement_algorithms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"key_usage": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -91,6 +96,7 @@ func dataSourcePublicKeyRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrARN, output.KeyId)
 	d.Set("customer_master_key_spec", output.CustomerMasterKeySpec)
 	d.Set("encryption_algorithms", output.EncryptionAlgorithms)
+	d.Set("key_agreement_algorithms", output.KeyAgreementAlgorithms)
 	d.Set("key_usage", output.KeyUsage)
 	d.Set(names.AttrPublicKey, itypes.Base64Encode(output.PublicKey))
 	d.Set("public_key_pem", string(pem.EncodeToMemory(&pem.Block{
@@ -68,6 +68,33 @@ func TestAccKMSPublicKeyDataSource_encrypt(t *testing.T) {
 	})
 }
 
+func TestAccKMSPublicKeyDataSource_keyAgreement(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_key.test"
+	datasourceName := "data.aws_kms_public_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicKeyDataSourceConfig_keyAgreement(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccPublicKeyCheckDataSource(datasourceName),
+					resource.TestCheckResourceAttrPair(datasourceName, "customer_master_key_spec", resourceName, "customer_master_key_spec"),
+					resource.TestCheckResourceAttr(datasourceName, "encryption_algorithms.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(datasourceName, "key_agreement_algorithms.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(datasourceName, "key_agreement_algorithms.0", "ECDH"),
+					resource.TestCheckResourceAttrPair(datasourceName, "key_usage", resourceName, "key_usage"),
+					resource.TestCheckResourceAttr(datasourceName, "signing_algorithms.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccPublicKeyCheckDataSource(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[name]
@@ -108,3 +135,18 @@ data "aws_kms_public_key" "test" {
 }
 `, rName)
 }
+
+func testAccPublicKeyDataSourceConfig_keyAgreement(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description              = %[1]q
+  deletion_window_in_days  = 7
+  customer_master_key_spec = "ECC_NIST_P256"
+  key_usage                = "KEY_AGREEMENT"
+}
+
+data "aws_kms_public_key" "test" {
+  key_id = aws_kms_key.test.arn
+}
+`, rName)
+}
@@ -15,6 +15,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -45,7 +46,13 @@ func resourceReplicaKey() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// The primary key can be moved to another Region of the same multi-Region key without replacement.
+			customdiff.ForceNewIfChange("primary_key_arn", func(_ context.Context, old, new, meta interface{}) bool {
+				return !multiRegionKeyARNsEqualIgnoringRegion(old.(string), new.(string))
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -99,7 +106,6 @@ func resourceReplicaKey() *schema.Resource {
 			"primary_key_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
@@ -206,8 +212,9 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) has invalid Origin: %s", d.Id(), origin)
 	}
 
-	if !aws.ToBool(key.metadata.MultiRegion) || key.metadata.MultiRegionConfiguration.MultiRegionKeyType != awstypes.MultiRegionKeyTypeReplica {
-		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) is not a multi-Region replica key", d.Id())
+	// A replica key that has been promoted by a primary Region update is now the primary key.
+	if !aws.ToBool(key.metadata.MultiRegion) {
+		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) is not a multi-Region key", d.Id())
 	}
 
 	d.Set(names.AttrARN, key.metadata.Arn)
@@ -245,6 +252,13 @@ func resourceReplicaKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("primary_key_arn") {
+		o, n := d.GetChange("primary_key_arn")
+		if err := updatePrimaryRegion(ctx, conn, "KMS Replica Key", o.(string), n.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange(names.AttrDescription) {
 		if err := updateKeyDescription(ctx, conn, "KMS Replica Key", d.Id(), d.Get(names.AttrDescription).(string)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -303,6 +317,22 @@ func resourceReplicaKeyDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func multiRegionKeyARNsEqualIgnoringRegion(arn1, arn2 string) bool {
+	v1, err := arn.Parse(arn1)
+
+	if err != nil {
+		return false
+	}
+
+	v2, err := arn.Parse(arn2)
+
+	if err != nil {
+		return false
+	}
+
+	return v1.Partition == v2.Partition && v1.AccountID == v2.AccountID && v1.Resource == v2.Resource
+}
+
 func waitReplicaKeyCreated(ctx context.Context, conn *kms.Client, id string) (*awstypes.KeyMetadata, error) {
 	const (
 		timeout = 2 * time.Minute
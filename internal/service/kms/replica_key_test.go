@@ -13,6 +13,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -212,6 +213,64 @@ func TestAccKMSReplicaKey_twoReplicas(t *testing.T) {
 	})
 }
 
+func TestAccKMSReplicaKey_updatePrimaryRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryKeyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeyConfig_primaryRegion(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(primaryKeyResourceName, "primary_key_arn", primaryKeyResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccReplicaKeyConfig_primaryRegion(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", resourceName, names.AttrARN),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(primaryKeyResourceName, "primary_key_arn", resourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccReplicaKeyConfig_primaryRegion(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicaKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return testAccCheckKeyDestroy(ctx)
 }
@@ -308,3 +367,26 @@ resource "aws_kms_replica_key" "test2" {
 }
 `, rName))
 }
+
+func testAccReplicaKeyConfig_primaryRegion(rName string, promote bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
+
+resource "aws_kms_key" "test" {
+  provider = awsalternate
+
+  description             = %[1]q
+  multi_region            = true
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn         = %[2]t ? replace(aws_kms_key.test.arn, ":${data.aws_region.alternate.name}:", ":${data.aws_region.current.name}:") : aws_kms_key.test.arn
+  deletion_window_in_days = 7
+}
+`, rName, promote))
+}
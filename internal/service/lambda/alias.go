@@ -5,17 +5,24 @@ package lambda
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -35,11 +42,47 @@ func resourceAlias() *schema.Resource {
 			StateContext: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deployment": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"routing_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 10,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrInterval: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 2880),
+						},
+						"percentage": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(1, 99),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[aliasDeploymentType](),
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -94,6 +137,10 @@ func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
+	if err := waitAliasFunctionVersionsActive(ctx, conn, aws.ToString(input.FunctionName), aws.ToString(input.FunctionVersion), input.RoutingConfig, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Alias (%s): %s", name, err)
+	}
+
 	output, err := conn.CreateAlias(ctx, input)
 
 	if err != nil {
@@ -138,6 +185,7 @@ func resourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interfa
 func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
 	input := &lambda.UpdateAliasInput{
 		Description:     aws.String(d.Get(names.AttrDescription).(string)),
@@ -147,6 +195,23 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
+	if err := waitAliasFunctionVersionsActive(ctx, conn, aws.ToString(input.FunctionName), aws.ToString(input.FunctionVersion), input.RoutingConfig, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lambda Alias (%s): %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("deployment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && d.HasChange("function_version") {
+		o, _ := d.GetChange("function_version")
+		deployment := v.([]interface{})[0].(map[string]interface{})
+
+		if duration, timeout := aliasDeploymentDuration(deployment), deadline.Remaining(); duration >= timeout {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Alias (%s): deployment takes %s, which exceeds the remaining update timeout (%s); increase timeouts.update", d.Id(), duration, timeout.Round(time.Second))
+		}
+
+		if err := shiftAliasTraffic(ctx, conn, meta.(*conns.AWSClient).CloudWatchClient(ctx), input, o.(string), deployment, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Alias (%s): %s", d.Id(), err)
+		}
+	}
+
 	_, err := conn.UpdateAlias(ctx, input)
 
 	if err != nil {
@@ -221,6 +286,166 @@ func findAlias(ctx context.Context, conn *lambda.Client, input *lambda.GetAliasI
 	return output, nil
 }
 
+// waitAliasFunctionVersionsActive waits for the alias' target versions to become active.
+// Versions published with SnapStart enabled remain pending until optimization completes.
+func waitAliasFunctionVersionsActive(ctx context.Context, conn *lambda.Client, functionName, functionVersion string, routingConfig *awstypes.AliasRoutingConfiguration, timeout time.Duration) error {
+	versions := []string{functionVersion}
+	if routingConfig != nil {
+		for version := range routingConfig.AdditionalVersionWeights {
+			versions = append(versions, version)
+		}
+	}
+
+	for _, version := range versions {
+		if version == "" || version == FunctionVersionLatest {
+			continue
+		}
+
+		input := &lambda.GetFunctionConfigurationInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(version),
+		}
+
+		if err := lambda.NewPublishedVersionActiveWaiter(conn).Wait(ctx, input, timeout); err != nil {
+			return fmt.Errorf("waiting for Lambda Function (%s) version (%s) to become active: %w", functionName, version, err)
+		}
+	}
+
+	return nil
+}
+
+// shiftAliasTraffic gradually routes traffic from the alias' current version to the new version.
+// If any of the deployment's CloudWatch alarms fires, or the timeout is reached, all traffic is returned to the current version.
+func shiftAliasTraffic(ctx context.Context, conn *lambda.Client, cloudwatchConn *cloudwatch.Client, input *lambda.UpdateAliasInput, oldVersion string, tfMap map[string]interface{}, timeout time.Duration) error {
+	newVersion := aws.ToString(input.FunctionVersion)
+	if oldVersion == "" || oldVersion == newVersion {
+		return nil
+	}
+
+	rollbackCtx := context.WithoutCancel(ctx)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	alarms := flex.ExpandStringValueSet(tfMap["alarms"].(*schema.Set))
+	interval := time.Duration(tfMap[names.AttrInterval].(int)) * time.Minute
+
+	for _, weight := range aliasDeploymentWeights(aliasDeploymentType(tfMap[names.AttrType].(string)), tfMap["percentage"].(float64)) {
+		log.Printf("[DEBUG] Shifting Lambda Alias (%s) traffic: %g to version %s", aws.ToString(input.Name), weight, newVersion)
+		_, err := conn.UpdateAlias(ctx, &lambda.UpdateAliasInput{
+			Description:     input.Description,
+			FunctionName:    input.FunctionName,
+			FunctionVersion: aws.String(oldVersion),
+			Name:            input.Name,
+			RoutingConfig: &awstypes.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]float64{
+					newVersion: weight,
+				},
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("shifting traffic to version (%s): %w", newVersion, err)
+		}
+
+		if err := waitAliasDeploymentInterval(ctx, cloudwatchConn, alarms, interval); err != nil {
+			_, rollbackErr := conn.UpdateAlias(rollbackCtx, &lambda.UpdateAliasInput{
+				Description:     input.Description,
+				FunctionName:    input.FunctionName,
+				FunctionVersion: aws.String(oldVersion),
+				Name:            input.Name,
+				RoutingConfig:   &awstypes.AliasRoutingConfiguration{},
+			})
+
+			if rollbackErr != nil {
+				rollbackErr = fmt.Errorf("rolling back to version (%s): %w", oldVersion, rollbackErr)
+			}
+
+			return errors.Join(fmt.Errorf("shifting traffic to version (%s): %w", newVersion, err), rollbackErr)
+		}
+	}
+
+	return nil
+}
+
+// aliasDeploymentDuration returns the time taken to shift all traffic to the new version.
+func aliasDeploymentDuration(tfMap map[string]interface{}) time.Duration {
+	weights := aliasDeploymentWeights(aliasDeploymentType(tfMap[names.AttrType].(string)), tfMap["percentage"].(float64))
+
+	return time.Duration(len(weights)) * time.Duration(tfMap[names.AttrInterval].(int)) * time.Minute
+}
+
+// aliasDeploymentWeights returns the successive weights routed to the new version before it receives all traffic.
+func aliasDeploymentWeights(deploymentType aliasDeploymentType, percentage float64) []float64 {
+	var weights []float64
+
+	switch deploymentType {
+	case aliasDeploymentTypeCanary:
+		weights = append(weights, percentage/100)
+	case aliasDeploymentTypeLinear:
+		for i := 1; float64(i)*percentage < 100; i++ {
+			weights = append(weights, math.Round(float64(i)*percentage*100)/10000)
+		}
+	}
+
+	return weights
+}
+
+// waitAliasDeploymentInterval waits for the specified interval, failing if any of the alarms enters the ALARM state.
+func waitAliasDeploymentInterval(ctx context.Context, conn *cloudwatch.Client, alarms []string, interval time.Duration) error {
+	const (
+		pollInterval = 30 * time.Second
+	)
+	deadline := time.Now().Add(interval)
+
+	for {
+		if err := checkAliasDeploymentAlarms(ctx, conn, alarms); err != nil {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(min(pollInterval, remaining)):
+		}
+	}
+}
+
+func checkAliasDeploymentAlarms(ctx context.Context, conn *cloudwatch.Client, alarms []string) error {
+	if len(alarms) == 0 {
+		return nil
+	}
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarms,
+		AlarmTypes: enum.EnumValues[cloudwatchtypes.AlarmType](),
+		StateValue: cloudwatchtypes.StateValueAlarm,
+	}
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("reading CloudWatch alarms: %w", err)
+		}
+
+		if len(page.MetricAlarms) > 0 {
+			return fmt.Errorf("CloudWatch alarm (%s) is in ALARM state", aws.ToString(page.MetricAlarms[0].AlarmName))
+		}
+
+		if len(page.CompositeAlarms) > 0 {
+			return fmt.Errorf("CloudWatch alarm (%s) is in ALARM state", aws.ToString(page.CompositeAlarms[0].AlarmName))
+		}
+	}
+
+	return nil
+}
+
 func expandAliasRoutingConfiguration(tfList []interface{}) *awstypes.AliasRoutingConfiguration {
 	apiObject := &awstypes.AliasRoutingConfiguration{}
 
@@ -6,7 +6,9 @@ package lambda_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -223,6 +225,122 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaAlias_deployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rString := sdkacctest.RandString(8)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_basic_%s", rString)
+	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_alias_basic_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_lambda_alias_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_deployment(roleName, policyName, attachmentName, funcName, aliasName, "test-fixtures/lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.alarms.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.interval", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "deployment.0.type", "Linear"),
+					resource.TestCheckResourceAttr(resourceName, "function_version", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccAliasConfig_deployment(roleName, policyName, attachmentName, funcName, aliasName, "test-fixtures/lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAliasDeploymentDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		deploymentType string
+		interval       int
+		percentage     float64
+		expected       time.Duration
+	}{
+		{
+			deploymentType: "Canary",
+			interval:       5,
+			percentage:     10,
+			expected:       5 * time.Minute,
+		},
+		{
+			deploymentType: "Linear",
+			interval:       10,
+			percentage:     25,
+			expected:       30 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := tflambda.AliasDeploymentDuration(map[string]interface{}{
+			names.AttrInterval: testCase.interval,
+			"percentage":       testCase.percentage,
+			names.AttrType:     testCase.deploymentType,
+		})
+
+		if got != testCase.expected {
+			t.Errorf("%s %g: got %s, expected %s", testCase.deploymentType, testCase.percentage, got, testCase.expected)
+		}
+	}
+}
+
+func TestAliasDeploymentWeights(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		deploymentType string
+		percentage     float64
+		expected       []float64
+	}{
+		{
+			deploymentType: "Canary",
+			percentage:     10,
+			expected:       []float64{0.1},
+		},
+		{
+			deploymentType: "Linear",
+			percentage:     25,
+			expected:       []float64{0.25, 0.5, 0.75},
+		},
+		{
+			deploymentType: "Linear",
+			percentage:     30,
+			expected:       []float64{0.3, 0.6, 0.9},
+		},
+		{
+			deploymentType: "Linear",
+			percentage:     50,
+			expected:       []float64{0.5},
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := tflambda.AliasDeploymentWeights(tflambda.AliasDeploymentType(testCase.deploymentType), testCase.percentage)
+
+		if !slices.Equal(got, testCase.expected) {
+			t.Errorf("%s %g: got %v, expected %v", testCase.deploymentType, testCase.percentage, got, testCase.expected)
+		}
+	}
+}
+
 func testAccCheckAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -452,3 +570,47 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasConfig_deployment(roleName, policyName, attachmentName, funcName, aliasName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(roleName, policyName, attachmentName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = %[3]q
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs16.x"
+  source_code_hash = filebase64sha256(%[3]q)
+  publish          = "true"
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[2]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[2]q
+  function_name    = aws_lambda_function.test.arn
+  function_version = aws_lambda_function.test.version
+
+  deployment {
+    alarms     = [aws_cloudwatch_metric_alarm.test.alarm_name]
+    interval   = 1
+    percentage = 50
+    type       = "Linear"
+  }
+}
+`, funcName, aliasName, filename))
+}
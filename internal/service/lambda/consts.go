@@ -17,11 +17,30 @@ const (
 	eventSourceMappingStateUpdating  = "Updating"
 )
 
+const (
+	errCodeAccessDeniedException     = "AccessDeniedException"
+	errCodeUnknownOperationException = "UnknownOperationException"
+)
+
 const (
 	iamPropagationTimeout    = 2 * time.Minute
 	lambdaPropagationTimeout = 5 * time.Minute // nosemgrep:ci.lambda-in-const-name, ci.lambda-in-var-name
 )
 
+type aliasDeploymentType string
+
+const (
+	aliasDeploymentTypeCanary aliasDeploymentType = "Canary"
+	aliasDeploymentTypeLinear aliasDeploymentType = "Linear"
+)
+
+func (aliasDeploymentType) Values() []aliasDeploymentType {
+	return []aliasDeploymentType{
+		aliasDeploymentTypeCanary,
+		aliasDeploymentTypeLinear,
+	}
+}
+
 type invocationAction string
 
 const (
@@ -25,12 +25,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_lambda_event_source_mapping", name="Event Source Mapping")
+// @Tags(identifierAttribute="arn")
 func resourceEventSourceMapping() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEventSourceMappingCreate,
@@ -42,6 +44,8 @@ func resourceEventSourceMapping() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: verify.SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"amazon_managed_kafka_event_source_config": {
 				Type:          schema.TypeList,
@@ -62,6 +66,10 @@ func resourceEventSourceMapping() *schema.Resource {
 					},
 				},
 			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"batch_size": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -231,12 +239,48 @@ func resourceEventSourceMapping() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.IntBetween(-1, 10_000),
 			},
+			"metrics_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metrics": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.EventSourceMappingMetric](),
+							},
+						},
+					},
+				},
+			},
 			"parallelization_factor": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.IntBetween(1, 10),
 			},
+			"provisioned_poller_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_pollers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 2_000),
+						},
+						"minimum_pollers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 200),
+						},
+					},
+				},
+			},
 			"queues": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -348,6 +392,8 @@ func resourceEventSourceMapping() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"topics": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -378,6 +424,7 @@ func resourceEventSourceMappingCreate(ctx context.Context, d *schema.ResourceDat
 	input := &lambda.CreateEventSourceMappingInput{
 		Enabled:      aws.Bool(d.Get(names.AttrEnabled).(bool)),
 		FunctionName: aws.String(functionName),
+		Tags:         getTagsIn(ctx),
 	}
 
 	var target string
@@ -433,10 +480,18 @@ func resourceEventSourceMappingCreate(ctx context.Context, d *schema.ResourceDat
 		input.MaximumRetryAttempts = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("metrics_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetricsConfig = expandEventSourceMappingMetricsConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parallelization_factor"); ok {
 		input.ParallelizationFactor = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("provisioned_poller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ProvisionedPollerConfig = expandProvisionedPollerConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("queues"); ok && len(v.([]interface{})) > 0 {
 		input.Queues = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -523,6 +578,7 @@ func resourceEventSourceMappingRead(ctx context.Context, d *schema.ResourceData,
 	} else {
 		d.Set("amazon_managed_kafka_event_source_config", nil)
 	}
+	d.Set(names.AttrARN, output.EventSourceMappingArn)
 	d.Set("batch_size", output.BatchSize)
 	d.Set("bisect_batch_on_function_error", output.BisectBatchOnFunctionError)
 	if output.DestinationConfig != nil {
@@ -560,7 +616,21 @@ func resourceEventSourceMappingRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("maximum_batching_window_in_seconds", output.MaximumBatchingWindowInSeconds)
 	d.Set("maximum_record_age_in_seconds", output.MaximumRecordAgeInSeconds)
 	d.Set("maximum_retry_attempts", output.MaximumRetryAttempts)
+	if v := output.MetricsConfig; v != nil && len(v.Metrics) > 0 {
+		if err := d.Set("metrics_config", []interface{}{flattenEventSourceMappingMetricsConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metrics_config: %s", err)
+		}
+	} else {
+		d.Set("metrics_config", nil)
+	}
 	d.Set("parallelization_factor", output.ParallelizationFactor)
+	if v := output.ProvisionedPollerConfig; v != nil {
+		if err := d.Set("provisioned_poller_config", []interface{}{flattenProvisionedPollerConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting provisioned_poller_config: %s", err)
+		}
+	} else {
+		d.Set("provisioned_poller_config", nil)
+	}
 	d.Set("queues", output.Queues)
 	if v := output.ScalingConfig; v != nil {
 		if err := d.Set("scaling_config", []interface{}{flattenScalingConfig(v)}); err != nil {
@@ -615,100 +685,120 @@ func resourceEventSourceMappingUpdate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
-	input := &lambda.UpdateEventSourceMappingInput{
-		UUID: aws.String(d.Id()),
-	}
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &lambda.UpdateEventSourceMappingInput{
+			UUID: aws.String(d.Id()),
+		}
 
-	if d.HasChange("batch_size") {
-		input.BatchSize = aws.Int32(int32(d.Get("batch_size").(int)))
-	}
+		if d.HasChange("batch_size") {
+			input.BatchSize = aws.Int32(int32(d.Get("batch_size").(int)))
+		}
 
-	if d.HasChange("bisect_batch_on_function_error") {
-		input.BisectBatchOnFunctionError = aws.Bool(d.Get("bisect_batch_on_function_error").(bool))
-	}
+		if d.HasChange("bisect_batch_on_function_error") {
+			input.BisectBatchOnFunctionError = aws.Bool(d.Get("bisect_batch_on_function_error").(bool))
+		}
 
-	if d.HasChange("destination_config") {
-		if v, ok := d.GetOk("destination_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.DestinationConfig = expandDestinationConfig(v.([]interface{})[0].(map[string]interface{}))
+		if d.HasChange("destination_config") {
+			if v, ok := d.GetOk("destination_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DestinationConfig = expandDestinationConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
 		}
-	}
 
-	if d.HasChange("document_db_event_source_config") {
-		if v, ok := d.GetOk("document_db_event_source_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.DocumentDBEventSourceConfig = expandDocumentDBEventSourceConfig(v.([]interface{})[0].(map[string]interface{}))
+		if d.HasChange("document_db_event_source_config") {
+			if v, ok := d.GetOk("document_db_event_source_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DocumentDBEventSourceConfig = expandDocumentDBEventSourceConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
 		}
-	}
 
-	if d.HasChange(names.AttrEnabled) {
-		input.Enabled = aws.Bool(d.Get(names.AttrEnabled).(bool))
-	}
+		if d.HasChange(names.AttrEnabled) {
+			input.Enabled = aws.Bool(d.Get(names.AttrEnabled).(bool))
+		}
 
-	if d.HasChange("filter_criteria") {
-		if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
-		} else {
-			// AWS ignores the removal if this is left as nil.
-			input.FilterCriteria = &awstypes.FilterCriteria{}
+		if d.HasChange("filter_criteria") {
+			if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// AWS ignores the removal if this is left as nil.
+				input.FilterCriteria = &awstypes.FilterCriteria{}
+			}
 		}
-	}
 
-	if d.HasChange("function_name") {
-		input.FunctionName = aws.String(d.Get("function_name").(string))
-	}
+		if d.HasChange("function_name") {
+			input.FunctionName = aws.String(d.Get("function_name").(string))
+		}
 
-	if d.HasChange("function_response_types") {
-		input.FunctionResponseTypes = flex.ExpandStringyValueSet[awstypes.FunctionResponseType](d.Get("function_response_types").(*schema.Set))
-	}
+		if d.HasChange("function_response_types") {
+			input.FunctionResponseTypes = flex.ExpandStringyValueSet[awstypes.FunctionResponseType](d.Get("function_response_types").(*schema.Set))
+		}
 
-	if d.HasChange(names.AttrKMSKeyARN) {
-		input.KMSKeyArn = aws.String(d.Get(names.AttrKMSKeyARN).(string))
-	}
+		if d.HasChange(names.AttrKMSKeyARN) {
+			input.KMSKeyArn = aws.String(d.Get(names.AttrKMSKeyARN).(string))
+		}
 
-	if d.HasChange("maximum_batching_window_in_seconds") {
-		input.MaximumBatchingWindowInSeconds = aws.Int32(int32(d.Get("maximum_batching_window_in_seconds").(int)))
-	}
+		if d.HasChange("maximum_batching_window_in_seconds") {
+			input.MaximumBatchingWindowInSeconds = aws.Int32(int32(d.Get("maximum_batching_window_in_seconds").(int)))
+		}
 
-	if d.HasChange("maximum_record_age_in_seconds") {
-		input.MaximumRecordAgeInSeconds = aws.Int32(int32(d.Get("maximum_record_age_in_seconds").(int)))
-	}
+		if d.HasChange("maximum_record_age_in_seconds") {
+			input.MaximumRecordAgeInSeconds = aws.Int32(int32(d.Get("maximum_record_age_in_seconds").(int)))
+		}
 
-	if d.HasChange("maximum_retry_attempts") {
-		input.MaximumRetryAttempts = aws.Int32(int32(d.Get("maximum_retry_attempts").(int)))
-	}
+		if d.HasChange("maximum_retry_attempts") {
+			input.MaximumRetryAttempts = aws.Int32(int32(d.Get("maximum_retry_attempts").(int)))
+		}
 
-	if d.HasChange("parallelization_factor") {
-		input.ParallelizationFactor = aws.Int32(int32(d.Get("parallelization_factor").(int)))
-	}
+		if d.HasChange("metrics_config") {
+			if v, ok := d.GetOk("metrics_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.MetricsConfig = expandEventSourceMappingMetricsConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// AWS ignores the removal if this is left as nil.
+				input.MetricsConfig = &awstypes.EventSourceMappingMetricsConfig{}
+			}
+		}
 
-	if d.HasChange("scaling_config") {
-		if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.ScalingConfig = expandScalingConfig(v.([]interface{})[0].(map[string]interface{}))
-		} else {
-			// AWS ignores the removal if this is left as nil.
-			input.ScalingConfig = &awstypes.ScalingConfig{}
+		if d.HasChange("parallelization_factor") {
+			input.ParallelizationFactor = aws.Int32(int32(d.Get("parallelization_factor").(int)))
 		}
-	}
 
-	if d.HasChange("source_access_configuration") {
-		if v, ok := d.GetOk("source_access_configuration"); ok && v.(*schema.Set).Len() > 0 {
-			input.SourceAccessConfigurations = expandSourceAccessConfigurations(v.(*schema.Set).List())
+		if d.HasChange("provisioned_poller_config") {
+			if v, ok := d.GetOk("provisioned_poller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ProvisionedPollerConfig = expandProvisionedPollerConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// AWS ignores the removal if this is left as nil.
+				input.ProvisionedPollerConfig = &awstypes.ProvisionedPollerConfig{}
+			}
 		}
-	}
 
-	if d.HasChange("tumbling_window_in_seconds") {
-		input.TumblingWindowInSeconds = aws.Int32(int32(d.Get("tumbling_window_in_seconds").(int)))
-	}
+		if d.HasChange("scaling_config") {
+			if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ScalingConfig = expandScalingConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// AWS ignores the removal if this is left as nil.
+				input.ScalingConfig = &awstypes.ScalingConfig{}
+			}
+		}
 
-	_, err := retryEventSourceMapping(ctx, func() (*lambda.UpdateEventSourceMappingOutput, error) {
-		return conn.UpdateEventSourceMapping(ctx, input)
-	})
+		if d.HasChange("source_access_configuration") {
+			if v, ok := d.GetOk("source_access_configuration"); ok && v.(*schema.Set).Len() > 0 {
+				input.SourceAccessConfigurations = expandSourceAccessConfigurations(v.(*schema.Set).List())
+			}
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Lambda Event Source Mapping (%s): %s", d.Id(), err)
-	}
+		if d.HasChange("tumbling_window_in_seconds") {
+			input.TumblingWindowInSeconds = aws.Int32(int32(d.Get("tumbling_window_in_seconds").(int)))
+		}
+
+		_, err := retryEventSourceMapping(ctx, func() (*lambda.UpdateEventSourceMappingOutput, error) {
+			return conn.UpdateEventSourceMapping(ctx, input)
+		})
 
-	if _, err := waitEventSourceMappingUpdated(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) update: %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Event Source Mapping (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEventSourceMappingUpdated(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceEventSourceMappingRead(ctx, d, meta)...)
@@ -1279,3 +1369,65 @@ func flattenScalingConfig(apiObject *awstypes.ScalingConfig) map[string]interfac
 
 	return tfMap
 }
+
+func expandEventSourceMappingMetricsConfig(tfMap map[string]interface{}) *awstypes.EventSourceMappingMetricsConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.EventSourceMappingMetricsConfig{}
+
+	if v, ok := tfMap["metrics"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Metrics = flex.ExpandStringyValueSet[awstypes.EventSourceMappingMetric](v)
+	}
+
+	return apiObject
+}
+
+func flattenEventSourceMappingMetricsConfig(apiObject *awstypes.EventSourceMappingMetricsConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metrics": apiObject.Metrics,
+	}
+
+	return tfMap
+}
+
+func expandProvisionedPollerConfig(tfMap map[string]interface{}) *awstypes.ProvisionedPollerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ProvisionedPollerConfig{}
+
+	if v, ok := tfMap["maximum_pollers"].(int); ok && v != 0 {
+		apiObject.MaximumPollers = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["minimum_pollers"].(int); ok && v != 0 {
+		apiObject.MinimumPollers = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenProvisionedPollerConfig(apiObject *awstypes.ProvisionedPollerConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaximumPollers; v != nil {
+		tfMap["maximum_pollers"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MinimumPollers; v != nil {
+		tfMap["minimum_pollers"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
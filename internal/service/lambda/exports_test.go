@@ -17,6 +17,8 @@ var (
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
+	AliasDeploymentDuration                      = aliasDeploymentDuration
+	AliasDeploymentWeights                       = aliasDeploymentWeights
 	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
 	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
 	FindEventSourceMappingByID                   = findEventSourceMappingByID
@@ -35,3 +37,7 @@ var (
 	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
 	SignerServiceIsAvailable                     = signerServiceIsAvailable
 )
+
+type (
+	AliasDeploymentType = aliasDeploymentType
+)
@@ -7,13 +7,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -307,6 +313,12 @@ func resourceFunction() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				RequiredWith: []string{"replace_security_groups_on_destroy"},
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.RecursiveLoopTerminate,
+				ValidateDiagFunc: enum.Validate[awstypes.RecursiveLoop](),
+			},
 			"reserved_concurrent_executions": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -407,6 +419,12 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"use_s3_object_checksum": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"filename", "image_uri", "source_code_hash"},
+			},
 			names.AttrVPCConfig: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -458,6 +476,8 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkLoggingConfigLogLevelsForLogFormat,
+			setSourceCodeHashFromS3ObjectChecksum,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -581,7 +601,7 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	_, err := retryFunctionOp(ctx, func() (*lambda.CreateFunctionOutput, error) {
+	output, err := retryFunctionOp(ctx, func() (*lambda.CreateFunctionOutput, error) {
 		return conn.CreateFunction(ctx, input)
 	})
 
@@ -591,6 +611,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(functionName)
 
+	if err := checkCodeSHA256MatchesS3ObjectChecksum(d, aws.ToString(output.CodeSha256)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+	}
+
 	_, err = tfresource.RetryWhenNotFound(ctx, lambdaPropagationTimeout, func() (interface{}, error) {
 		return findFunctionByName(ctx, conn, d.Id())
 	})
@@ -614,6 +638,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v := awstypes.RecursiveLoop(d.Get("recursive_loop").(string)); v != awstypes.RecursiveLoopTerminate {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: v,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -687,6 +722,17 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("memory_size", function.MemorySize)
 	d.Set("package_type", function.PackageType)
+	recursionConfig, err := findFunctionRecursionConfigByName(ctx, conn, d.Id())
+
+	switch {
+	case err == nil:
+		d.Set("recursive_loop", recursionConfig.RecursiveLoop)
+	// Don't require lambda:GetFunctionRecursionConfig permissions, or support in the partition, unless recursive_loop is configured.
+	case awstypes.RecursiveLoop(d.Get("recursive_loop").(string)) == awstypes.RecursiveLoopTerminate && tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException, errCodeUnknownOperationException):
+		d.Set("recursive_loop", awstypes.RecursiveLoopTerminate)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+	}
 	if output.Concurrency != nil {
 		d.Set("reserved_concurrent_executions", output.Concurrency.ReservedConcurrentExecutions)
 	} else {
@@ -715,6 +761,8 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tracing_config: %s", err)
 	}
+	// Support in-place update of non-refreshable attribute.
+	d.Set("use_s3_object_checksum", d.Get("use_s3_object_checksum"))
 	if err := d.Set(names.AttrVPCConfig, flattenVPCConfigResponse(function.VpcConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
 	}
@@ -971,7 +1019,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		_, err := conn.UpdateFunctionCode(ctx, input)
+		output, err := conn.UpdateFunctionCode(ctx, input)
 
 		if err != nil {
 			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "Error occurred while GetObject.") {
@@ -985,6 +1033,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
 		}
 
+		if err := checkCodeSHA256MatchesS3ObjectChecksum(d, aws.ToString(output.CodeSha256)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+		}
+
 		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: waiting for completion: %s", d.Id(), err)
 		}
@@ -1011,6 +1063,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("recursive_loop") {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: awstypes.RecursiveLoop(d.Get("recursive_loop").(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1101,6 +1164,31 @@ func findFunction(ctx context.Context, conn *lambda.Client, input *lambda.GetFun
 	return output, nil
 }
 
+func findFunctionRecursionConfigByName(ctx context.Context, conn *lambda.Client, name string) (*lambda.GetFunctionRecursionConfigOutput, error) {
+	input := &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	output, err := conn.GetFunctionRecursionConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findLatestFunctionVersionByName(ctx context.Context, conn *lambda.Client, name string) (*awstypes.FunctionConfiguration, error) {
 	input := &lambda.ListVersionsByFunctionInput{
 		FunctionName: aws.String(name),
@@ -1333,6 +1421,109 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// Application and system log levels are only supported for JSON structured logs.
+func checkLoggingConfigLogLevelsForLogFormat(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := awstypes.LogFormat(d.Get("logging_config.0.log_format").(string)); v != awstypes.LogFormatText {
+		return nil
+	}
+
+	for _, k := range []string{"application_log_level", "system_log_level"} {
+		if v := d.Get("logging_config.0." + k).(string); v != "" {
+			return fmt.Errorf("logging_config.0.%s cannot be set when log_format is %s", k, awstypes.LogFormatText)
+		}
+	}
+
+	return nil
+}
+
+// setSourceCodeHashFromS3ObjectChecksum sets source_code_hash from the SHA-256 checksum of the
+// S3 object containing the deployment package, so that changes to the object are detected.
+func setSourceCodeHashFromS3ObjectChecksum(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("use_s3_object_checksum").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrS3Bucket) || !d.NewValueKnown("s3_key") || !d.NewValueKnown("s3_object_version") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	// A specific object version is immutable, so there's no need to look it up again.
+	if d.Id() != "" && d.Get("s3_object_version").(string) != "" && !d.HasChanges(names.AttrS3Bucket, "s3_key", "s3_object_version", "use_s3_object_checksum") {
+		return nil
+	}
+
+	awsClient := meta.(*conns.AWSClient)
+	conn := awsClient.S3Client(ctx)
+	bucket, key := d.Get(names.AttrS3Bucket).(string), d.Get("s3_key").(string)
+
+	// The bucket may be in a different Region to the provider's S3 client.
+	region, err := manager.GetBucketRegion(ctx, conn, bucket, func(o *s3.Options) {
+		o.UsePathStyle = awsClient.S3UsePathStyle(ctx)
+		o.Credentials = awsClient.CredentialsProvider(ctx)
+	})
+
+	if errs.IsA[manager.BucketNotFound](err) {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading S3 Bucket (%s) Region: %w", bucket, err)
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		ChecksumMode: s3types.ChecksumModeEnabled,
+		Key:          aws.String(key),
+	}
+	if v, ok := d.GetOk("s3_object_version"); ok {
+		input.VersionId = aws.String(v.(string))
+	}
+
+	output, err := conn.HeadObject(ctx, input, func(o *s3.Options) {
+		o.Region = region
+	})
+
+	// The object may be created during apply, in which case the deployed code's SHA-256 is used.
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+	}
+
+	// Multipart uploads have a checksum of checksums, suffixed with the part count, which doesn't match the code SHA-256.
+	checksum := aws.ToString(output.ChecksumSHA256)
+	if checksum == "" || strings.Contains(checksum, "-") {
+		return fmt.Errorf("use_s3_object_checksum: S3 Object (s3://%s/%s) has no full-object SHA-256 checksum; upload it in a single part with the SHA256 checksum algorithm", bucket, key)
+	}
+
+	if old, _ := d.GetChange("source_code_hash"); old.(string) != checksum {
+		return d.SetNew("source_code_hash", checksum)
+	}
+
+	return nil
+}
+
+// checkCodeSHA256MatchesS3ObjectChecksum returns an error if the deployed code doesn't match the
+// S3 object checksum determined at plan time, i.e. the object was replaced between plan and apply.
+// If the checksum couldn't be determined at plan time, source_code_hash is set from the deployed code.
+func checkCodeSHA256MatchesS3ObjectChecksum(d *schema.ResourceData, codeSHA256 string) error {
+	if !d.Get("use_s3_object_checksum").(bool) {
+		return nil
+	}
+
+	switch v := d.Get("source_code_hash").(string); v {
+	case "":
+		d.Set("source_code_hash", codeSHA256)
+	case codeSHA256:
+	default:
+		return fmt.Errorf("deployed code SHA-256 (%s) does not match the planned S3 Object checksum (%s); the S3 Object changed after plan", codeSHA256, v)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
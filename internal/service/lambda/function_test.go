@@ -5,6 +5,7 @@ package lambda_test
 
 import (
 	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -16,6 +17,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go-v2/service/signer"
 	signertypes "github.com/aws/aws-sdk-go-v2/service/signer/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -76,6 +79,7 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "logging_config.0.system_log_level", ""),
 					resource.TestCheckResourceAttr(resourceName, "package_type", string(awstypes.PackageTypeZip)),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "qualified_arn", "lambda", fmt.Sprintf("function:%s:%s", funcName, tflambda.FunctionVersionLatest)),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(awstypes.RecursiveLoopTerminate)),
 					resource.TestCheckResourceAttr(resourceName, "reserved_concurrent_executions", "-1"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, tflambda.FunctionVersionLatest),
@@ -1250,6 +1254,80 @@ func TestAccLambdaFunction_loggingConfig(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_loggingConfigWithLogGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	logGroupResourceName := "aws_cloudwatch_log_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_loggingConfigWithLogGroup(rName, "Text", "INFO"),
+				ExpectError: regexache.MustCompile(`logging_config.0.application_log_level cannot be set when log_format is Text`),
+			},
+			{
+				Config: testAccFunctionConfig_loggingConfigWithLogGroup(rName, "JSON", "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.application_log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.log_format", "JSON"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.log_group", logGroupResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.system_log_level", "WARN"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_recursiveLoop(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_recursiveLoop(rName, string(awstypes.RecursiveLoopAllow)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(awstypes.RecursiveLoopAllow)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+			{
+				Config: testAccFunctionConfig_recursiveLoop(rName, string(awstypes.RecursiveLoopTerminate)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(awstypes.RecursiveLoopTerminate)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_tracing(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2042,6 +2120,74 @@ func TestAccLambdaFunction_S3Update_unversioned(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_S3Update_useS3ObjectChecksum(t *testing.T) {
+	ctx := acctest.Context(t)
+	path, zipFile, err := createTempFile("lambda_s3Update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	objectResourceName := "aws_s3_object.o"
+	key := "lambda-func.zip"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3UseS3ObjectChecksum(rName, key, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceCodeHash(&conf, "MbW0T1Pcy1QPtrFC9dT7hUfircj1NXss2uXgakqzAbk="),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", objectResourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "use_s3_object_checksum", acctest.CtTrue),
+				),
+			},
+			{
+				// Replace the object outside of Terraform, as a separate build pipeline would.
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func_modified.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+
+					body, err := os.ReadFile(path)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+					_, err = conn.PutObject(ctx, &s3.PutObjectInput{
+						Body:              bytes.NewReader(body),
+						Bucket:            aws.String(rName),
+						ChecksumAlgorithm: s3types.ChecksumAlgorithmSha256,
+						Key:               aws.String(key),
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFunctionConfig_s3UseS3ObjectChecksum(rName, key, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceCodeHash(&conf, "7qn3LZOWCpWK5nm49qjw+VrbPQHfdu2ZrDjBsSUveKM="),
+					resource.TestCheckResourceAttr(resourceName, "source_code_hash", "7qn3LZOWCpWK5nm49qjw+VrbPQHfdu2ZrDjBsSUveKM="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_snapStart(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf lambda.GetFunctionOutput
@@ -2079,6 +2225,41 @@ func TestAccLambdaFunction_snapStart(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_snapStartPython(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	var alias lambda.GetAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	aliasResourceName := "aws_lambda_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_snapStartPython(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckAliasExists(ctx, aliasResourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", "PublishedVersions"),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.optimization_status", "On"),
+					resource.TestCheckResourceAttrPair(aliasResourceName, "function_version", resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_runtimes(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2569,6 +2750,31 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_snapStartPython(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambda_python.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda_function.lambda_handler"
+  runtime       = "python3.12"
+  publish       = true
+
+  snap_start {
+    apply_on = "PublishedVersions"
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+}
+`, rName))
+}
+
 func testAccFunctionConfig_snapStartDisabled(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -3384,6 +3590,47 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_loggingConfigWithLogGroup(rName, logFormat, applicationLogLevel string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name              = "/test/lambda/%[1]s"
+  retention_in_days = 7
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+
+  logging_config {
+    application_log_level = %[3]q
+    log_format            = %[2]q
+    log_group             = aws_cloudwatch_log_group.test.name
+    system_log_level      = "WARN"
+  }
+}
+`, rName, logFormat, applicationLogLevel))
+}
+
+func testAccFunctionConfig_recursiveLoop(rName, recursiveLoop string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename       = "test-fixtures/lambdatest.zip"
+  function_name  = %[1]q
+  role           = aws_iam_role.iam_for_lambda.arn
+  handler        = "exports.example"
+  runtime        = "nodejs16.x"
+  recursive_loop = %[2]q
+}
+`, rName, recursiveLoop))
+}
+
 func testAccFunctionConfig_tracing(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -3856,6 +4103,38 @@ resource "aws_lambda_function" "test" {
 `, key, path, rName)
 }
 
+func testAccFunctionConfig_s3UseS3ObjectChecksum(rName, key, path string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "artifacts" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "o" {
+  bucket             = aws_s3_bucket.artifacts.bucket
+  key                = %[2]q
+  source             = %[3]q
+  checksum_algorithm = "SHA256"
+
+  lifecycle {
+    ignore_changes = [etag, source]
+  }
+}
+
+resource "aws_lambda_function" "test" {
+  s3_bucket              = aws_s3_object.o.bucket
+  s3_key                 = aws_s3_object.o.key
+  use_s3_object_checksum = true
+  function_name          = %[1]q
+  role                   = aws_iam_role.iam_for_lambda.arn
+  handler                = "exports.example"
+  runtime                = "nodejs16.x"
+}
+`, rName, key, path))
+}
+
 func testAccFunctionConfig_s3UnversionedTPL(rName, key, path string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "artifacts" {
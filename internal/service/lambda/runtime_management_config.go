@@ -75,6 +75,27 @@ func (r *resourceRuntimeManagementConfig) Schema(ctx context.Context, req resour
 	}
 }
 
+func (r *resourceRuntimeManagementConfig) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resourceRuntimeManagementConfigData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UpdateRuntimeOn.IsUnknown() || data.RuntimeVersionARN.IsUnknown() {
+		return
+	}
+
+	// A runtime version can only be pinned, and must be pinned, in Manual mode.
+	if manual := data.UpdateRuntimeOn.ValueEnum() == awstypes.UpdateRuntimeOnManual; manual == data.RuntimeVersionARN.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("runtime_version_arn"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("runtime_version_arn must be set if and only if update_runtime_on is %q.", awstypes.UpdateRuntimeOnManual),
+		)
+	}
+}
+
 func (r *resourceRuntimeManagementConfig) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	conn := r.Meta().LambdaClient(ctx)
 
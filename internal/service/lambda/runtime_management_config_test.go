@@ -131,6 +131,31 @@ func TestAccLambdaRuntimeManagementConfig_runtimeVersionARN(t *testing.T) {
 	})
 }
 
+func TestAccLambdaRuntimeManagementConfig_runtimeVersionARNValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuntimeManagementConfigConfig_updateRuntimeOn(rName, string(types.UpdateRuntimeOnManual)),
+				ExpectError: regexache.MustCompile(`runtime_version_arn must be set if and only if update_runtime_on is "Manual"`),
+			},
+			{
+				Config:      testAccRuntimeManagementConfigConfig_runtimeVersionARNUpdateRuntimeOn(rName, string(types.UpdateRuntimeOnFunctionUpdate)),
+				ExpectError: regexache.MustCompile(`runtime_version_arn must be set if and only if update_runtime_on is "Manual"`),
+			},
+		},
+	})
+}
+
 func testAccCheckRuntimeManagementConfigDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -233,3 +258,26 @@ resource "aws_lambda_runtime_management_config" "test" {
 }
 `, runtimeVersion))
 }
+
+func testAccRuntimeManagementConfigConfig_updateRuntimeOn(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(
+		testAccRuntimeManagementConfigConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = %[1]q
+}
+`, updateRuntimeOn))
+}
+
+func testAccRuntimeManagementConfigConfig_runtimeVersionARNUpdateRuntimeOn(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(
+		testAccRuntimeManagementConfigConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name       = aws_lambda_function.test.function_name
+  update_runtime_on   = %[1]q
+  runtime_version_arn = "arn:aws:lambda:us-west-2::runtime:b475b23763329123d9e6f79f51886d0e1054f727f5b90ec945fcb2a3ec09afdd"
+}
+`, updateRuntimeOn))
+}
@@ -106,6 +106,11 @@ func resourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"multi_region_cluster_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			names.AttrName: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -309,6 +314,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.MaintenanceWindow = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("multi_region_cluster_name"); ok {
+		input.MultiRegionClusterName = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrParameterGroupName); ok {
 		input.ParameterGroupName = aws.String(v.(string))
 	}
@@ -349,7 +358,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Creating MemoryDB Cluster: %+v", input)
-	_, err := conn.CreateCluster(ctx, input)
+	output, err := conn.CreateCluster(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating MemoryDB Cluster (%s): %s", name, err)
@@ -359,6 +368,12 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to be created: %s", name, err)
 	}
 
+	if v, ok := d.GetOk("multi_region_cluster_name"); ok {
+		if err := waitMultiRegionClusterMemberJoined(ctx, conn, v.(string), aws.ToString(output.Cluster.ARN), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to join Multi Region Cluster (%s): %s", name, v.(string), err)
+		}
+	}
+
 	d.SetId(name)
 
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
@@ -516,6 +531,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrEngineVersion, cluster.EngineVersion)
 	d.Set(names.AttrKMSKeyARN, cluster.KmsKeyId) // KmsKeyId is actually an ARN here.
 	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("multi_region_cluster_name", cluster.MultiRegionClusterName)
 	d.Set(names.AttrName, cluster.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(cluster.Name)))
 	d.Set("node_type", cluster.NodeType)
@@ -582,6 +598,13 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to be deleted: %s", d.Id(), err)
 	}
 
+	// The multi-Region cluster cannot be deleted until all of its members have left.
+	if v, ok := d.GetOk("multi_region_cluster_name"); ok {
+		if err := waitMultiRegionClusterMemberLeft(ctx, conn, v.(string), d.Get(names.AttrARN).(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to leave Multi Region Cluster (%s): %s", d.Id(), v.(string), err)
+		}
+	}
+
 	return diags
 }
 
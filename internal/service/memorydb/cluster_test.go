@@ -1060,6 +1060,35 @@ func TestAccMemoryDBCluster_Update_tags(t *testing.T) {
 	})
 }
 
+func TestAccMemoryDBCluster_multiRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+	multiRegionClusterResourceName := "aws_memorydb_multi_region_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MemoryDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_multiRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "multi_region_cluster_name", multiRegionClusterResourceName, "multi_region_cluster_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "node_type", multiRegionClusterResourceName, "node_type"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBClient(ctx)
@@ -1537,6 +1566,29 @@ resource "aws_memorydb_cluster" "test2" {
 	)
 }
 
+func testAccClusterConfig_multiRegion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseNetwork(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_multi_region_cluster" "test" {
+  multi_region_cluster_name_suffix = %[1]q
+  engine                           = "valkey"
+  node_type                        = "db.r7g.xlarge"
+}
+
+resource "aws_memorydb_cluster" "test" {
+  acl_name                  = "open-access"
+  multi_region_cluster_name = aws_memorydb_multi_region_cluster.test.multi_region_cluster_name
+  name                      = %[1]q
+  node_type                 = aws_memorydb_multi_region_cluster.test.node_type
+  num_replicas_per_shard    = 0
+  num_shards                = 1
+  subnet_group_name         = aws_memorydb_subnet_group.test.id
+}
+`, rName),
+	)
+}
+
 func testAccClusterConfig_snapshotWindow(rName, snapshotWindow string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseNetwork(rName),
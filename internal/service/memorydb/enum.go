@@ -94,6 +94,22 @@ func ClusterSNSTopicStatus_Values() []string {
 	}
 }
 
+const (
+	MultiRegionClusterStatusAvailable = "available"
+	MultiRegionClusterStatusCreating  = "creating"
+	MultiRegionClusterStatusDeleting  = "deleting"
+	MultiRegionClusterStatusUpdating  = "updating"
+)
+
+func MultiRegionClusterStatus_Values() []string {
+	return []string{
+		MultiRegionClusterStatusAvailable,
+		MultiRegionClusterStatusCreating,
+		MultiRegionClusterStatusDeleting,
+		MultiRegionClusterStatusUpdating,
+	}
+}
+
 const (
 	SnapshotStatusAvailable = "available"
 	SnapshotStatusCopying   = "copying"
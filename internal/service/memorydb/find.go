@@ -57,6 +57,28 @@ func FindClusterByName(ctx context.Context, conn *memorydb.Client, name string)
 	return tfresource.AssertSingleValueResult(output.Clusters)
 }
 
+func FindMultiRegionClusterByName(ctx context.Context, conn *memorydb.Client, name string) (*awstypes.MultiRegionCluster, error) {
+	input := memorydb.DescribeMultiRegionClustersInput{
+		MultiRegionClusterName: aws.String(name),
+		ShowClusterDetails:     aws.Bool(true),
+	}
+
+	output, err := conn.DescribeMultiRegionClusters(ctx, &input)
+
+	if errs.IsA[*awstypes.MultiRegionClusterNotFoundFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output.MultiRegionClusters)
+}
+
 func FindParameterGroupByName(ctx context.Context, conn *memorydb.Client, name string) (*awstypes.ParameterGroup, error) {
 	input := memorydb.DescribeParameterGroupsInput{
 		ParameterGroupName: aws.String(name),
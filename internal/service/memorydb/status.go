@@ -89,6 +89,47 @@ func statusClusterSecurityGroups(ctx context.Context, conn *memorydb.Client, clu
 	}
 }
 
+// statusMultiRegionCluster fetches the MemoryDB multi-Region cluster and its status.
+func statusMultiRegionCluster(ctx context.Context, conn *memorydb.Client, multiRegionClusterName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		multiRegionCluster, err := FindMultiRegionClusterByName(ctx, conn, multiRegionClusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return multiRegionCluster, aws.ToString(multiRegionCluster.Status), nil
+	}
+}
+
+// statusMultiRegionClusterMember fetches the status of a MemoryDB Cluster as
+// seen by the multi-Region cluster it belongs to.
+func statusMultiRegionClusterMember(ctx context.Context, conn *memorydb.Client, multiRegionClusterName, clusterARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		multiRegionCluster, err := FindMultiRegionClusterByName(ctx, conn, multiRegionClusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range multiRegionCluster.Clusters {
+			if aws.ToString(v.ARN) == clusterARN {
+				return v, aws.ToString(v.Status), nil
+			}
+		}
+
+		return nil, "", nil
+	}
+}
+
 // statusSnapshot fetches the MemoryDB Snapshot and its status.
 func statusSnapshot(ctx context.Context, conn *memorydb.Client, snapshotName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -115,6 +115,66 @@ func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.Client,
 	return err
 }
 
+// waitMultiRegionClusterAvailable waits for MemoryDB multi-Region cluster to reach an active state after modifications.
+func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.Client, multiRegionClusterName string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{MultiRegionClusterStatusCreating, MultiRegionClusterStatusUpdating},
+		Target:  []string{MultiRegionClusterStatusAvailable},
+		Refresh: statusMultiRegionCluster(ctx, conn, multiRegionClusterName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitMultiRegionClusterDeleted waits for MemoryDB multi-Region cluster to be deleted.
+func waitMultiRegionClusterDeleted(ctx context.Context, conn *memorydb.Client, multiRegionClusterName string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{MultiRegionClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusMultiRegionCluster(ctx, conn, multiRegionClusterName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitMultiRegionClusterMemberJoined waits for MemoryDB Cluster to become an
+// available member of a multi-Region cluster.
+func waitMultiRegionClusterMemberJoined(ctx context.Context, conn *memorydb.Client, multiRegionClusterName, clusterARN string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{ClusterStatusCreating, ClusterStatusUpdating},
+		Target:                    []string{ClusterStatusAvailable},
+		Refresh:                   statusMultiRegionClusterMember(ctx, conn, multiRegionClusterName, clusterARN),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitMultiRegionClusterMemberLeft waits for MemoryDB Cluster to no longer be
+// a member of a multi-Region cluster.
+func waitMultiRegionClusterMemberLeft(ctx context.Context, conn *memorydb.Client, multiRegionClusterName, clusterARN string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusAvailable, ClusterStatusDeleting, ClusterStatusUpdating},
+		Target:  []string{},
+		Refresh: statusMultiRegionClusterMember(ctx, conn, multiRegionClusterName, clusterARN),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 // waitUserActive waits for MemoryDB user to reach an active state after modifications.
 func waitUserActive(ctx context.Context, conn *memorydb.Client, userId string) error {
 	stateConf := &retry.StateChangeConf{
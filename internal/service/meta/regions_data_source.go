@@ -5,9 +5,11 @@ package meta
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -49,6 +51,14 @@ func (d *dataSourceRegions) Schema(ctx context.Context, req datasource.SchemaReq
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"service_availability": schema.MapAttribute{
+				ElementType: types.MapType{ElemType: types.BoolType},
+				Computed:    true,
+			},
+			"services": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			names.AttrFilter: tfec2.CustomFiltersBlock(),
@@ -90,12 +100,75 @@ func (d *dataSourceRegions) Read(ctx context.Context, request datasource.ReadReq
 	data.ID = types.StringValue(d.Meta().Partition)
 	data.Names = flex.FlattenFrameworkStringValueSetLegacy(ctx, names)
 
+	data.ServiceAvailability = types.MapNull(types.MapType{ElemType: types.BoolType})
+
+	if services := flex.ExpandFrameworkStringValueSet(ctx, data.Services); len(services) > 0 {
+		conn := d.Meta().SSMClient(ctx)
+
+		availability := make(map[string]map[string]bool, len(names))
+		for _, region := range names {
+			availability[region] = make(map[string]bool, len(services))
+		}
+
+		for _, service := range services {
+			regions, err := findServiceRegions(ctx, conn, service)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading Regions for service (%s)", service), err.Error())
+
+				return
+			}
+
+			for _, region := range names {
+				availability[region][service] = regions[region]
+			}
+		}
+
+		serviceAvailability, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.BoolType}, availability)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.ServiceAvailability = serviceAvailability
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceRegionsData struct {
-	AllRegions types.Bool   `tfsdk:"all_regions"`
-	Filters    types.Set    `tfsdk:"filter"`
-	ID         types.String `tfsdk:"id"`
-	Names      types.Set    `tfsdk:"names"`
+	AllRegions          types.Bool   `tfsdk:"all_regions"`
+	Filters             types.Set    `tfsdk:"filter"`
+	ID                  types.String `tfsdk:"id"`
+	Names               types.Set    `tfsdk:"names"`
+	ServiceAvailability types.Map    `tfsdk:"service_availability"`
+	Services            types.Set    `tfsdk:"services"`
+}
+
+// findServiceRegions returns the Regions in which the specified service is available,
+// as published in the AWS global infrastructure public SSM parameters.
+func findServiceRegions(ctx context.Context, conn *ssm.Client, service string) (map[string]bool, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path: aws.String(fmt.Sprintf("/aws/service/global-infrastructure/services/%s/regions", service)),
+	}
+	output := make(map[string]bool)
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Parameters {
+			output[aws.ToString(v.Value)] = true
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, fmt.Errorf("service (%s) not found in AWS global infrastructure parameters", service)
+	}
+
+	return output, nil
 }
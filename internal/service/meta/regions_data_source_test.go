@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
@@ -88,6 +89,42 @@ func TestAccMetaRegionsDataSource_nonExistentRegion(t *testing.T) {
 	})
 }
 
+func TestAccMetaRegionsDataSource_services(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_regions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig_services("ec2"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "service_availability.%", 0),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("service_availability.%s.ec2", acctest.Region()), acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaRegionsDataSource_servicesNonExistent(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegionsDataSourceConfig_services("not-a-service"),
+				ExpectError: regexache.MustCompile(`service \(not-a-service\) not found`),
+			},
+		},
+	})
+}
+
 func testAccRegionsDataSourceConfig_empty() string {
 	return `
 data "aws_regions" "test" {}
@@ -123,3 +160,11 @@ data "aws_regions" "test" {
 }
 `
 }
+
+func testAccRegionsDataSourceConfig_services(service string) string {
+	return fmt.Sprintf(`
+data "aws_regions" "test" {
+  services = [%[1]q]
+}
+`, service)
+}
@@ -16,6 +16,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/neptune/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -245,6 +246,11 @@ func resourceCluster() *schema.Resource {
 			"replication_source_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Secondary members of a global cluster report the current primary as their replication source,
+					// which changes on global cluster failover.
+					return new == "" && d.Get("global_cluster_identifier").(string) != ""
+				},
 			},
 			"serverless_v2_scaling_configuration": {
 				Type:     schema.TypeList,
@@ -315,7 +321,10 @@ func resourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			clusterServerlessV2ScalingConfigurationCustomizeDiff,
+		),
 	}
 }
 
@@ -713,6 +722,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if err := removeClusterFromGlobalCluster(ctx, conn, d.Get(names.AttrARN).(string), o, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+
+		// Removing a secondary cluster promotes it to a standalone cluster with read/write capability.
+		if _, err := waitDBClusterAvailable(ctx, conn, d.Id(), false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Neptune Cluster (%s) promotion: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("iam_roles") {
@@ -793,6 +807,22 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func clusterServerlessV2ScalingConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	v, ok := diff.GetOk("serverless_v2_scaling_configuration")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	minCapacity, maxCapacity := tfMap["min_capacity"].(float64), tfMap[names.AttrMaxCapacity].(float64)
+
+	if minCapacity > maxCapacity {
+		return fmt.Errorf("serverless_v2_scaling_configuration min_capacity (%g) must be less than or equal to max_capacity (%g)", minCapacity, maxCapacity)
+	}
+
+	return nil
+}
+
 func addIAMRoleToCluster(ctx context.Context, conn *neptune.Client, clusterID, roleARN string) error {
 	_, err := conn.AddRoleToDBCluster(ctx, &neptune.AddRoleToDBClusterInput{
 		DBClusterIdentifier: aws.String(clusterID),
@@ -278,6 +278,24 @@ func TestAccNeptuneCluster_serverlessConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccNeptuneCluster_serverlessConfigurationInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_serverlessConfigurationCapacity(rName, 16, 8),
+				ExpectError: regexache.MustCompile(`min_capacity \(16\) must be less than or equal to max_capacity \(8\)`),
+			},
+		},
+	})
+}
+
 func TestAccNeptuneCluster_takeFinalSnapshot(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.DBCluster
@@ -583,6 +601,48 @@ func TestAccNeptuneCluster_GlobalClusterIdentifier_PrimarySecondaryClusters(t *t
 	})
 }
 
+func TestAccNeptuneCluster_GlobalClusterIdentifier_secondaryPromote(t *testing.T) {
+	ctx := acctest.Context(t)
+	var providers []*schema.Provider
+	var primaryDbCluster, secondaryDbCluster awstypes.DBCluster
+
+	rNameGlobal := sdkacctest.RandomWithPrefix("tf-acc-test-global")
+	rNamePrimary := sdkacctest.RandomWithPrefix("tf-acc-test-primary")
+	rNameSecondary := sdkacctest.RandomWithPrefix("tf-acc-test-secondary")
+
+	resourceNamePrimary := "aws_neptune_cluster.primary"
+	resourceNameSecondary := "aws_neptune_cluster.secondary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_globalIdentifierPrimarySecondaryMembership(rNameGlobal, rNamePrimary, rNameSecondary, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExistsWithProvider(ctx, resourceNamePrimary, &primaryDbCluster, acctest.RegionProviderFunc(acctest.Region(), &providers)),
+					testAccCheckClusterExistsWithProvider(ctx, resourceNameSecondary, &secondaryDbCluster, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					resource.TestCheckResourceAttrPair(resourceNameSecondary, "global_cluster_identifier", "aws_neptune_global_cluster.test", names.AttrID),
+				),
+			},
+			{
+				Config: testAccClusterConfig_globalIdentifierPrimarySecondaryMembership(rNameGlobal, rNamePrimary, rNameSecondary, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExistsWithProvider(ctx, resourceNameSecondary, &secondaryDbCluster, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					resource.TestCheckResourceAttr(resourceNameSecondary, "global_cluster_identifier", ""),
+					resource.TestCheckResourceAttr("aws_neptune_global_cluster.test", "global_cluster_members.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNeptuneCluster_deleteProtection(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster awstypes.DBCluster
@@ -884,6 +944,21 @@ resource "aws_neptune_cluster" "test" {
 `, rName)
 }
 
+func testAccClusterConfig_serverlessConfigurationCapacity(rName string, minCapacity, maxCapacity float64) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier_prefix = %[1]q
+  engine                    = "neptune"
+  skip_final_snapshot       = true
+
+  serverless_v2_scaling_configuration {
+    min_capacity = %[2]g
+    max_capacity = %[3]g
+  }
+}
+`, rName, minCapacity, maxCapacity)
+}
+
 func testAccClusterConfig_finalSnapshot(rName string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(), fmt.Sprintf(`
 resource "aws_neptune_cluster" "test" {
@@ -1338,6 +1413,10 @@ resource "aws_neptune_cluster" "test" {
 }
 
 func testAccClusterConfig_globalIdentifierPrimarySecondary(rNameGlobal, rNamePrimary, rNameSecondary string) string {
+	return testAccClusterConfig_globalIdentifierPrimarySecondaryMembership(rNameGlobal, rNamePrimary, rNameSecondary, true)
+}
+
+func testAccClusterConfig_globalIdentifierPrimarySecondaryMembership(rNameGlobal, rNamePrimary, rNameSecondary string, secondaryIsMember bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(2),
 		fmt.Sprintf(`
@@ -1403,15 +1482,11 @@ resource "aws_neptune_cluster" "secondary" {
   cluster_identifier        = %[3]q
   skip_final_snapshot       = true
   neptune_subnet_group_name = aws_neptune_subnet_group.alternate.name
-  global_cluster_identifier = aws_neptune_global_cluster.test.id
+  global_cluster_identifier = %[4]t ? aws_neptune_global_cluster.test.id : null
   engine                    = aws_neptune_global_cluster.test.engine
   engine_version            = aws_neptune_global_cluster.test.engine_version
 
   depends_on = [aws_neptune_cluster_instance.primary]
-
-  lifecycle {
-    ignore_changes = [replication_source_identifier]
-  }
 }
 
 resource "aws_neptune_cluster_instance" "secondary" {
@@ -1421,7 +1496,7 @@ resource "aws_neptune_cluster_instance" "secondary" {
   engine_version     = aws_neptune_global_cluster.test.engine_version
   instance_class     = "db.r6g.large"
 }
-`, rNameGlobal, rNamePrimary, rNameSecondary))
+`, rNameGlobal, rNamePrimary, rNameSecondary, secondaryIsMember))
 }
 
 func testAccClusterConfig_restoreFromSnapshot(rName string) string {
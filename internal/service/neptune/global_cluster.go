@@ -264,6 +264,8 @@ func resourceGlobalClusterDelete(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).NeptuneClient(ctx)
 
 	// Remove any members from the global cluster.
+	// The writer cluster must be removed last.
+	var writerARN string
 	for _, tfMapRaw := range d.Get("global_cluster_members").(*schema.Set).List() {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
 
@@ -271,10 +273,24 @@ func resourceGlobalClusterDelete(ctx context.Context, d *schema.ResourceData, me
 			continue
 		}
 
-		if clusterARN, ok := tfMap["db_cluster_arn"].(string); ok && clusterARN != "" {
-			if err := removeClusterFromGlobalCluster(ctx, conn, clusterARN, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
-			}
+		clusterARN, ok := tfMap["db_cluster_arn"].(string)
+		if !ok || clusterARN == "" {
+			continue
+		}
+
+		if tfMap["is_writer"].(bool) {
+			writerARN = clusterARN
+			continue
+		}
+
+		if err := removeClusterFromGlobalCluster(ctx, conn, clusterARN, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if writerARN != "" {
+		if err := removeClusterFromGlobalCluster(ctx, conn, writerARN, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
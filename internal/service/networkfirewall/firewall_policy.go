@@ -211,6 +211,10 @@ func resourceFirewallPolicy() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("firewall_policy.0.stateful_engine_options.0.rule_order", d)
 			},
+			// A TLS inspection configuration can only be associated with a new policy and can't be removed.
+			customdiff.ForceNewIfChange("firewall_policy.0.tls_inspection_configuration_arn", func(_ context.Context, old, new, meta interface{}) bool {
+				return (old.(string) == "") != (new.(string) == "")
+			}),
 			verify.SetTagsDiff,
 		),
 	}
@@ -540,8 +540,12 @@ func putAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.Cl
 		PolicyDocument: aws.String(document),
 	})
 
+	if v, ok := errs.As[*awstypes.CoreNetworkPolicyException](err); ok && len(v.Errors) > 0 {
+		err = errors.Join(err, coreNetworkPolicyErrors(v.Errors))
+	}
+
 	if err != nil {
-		return fmt.Errorf("putting Network Manager Core Network (%s) policy: %s", coreNetworkId, err)
+		return fmt.Errorf("putting Network Manager Core Network (%s) policy: %w", coreNetworkId, err)
 	}
 
 	policyVersionID := output.CoreNetworkPolicy.PolicyVersionId
@@ -588,24 +592,28 @@ func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Clie
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.CoreNetworkPolicy); ok {
+		if state, v := output.ChangeSetState, output.PolicyErrors; state == awstypes.ChangeSetStateFailedGeneration && len(v) > 0 {
+			tfresource.SetLastError(err, coreNetworkPolicyErrors(v))
+		}
+
 		return output, err
 	}
 
-	if output, ok := outputRaw.(*awstypes.CoreNetworkPolicy); ok {
-		if state, v := output.ChangeSetState, output.PolicyErrors; state == awstypes.ChangeSetStateFailedGeneration && len(v) > 0 {
-			var errs []error
+	return nil, err
+}
 
-			for _, err := range v {
-				errs = append(errs, fmt.Errorf("%s: %s", aws.ToString(err.ErrorCode), aws.ToString(err.Message)))
-			}
+func coreNetworkPolicyErrors(apiObjects []awstypes.CoreNetworkPolicyError) error {
+	var errs []error
 
-			tfresource.SetLastError(err, errors.Join(errs...))
+	for _, apiObject := range apiObjects {
+		if path := aws.ToString(apiObject.Path); path != "" {
+			errs = append(errs, fmt.Errorf("%s (%s): %s", aws.ToString(apiObject.ErrorCode), path, aws.ToString(apiObject.Message)))
+		} else {
+			errs = append(errs, fmt.Errorf("%s: %s", aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.Message)))
 		}
-
-		return output, err
 	}
 
-	return nil, err
+	return errors.Join(errs...)
 }
 
 // buildCoreNetworkBasePolicyDocument returns a base policy document
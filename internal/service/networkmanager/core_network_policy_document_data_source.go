@@ -6,7 +6,9 @@ package networkmanager
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/YakDriver/regexache"
@@ -433,6 +435,10 @@ func dataSourceCoreNetworkPolicyDocumentRead(ctx context.Context, d *schema.Reso
 	}
 	mergedDoc.AttachmentPolicies = attachmentPolicies
 
+	if err := validateCoreNetworkPolicyDocument(mergedDoc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "invalid Core Network Policy Document: %s", err)
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -865,3 +871,137 @@ func expandCoreNetworkPolicyNetworkFunctionGroups(tfList []interface{}) ([]*core
 
 	return apiObjects, nil
 }
+
+// validateCoreNetworkPolicyDocument checks the cross-references within an assembled policy document
+// that cannot be expressed in the schema, so that errors are reported at plan time rather than when
+// the policy is put. Errors are prefixed with the path of the offending element in the JSON document.
+func validateCoreNetworkPolicyDocument(apiObject *coreNetworkPolicyDocument) error {
+	var errs []error
+
+	edgeLocations := make(map[string]struct{})
+	if v := apiObject.CoreNetworkConfiguration; v != nil {
+		for _, v := range v.EdgeLocations {
+			edgeLocations[v.Location] = struct{}{}
+		}
+	}
+
+	segments := make(map[string]struct{})
+	for _, v := range apiObject.Segments {
+		segments[v.Name] = struct{}{}
+	}
+
+	networkFunctionGroups := make(map[string]struct{})
+	for _, v := range apiObject.NetworkFunctionGroups {
+		networkFunctionGroups[v.Name] = struct{}{}
+	}
+
+	checkSegments := func(path string, v interface{}) {
+		for _, name := range coreNetworkPolicyStringList(v) {
+			if _, ok := segments[name]; !ok && name != "*" {
+				errs = append(errs, fmt.Errorf("%s: segment %q is not defined", path, name))
+			}
+		}
+	}
+
+	for i, v := range apiObject.Segments {
+		for _, location := range coreNetworkPolicyStringList(v.EdgeLocations) {
+			if _, ok := edgeLocations[location]; !ok {
+				errs = append(errs, fmt.Errorf("segments[%d].edge-locations: edge location %q is not defined in core-network-configuration", i, location))
+			}
+		}
+		checkSegments(fmt.Sprintf("segments[%d].allow-filter", i), v.AllowFilter)
+		checkSegments(fmt.Sprintf("segments[%d].deny-filter", i), v.DenyFilter)
+	}
+
+	for i, v := range apiObject.SegmentActions {
+		path := fmt.Sprintf("segment-actions[%d]", i)
+
+		checkSegments(path+".segment", v.Segment)
+		checkSegments(path+".share-with", v.ShareWith)
+		checkSegments(path+".share-with.except", v.ShareWithExcept)
+		if v.WhenSentTo != nil {
+			checkSegments(path+".when-sent-to.segments", v.WhenSentTo.Segments)
+		}
+
+		switch v.Action {
+		case "share":
+			if v.Mode != "" && v.Mode != "attachment-route" {
+				errs = append(errs, fmt.Errorf("%s.mode: must be \"attachment-route\" if action = %q, got %q", path, v.Action, v.Mode))
+			}
+
+		case "send-via", "send-to":
+			if v.Action == "send-via" && v.Mode != "" && !slices.Contains([]string{"single-hop", "dual-hop"}, v.Mode) {
+				errs = append(errs, fmt.Errorf("%s.mode: must be \"single-hop\" or \"dual-hop\" if action = %q, got %q", path, v.Action, v.Mode))
+			}
+
+			if v.Via == nil || len(coreNetworkPolicyStringList(v.Via.NetworkFunctionGroups)) == 0 {
+				errs = append(errs, fmt.Errorf("%s.via.network-function-groups: required if action = %q", path, v.Action))
+				continue
+			}
+
+			for _, name := range coreNetworkPolicyStringList(v.Via.NetworkFunctionGroups) {
+				if _, ok := networkFunctionGroups[name]; !ok {
+					errs = append(errs, fmt.Errorf("%s.via.network-function-groups: network function group %q is not defined", path, name))
+				}
+			}
+		}
+	}
+
+	for i, v := range apiObject.AttachmentPolicies {
+		path := fmt.Sprintf("attachment-policies[%d]", i)
+
+		for j, v := range v.Conditions {
+			if v.Type == "attachment-type" && !slices.Contains(coreNetworkPolicyAttachmentTypes(), v.Value) {
+				errs = append(errs, fmt.Errorf("%s.conditions[%d].value: must be one of %q if type = \"attachment-type\", got %q", path, j, coreNetworkPolicyAttachmentTypes(), v.Value))
+			}
+		}
+
+		if v := v.Action; v != nil {
+			checkSegments(path+".action.segment", v.Segment)
+
+			if name := v.AddToNetworkFunctionGroup; name != "" {
+				if _, ok := networkFunctionGroups[name]; !ok {
+					errs = append(errs, fmt.Errorf("%s.action.add-to-network-function-group: network function group %q is not defined", path, name))
+				}
+			}
+
+			switch v.AssociationMethod {
+			case "constant":
+				if v.Segment == "" && v.AddToNetworkFunctionGroup == "" {
+					errs = append(errs, fmt.Errorf("%s.action: one of segment or add-to-network-function-group is required if association-method = \"constant\"", path))
+				}
+
+			case "tag":
+				if v.TagValueOfKey == "" {
+					errs = append(errs, fmt.Errorf("%s.action.tag-value-of-key: required if association-method = \"tag\"", path))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func coreNetworkPolicyAttachmentTypes() []string {
+	return []string{
+		"connect",
+		"direct-connect-gateway",
+		"transit-gateway-route-table",
+		"vpc",
+		"vpn",
+	}
+}
+
+func coreNetworkPolicyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	default:
+		return nil
+	}
+}
@@ -6,6 +6,7 @@ package networkmanager_test
 import (
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -96,6 +97,72 @@ func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_viaCompat(t *testi
 	})
 }
 
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_invalidReferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_invalidReferences,
+				ExpectError: regexache.MustCompile(`"FirewallVPC" is not defined`),
+			},
+		},
+	})
+}
+
+// lintignore:AWSAT003
+const testAccCoreNetworkPolicyDocumentDataSourceConfig_invalidReferences = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["65022-65534"]
+
+    edge_locations {
+      location = "us-east-2"
+    }
+  }
+
+  segments {
+    name           = "development"
+    edge_locations = ["eu-west-1"]
+  }
+
+  segment_actions {
+    action  = "send-via"
+    segment = "development"
+    mode    = "single-hop"
+
+    when_sent_to {
+      segments = ["staging"]
+    }
+
+    via {
+      network_function_groups = ["FirewallVPC"]
+    }
+  }
+
+  attachment_policies {
+    rule_number = 100
+
+    conditions {
+      type     = "attachment-type"
+      operator = "equals"
+      value    = "peering"
+    }
+
+    action {
+      add_to_network_function_group = "FirewallVPC"
+    }
+  }
+
+  network_function_groups {
+    name                          = "InspectionVPC"
+    require_attachment_acceptance = true
+  }
+}
+`
+
 // lintignore:AWSAT003
 const testAccCoreNetworkPolicyDocumentDataSourceConfig_basic = `
 data "aws_networkmanager_core_network_policy_document" "test" {
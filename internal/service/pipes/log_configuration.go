@@ -173,6 +173,11 @@ func expandS3LogDestinationParameters(tfMap map[string]interface{}) *types.S3Log
 	return apiObject
 }
 
+// isPipeLogConfigurationDisabled returns whether logging has been turned off without any destinations.
+func isPipeLogConfigurationDisabled(apiObject *types.PipeLogConfiguration) bool {
+	return apiObject.Level == types.LogLevelOff && apiObject.CloudwatchLogsLogDestination == nil && apiObject.FirehoseLogDestination == nil && apiObject.S3LogDestination == nil
+}
+
 func flattenPipeLogConfiguration(apiObject *types.PipeLogConfiguration) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -209,7 +209,7 @@ func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	} else {
 		d.Set("enrichment_parameters", nil)
 	}
-	if v := output.LogConfiguration; !types.IsZero(v) {
+	if v := output.LogConfiguration; !types.IsZero(v) && !isPipeLogConfigurationDisabled(v) {
 		if err := d.Set("log_configuration", []interface{}{flattenPipeLogConfiguration(v)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting log_configuration: %s", err)
 		}
@@ -265,6 +265,11 @@ func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		if d.HasChange("log_configuration") {
 			if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.LogConfiguration = expandPipeLogConfigurationParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// Logging is turned off by setting the level to OFF.
+				input.LogConfiguration = &awstypes.PipeLogConfigurationParameters{
+					Level: awstypes.LogLevelOff,
+				}
 			}
 		}
 
@@ -395,6 +395,50 @@ func TestAccPipesPipe_update_logConfiguration_cloudwatchLogsLogDestination(t *te
 	})
 }
 
+func TestAccPipesPipe_logConfiguration_s3LogDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pipe pipes.DescribePipeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PipesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PipesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_logConfiguration_s3LogDestination(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipeExists(ctx, resourceName, &pipe),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.level", "TRACE"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.s3_log_destination.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "log_configuration.0.s3_log_destination.0.bucket_name", "aws_s3_bucket.logs", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.s3_log_destination.0.output_format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.s3_log_destination.0.prefix", "pipes/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipeConfig_basicSQS(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipeExists(ctx, resourceName, &pipe),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPipesPipe_logConfiguration_includeExecutionData(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pipe pipes.DescribePipeOutput
@@ -2112,6 +2156,39 @@ resource "aws_cloudwatch_log_group" "target" {
 `, rName))
 }
 
+func testAccPipeConfig_logConfiguration_s3LogDestination(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipeConfig_base(rName),
+		testAccPipeConfig_baseSQSSource(rName),
+		testAccPipeConfig_baseSQSTarget(rName),
+		fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  log_configuration {
+    level = "TRACE"
+
+    s3_log_destination {
+      bucket_name   = aws_s3_bucket.logs.bucket
+      bucket_owner  = data.aws_caller_identity.main.account_id
+      output_format = "json"
+      prefix        = "pipes/"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket        = "%[1]s-logs"
+  force_destroy = true
+}
+`, rName))
+}
+
 func testAccPipeConfig_logConfiguration_includeExecutionData(rName, includeExecutionData string) string {
 	return acctest.ConfigCompose(
 		testAccPipeConfig_base(rName),
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -162,3 +164,167 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds_sdkv2.Client
+}
+
+func newClusterHandler(conn *rds_sdkv2.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) precondition(ctx context.Context, d *schema.ResourceData) error {
+	// Deletion protection on the Blue environment would prevent its clean up after switchover.
+	if !d.HasChange(names.AttrDeletionProtection) {
+		return nil
+	}
+
+	input := &rds_sdkv2.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(true),
+		DBClusterIdentifier: aws.String(d.Id()),
+		DeletionProtection:  aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
+	}
+
+	if _, err := h.conn.ModifyDBCluster(ctx, input); err != nil {
+		return fmt.Errorf("setting pre-conditions: %s", err)
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, h.conn, d.Id(), true, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("setting pre-conditions: waiting for completion: %s", err)
+	}
+
+	return nil
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds_sdkv2.CreateBlueGreenDeploymentInput {
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if v, ok := d.GetOk("db_instance_parameter_group_name"); ok && d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// deleteSource removes the Blue environment left behind after switchover.
+// The cluster's member instances must be deleted before the cluster itself.
+func (h *clusterHandler) deleteSource(ctx context.Context, sourceARN string, timeout time.Duration) error {
+	source, err := findDBClusterByID(ctx, h.conn, sourceARN)
+	if err != nil {
+		return err
+	}
+
+	sourceID := aws.ToString(source.DBClusterIdentifier)
+
+	for _, member := range source.DBClusterMembers {
+		instanceID := aws.ToString(member.DBInstanceIdentifier)
+
+		_, err := h.conn.DeleteDBInstance(ctx, &rds_sdkv2.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+		})
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			continue
+		}
+
+		if err != nil && !errs.IsAErrorMessageContains[*types.InvalidDBInstanceStateFault](err, "is already being deleted") {
+			return fmt.Errorf("deleting RDS Cluster Instance (%s): %s", instanceID, err)
+		}
+	}
+
+	for _, member := range source.DBClusterMembers {
+		instanceID := aws.ToString(member.DBInstanceIdentifier)
+
+		if _, err := waitDBClusterInstanceDeleted(ctx, h.conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("waiting for RDS Cluster Instance (%s) delete: %s", instanceID, err)
+		}
+	}
+
+	if aws.ToBool(source.DeletionProtection) {
+		input := &rds_sdkv2.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(sourceID),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if _, err := h.conn.ModifyDBCluster(ctx, input); err != nil {
+			return fmt.Errorf("disabling deletion protection: %s", err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, h.conn, sourceID, false, timeout); err != nil {
+			return fmt.Errorf("disabling deletion protection: waiting for completion: %s", err)
+		}
+	}
+
+	_, err = tfresource.RetryWhenIsA[*types.InvalidDBClusterStateFault](ctx, timeout,
+		func() (interface{}, error) {
+			return h.conn.DeleteDBCluster(ctx, &rds_sdkv2.DeleteDBClusterInput{
+				DBClusterIdentifier: aws.String(sourceID),
+				SkipFinalSnapshot:   aws.Bool(true),
+			})
+		},
+	)
+
+	if errs.IsA[*types.DBClusterNotFoundFault](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): %s", sourceID, err)
+	}
+
+	if _, err := waitDBClusterDeleted(ctx, h.conn, sourceID, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) delete: %s", sourceID, err)
+	}
+
+	return nil
+}
+
+func setBlueGreenUpdateSwitchoverTime(d *schema.ResourceData, t time.Time) error {
+	return d.Set("blue_green_update", []interface{}{
+		map[string]interface{}{
+			names.AttrEnabled: d.Get("blue_green_update.0.enabled").(bool),
+			"switchover_time": t.Format(time.RFC3339),
+		},
+	})
+}
+
+// isMajorEngineVersionChange reports whether an engine version change crosses a major version boundary.
+// An empty version (e.g. a not-yet-known value) is never considered a major version change.
+func isMajorEngineVersionChange(engine, oldVersion, newVersion string) bool {
+	if oldVersion == "" || newVersion == "" {
+		return false
+	}
+
+	return engineMajorVersion(engine, oldVersion) != engineMajorVersion(engine, newVersion)
+}
+
+// engineMajorVersion returns the major version prefix of an engine version.
+// PostgreSQL 10 and later use a single-part major version (e.g. "16.3" => "16"),
+// earlier PostgreSQL versions and the MySQL family use two parts (e.g. "8.0.35" => "8.0").
+func engineMajorVersion(engine, version string) string {
+	parts := strings.Split(version, ".")
+
+	if strings.Contains(engine, "postgres") {
+		if v, err := strconv.Atoi(parts[0]); err == nil && v >= 10 {
+			return parts[0]
+		}
+	}
+
+	if len(parts) < 2 {
+		return parts[0]
+	}
+
+	return parts[0] + "." + parts[1]
+}
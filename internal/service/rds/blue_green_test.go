@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"testing"
+)
+
+func TestIsMajorEngineVersionChange(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		engine     string
+		oldVersion string
+		newVersion string
+		expected   bool
+	}
+	tests := map[string]testCase{
+		"unknown new version": {
+			engine:     InstanceEngineMySQL,
+			oldVersion: "8.0.35",
+			expected:   false,
+		},
+		"mysql minor": {
+			engine:     InstanceEngineMySQL,
+			oldVersion: "8.0.35",
+			newVersion: "8.0.36",
+			expected:   false,
+		},
+		"mysql major": {
+			engine:     InstanceEngineMySQL,
+			oldVersion: "5.7.44",
+			newVersion: "8.0.36",
+			expected:   true,
+		},
+		"mysql major two part": {
+			engine:     InstanceEngineMySQL,
+			oldVersion: "8.0",
+			newVersion: "8.4",
+			expected:   true,
+		},
+		"mariadb major": {
+			engine:     InstanceEngineMariaDB,
+			oldVersion: "10.6.16",
+			newVersion: "10.11.6",
+			expected:   true,
+		},
+		"postgres minor": {
+			engine:     InstanceEnginePostgres,
+			oldVersion: "15.4",
+			newVersion: "15.5",
+			expected:   false,
+		},
+		"postgres major": {
+			engine:     InstanceEnginePostgres,
+			oldVersion: "15.5",
+			newVersion: "16.1",
+			expected:   true,
+		},
+		"postgres major only": {
+			engine:     InstanceEnginePostgres,
+			oldVersion: "15",
+			newVersion: "15.5",
+			expected:   false,
+		},
+		"postgres legacy major": {
+			engine:     InstanceEnginePostgres,
+			oldVersion: "9.5.25",
+			newVersion: "9.6.24",
+			expected:   true,
+		},
+		"aurora-mysql minor": {
+			engine:     ClusterEngineAuroraMySQL,
+			oldVersion: "8.0.mysql_aurora.3.04.1",
+			newVersion: "8.0.mysql_aurora.3.05.2",
+			expected:   false,
+		},
+		"aurora-mysql major": {
+			engine:     ClusterEngineAuroraMySQL,
+			oldVersion: "5.7.mysql_aurora.2.11.2",
+			newVersion: "8.0.mysql_aurora.3.05.2",
+			expected:   true,
+		},
+		"aurora-postgresql major": {
+			engine:     ClusterEngineAuroraPostgreSQL,
+			oldVersion: "15.4",
+			newVersion: "16.1",
+			expected:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isMajorEngineVersionChange(test.engine, test.oldVersion, test.newVersion), test.expected; got != want {
+				t.Errorf("isMajorEngineVersionChange(%q, %q, %q) = %t, want %t", test.engine, test.oldVersion, test.newVersion, got, want)
+			}
+		})
+	}
+}
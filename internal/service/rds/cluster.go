@@ -5,8 +5,10 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -107,6 +110,23 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"switchover_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrClusterIdentifier: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -142,6 +162,13 @@ func resourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cluster_scalability_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ClusterScalabilityType](),
+			},
 			"copy_tags_to_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -300,6 +327,10 @@ func resourceCluster() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"manage_major_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"manage_master_user_password": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -343,6 +374,18 @@ func resourceCluster() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"monitoring_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1, 5, 10, 15, 30, 60}),
+			},
+			"monitoring_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"network_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -552,7 +595,13 @@ func resourceCluster() *schema.Resource {
 						"min_capacity": {
 							Type:         schema.TypeFloat,
 							Required:     true,
-							ValidateFunc: validation.FloatBetween(0.5, 128),
+							ValidateFunc: validation.FloatBetween(0, 128),
+						},
+						"seconds_until_auto_pause": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(300, 86400),
 						},
 					},
 				},
@@ -625,6 +674,26 @@ func resourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get(names.AttrEngine).(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+				return nil
+			},
+			clusterMajorVersionUpgradeCustomizeDiff,
 		),
 	}
 }
@@ -1058,6 +1127,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.CACertificateIdentifier = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("cluster_scalability_type"); ok {
+			input.ClusterScalabilityType = types.ClusterScalabilityType(v.(string))
+		}
+
 		if v := d.Get(names.AttrDatabaseName); v.(string) != "" {
 			input.DatabaseName = aws.String(v.(string))
 		}
@@ -1147,6 +1220,14 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.MasterUsername = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("monitoring_interval"); ok {
+			input.MonitoringInterval = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("monitoring_role_arn"); ok {
+			input.MonitoringRoleArn = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("network_type"); ok {
 			input.NetworkType = aws.String(v.(string))
 		}
@@ -1276,6 +1357,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return aws.ToString(v.DBInstanceIdentifier)
 	}))
 	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
+	d.Set("cluster_scalability_type", dbc.ClusterScalabilityType)
 	d.Set("copy_tags_to_snapshot", dbc.CopyTagsToSnapshot)
 	// Only set the DatabaseName if it is not nil. There is a known API bug where
 	// RDS accepts a DatabaseName but does not return it, causing a perpetual
@@ -1330,6 +1412,8 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("master_user_secret", nil)
 	}
 	d.Set("master_username", dbc.MasterUsername)
+	d.Set("monitoring_interval", dbc.MonitoringInterval)
+	d.Set("monitoring_role_arn", dbc.MonitoringRoleArn)
 	d.Set("network_type", dbc.NetworkType)
 	d.Set("performance_insights_enabled", dbc.PerformanceInsightsEnabled)
 	d.Set("performance_insights_kms_key_id", dbc.PerformanceInsightsKMSKeyId)
@@ -1394,15 +1478,109 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChangesExcept(
+	// Engine version and parameter group changes are applied to the Green environment of a
+	// Blue/Green Deployment. Any remaining changes are applied in place after switchover.
+	blueGreenKeys := []string{
+		names.AttrEngineVersion,
+		"db_cluster_parameter_group_name",
+		"db_instance_parameter_group_name",
+	}
+	var blueGreenUpdated bool
+	if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(blueGreenKeys...) {
+		deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
+		orchestrator := newBlueGreenOrchestrator(conn)
+		defer orchestrator.CleanUp(ctx)
+
+		handler := newClusterHandler(conn)
+
+		if err := handler.precondition(ctx, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+
+		dep, err := orchestrator.CreateDeployment(ctx, handler.createBlueGreenInput(d))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
+		defer func() {
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+			if dep == nil {
+				log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment: deployment disappeared", d.Id())
+				return
+			}
+
+			// Ensure that the Blue/Green Deployment is always cleaned up
+			input := &rds.DeleteBlueGreenDeploymentInput{
+				BlueGreenDeploymentIdentifier: deploymentIdentifier,
+			}
+			if aws.ToString(dep.Status) != "SWITCHOVER_COMPLETED" {
+				input.DeleteTarget = aws.Bool(true)
+			}
+
+			_, err := conn.DeleteBlueGreenDeployment(ctx, input)
+
+			if err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+				return
+			}
+
+			orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+				if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, aws.ToString(deploymentIdentifier), deadline.Remaining(), optFns...); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
+				}
+			})
+		}()
+
+		dep, err = orchestrator.waitForDeploymentAvailable(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+
+		dep, err = orchestrator.Switchover(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		blueGreenUpdated = true
+		if err := setBlueGreenUpdateSwitchoverTime(d, time.Now().UTC()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting blue_green_update: %s", err)
+		}
+
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
+
+		if err := handler.deleteSource(ctx, aws.ToString(dep.Source), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	exceptKeys := []string{
 		names.AttrAllowMajorVersionUpgrade,
+		"blue_green_update",
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
 		"global_cluster_identifier",
 		"iam_roles",
+		"manage_major_version_upgrade",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		names.AttrTags, names.AttrTagsAll) {
+		names.AttrTags, names.AttrTagsAll,
+	}
+	if blueGreenUpdated {
+		exceptKeys = append(exceptKeys, blueGreenKeys...)
+		exceptKeys = append(exceptKeys, names.AttrDeletionProtection)
+	}
+	if d.HasChangesExcept(exceptKeys...) {
 		applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(applyImmediately),
@@ -1417,6 +1595,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.AllowMajorVersionUpgrade = aws.Bool(v.(bool))
 		}
 
+		managedMajorVersionUpgrade := !blueGreenUpdated && d.Get("manage_major_version_upgrade").(bool) && isMajorEngineVersionChange(d.Get(names.AttrEngine).(string), d.Get("engine_version_actual").(string), d.Get(names.AttrEngineVersion).(string))
+		if managedMajorVersionUpgrade {
+			input.AllowMajorVersionUpgrade = aws.Bool(true)
+		}
+
 		if d.HasChange("backtrack_window") {
 			input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
 		}
@@ -1437,7 +1620,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if !blueGreenUpdated && d.HasChange("db_cluster_parameter_group_name") {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1446,11 +1629,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); !blueGreenUpdated && (ok || d.HasChange("db_instance_parameter_group_name")) {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
-		if d.HasChange(names.AttrDeletionProtection) {
+		if !blueGreenUpdated && d.HasChange(names.AttrDeletionProtection) {
 			input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
 		}
 
@@ -1482,15 +1665,17 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange(names.AttrEngineVersion) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
-		}
+		if !blueGreenUpdated {
+			if d.HasChange(names.AttrEngineVersion) {
+				input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+			}
 
-		// This can happen when updates are deferred (apply_immediately = false), and
-		// multiple applies occur before the maintenance window. In this case,
-		// continue sending the desired engine_version as part of the modify request.
-		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+			// This can happen when updates are deferred (apply_immediately = false), and
+			// multiple applies occur before the maintenance window. In this case,
+			// continue sending the desired engine_version as part of the modify request.
+			if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
+				input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+			}
 		}
 
 		if d.HasChange("iam_database_authentication_enabled") {
@@ -1517,6 +1702,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
+		if d.HasChange("monitoring_interval") {
+			input.MonitoringInterval = aws.Int32(int32(d.Get("monitoring_interval").(int)))
+		}
+
+		if d.HasChange("monitoring_role_arn") {
+			input.MonitoringRoleArn = aws.String(d.Get("monitoring_role_arn").(string))
+		}
+
 		if d.HasChange("network_type") {
 			input.NetworkType = aws.String(d.Get("network_type").(string))
 		}
@@ -1572,6 +1765,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		const (
 			timeout = 5 * time.Minute
 		)
+		modifyTime := time.Now()
 		_, err := tfresource.RetryWhen(ctx, timeout,
 			func() (interface{}, error) {
 				return conn.ModifyDBCluster(ctx, input)
@@ -1598,9 +1792,39 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), applyImmediately, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		output, err := waitDBClusterUpdated(ctx, conn, d.Id(), applyImmediately, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
 		}
+
+		// A major version upgrade that fails its pre-checks leaves the cluster available on the
+		// original engine version. The reason is only reported in the cluster's events.
+		if engine, engineVersion := d.Get(names.AttrEngine).(string), d.Get(names.AttrEngineVersion).(string); managedMajorVersionUpgrade && applyImmediately {
+			if engineMajorVersion(engine, aws.ToString(output.EngineVersion)) != engineMajorVersion(engine, engineVersion) {
+				events, err := findDBClusterUpgradeEvents(ctx, conn, d.Id(), modifyTime)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s) events: %s", d.Id(), err)
+				}
+
+				if len(events) == 0 {
+					return sdkdiag.AppendErrorf(diags, "upgrading RDS Cluster (%s) engine version to %s: engine version is %s", d.Id(), engineVersion, aws.ToString(output.EngineVersion))
+				}
+
+				for _, v := range events {
+					diags = sdkdiag.AppendErrorf(diags, "upgrading RDS Cluster (%s) engine version to %s: %s", d.Id(), engineVersion, aws.ToString(v.Message))
+				}
+
+				return diags
+			}
+
+			for _, v := range output.DBClusterMembers {
+				if _, err := waitDBInstanceAvailable(ctx, conn, aws.ToString(v.DBInstanceIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) instance (%s) upgrade: %s", d.Id(), aws.ToString(v.DBInstanceIdentifier), err)
+				}
+			}
+		}
 	}
 
 	if d.HasChange("global_cluster_identifier") {
@@ -1763,6 +1987,86 @@ func resourceClusterImport(_ context.Context, d *schema.ResourceData, meta inter
 	return []*schema.ResourceData{d}, nil
 }
 
+// clusterMajorVersionUpgradeCustomizeDiff validates a managed engine major version upgrade at plan time.
+// The target engine version must be a valid upgrade target and any configured parameter groups must
+// belong to its parameter group family.
+func clusterMajorVersionUpgradeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("manage_major_version_upgrade").(bool) || !d.HasChange(names.AttrEngineVersion) || !d.NewValueKnown(names.AttrEngineVersion) {
+		return nil
+	}
+
+	engine := d.Get(names.AttrEngine).(string)
+	o, n := d.GetChange(names.AttrEngineVersion)
+	oldVersion, newVersion := o.(string), n.(string)
+	if v := d.Get("engine_version_actual").(string); v != "" {
+		oldVersion = v
+	}
+
+	if !isMajorEngineVersionChange(engine, oldVersion, newVersion) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(oldVersion),
+	}
+	current, err := findDBEngineVersion(ctx, conn, input, tfslices.PredicateTrue[*types.DBEngineVersion]())
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Engine Version (%s %s): %w", engine, oldVersion, err)
+	}
+
+	if !slices.ContainsFunc(current.ValidUpgradeTarget, func(v types.UpgradeTarget) bool {
+		return aws.ToString(v.EngineVersion) == newVersion
+	}) {
+		return fmt.Errorf(`"engine_version" %q is not a valid upgrade target for %s %s.`, newVersion, engine, oldVersion)
+	}
+
+	input = &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(newVersion),
+	}
+	target, err := findDBEngineVersion(ctx, conn, input, tfslices.PredicateTrue[*types.DBEngineVersion]())
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Engine Version (%s %s): %w", engine, newVersion, err)
+	}
+
+	family := aws.ToString(target.DBParameterGroupFamily)
+
+	// An unconfigured cluster parameter group is replaced by the target family's default group.
+	if !d.GetRawConfig().GetAttr("db_cluster_parameter_group_name").IsNull() && d.NewValueKnown("db_cluster_parameter_group_name") {
+		name := d.Get("db_cluster_parameter_group_name").(string)
+		group, err := findDBClusterParameterGroupByName(ctx, conn, name)
+
+		if err != nil {
+			return fmt.Errorf("reading RDS Cluster Parameter Group (%s): %w", name, err)
+		}
+
+		if v := aws.ToString(group.DBParameterGroupFamily); v != family {
+			return fmt.Errorf(`"db_cluster_parameter_group_name" %q has family %q, "engine_version" %q requires family %q.`, name, v, newVersion, family)
+		}
+	}
+
+	if d.NewValueKnown("db_instance_parameter_group_name") {
+		if name := d.Get("db_instance_parameter_group_name").(string); name != "" {
+			group, err := findDBParameterGroupByName(ctx, conn, name)
+
+			if err != nil {
+				return fmt.Errorf("reading RDS DB Parameter Group (%s): %w", name, err)
+			}
+
+			if v := aws.ToString(group.DBParameterGroupFamily); v != family {
+				return fmt.Errorf(`"db_instance_parameter_group_name" %q has family %q, "engine_version" %q requires family %q.`, name, v, newVersion, family)
+			}
+		}
+	}
+
+	return nil
+}
+
 func enableHTTPEndpointProvisioned(ctx context.Context, conn *rds.Client, arn string, o, n interface{}) error {
 	if o == nil {
 		return nil
@@ -1897,6 +2201,40 @@ func findDBClusters(ctx context.Context, conn *rds.Client, input *rds.DescribeDB
 	return output, nil
 }
 
+// findDBClusterUpgradeEvents returns the events reported for an RDS Cluster's engine version upgrade since the specified time.
+func findDBClusterUpgradeEvents(ctx context.Context, conn *rds.Client, id string, startTime time.Time) ([]types.Event, error) {
+	input := &rds.DescribeEventsInput{
+		SourceIdentifier: aws.String(id),
+		SourceType:       types.SourceTypeDbCluster,
+		StartTime:        aws.Time(startTime),
+	}
+
+	return findEvents(ctx, conn, input, func(v *types.Event) bool {
+		return strings.Contains(strings.ToLower(aws.ToString(v.Message)), "upgrade")
+	})
+}
+
+func findEvents(ctx context.Context, conn *rds.Client, input *rds.DescribeEventsInput, filter tfslices.Predicate[*types.Event]) ([]types.Event, error) {
+	var output []types.Event
+
+	pages := rds.NewDescribeEventsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Events {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
 func statusDBCluster(ctx context.Context, conn *rds.Client, id string, waitNoPendingModifiedValues bool, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findDBClusterByID(ctx, conn, id, optFns...)
@@ -1929,6 +2267,7 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.Client, id string, time
 			clusterStatusPreparingDataMigration,
 			clusterStatusRebooting,
 			clusterStatusResettingMasterCredentials,
+			clusterStatusResuming,
 		},
 		Target:     []string{clusterStatusAvailable},
 		Refresh:    statusDBCluster(ctx, conn, id, false),
@@ -1954,6 +2293,7 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, wait
 		clusterStatusModifying,
 		clusterStatusRenaming,
 		clusterStatusResettingMasterCredentials,
+		clusterStatusResuming,
 		clusterStatusScalingCompute,
 		clusterStatusUpgrading,
 	}
@@ -1962,8 +2302,9 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, wait
 	}
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    pendingStatuses,
-		Target:     []string{clusterStatusAvailable},
+		Pending: pendingStatuses,
+		// Aurora Serverless v2 clusters that have scaled to zero capacity may remain paused after modification.
+		Target:     []string{clusterStatusAvailable, clusterStatusPaused},
 		Refresh:    statusDBCluster(ctx, conn, id, waitNoPendingModifiedValues),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
@@ -1986,7 +2327,9 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, time
 			clusterStatusBackingUp,
 			clusterStatusDeleting,
 			clusterStatusModifying,
+			clusterStatusPaused,
 			clusterStatusPromoting,
+			clusterStatusResuming,
 			clusterStatusScalingCompute,
 		},
 		Target:     []string{},
@@ -2005,6 +2348,13 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, time
 	return nil, err
 }
 
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
 func expandScalingConfiguration(tfMap map[string]interface{}) *types.ScalingConfiguration {
 	if tfMap == nil {
 		return nil
@@ -2088,10 +2438,15 @@ func expandServerlessV2ScalingConfiguration(tfMap map[string]interface{}) *types
 		apiObject.MaxCapacity = aws.Float64(v)
 	}
 
-	if v, ok := tfMap["min_capacity"].(float64); ok && v != 0.0 {
+	// A minimum capacity of 0 ACUs enables automatic pause.
+	if v, ok := tfMap["min_capacity"].(float64); ok {
 		apiObject.MinCapacity = aws.Float64(v)
 	}
 
+	if v, ok := tfMap["seconds_until_auto_pause"].(int); ok && v != 0 {
+		apiObject.SecondsUntilAutoPause = aws.Int32(int32(v))
+	}
+
 	return apiObject
 }
 
@@ -2110,5 +2465,9 @@ func flattenServerlessV2ScalingConfigurationInfo(apiObject *types.ServerlessV2Sc
 		tfMap["min_capacity"] = aws.ToFloat64(v)
 	}
 
+	if v := apiObject.SecondsUntilAutoPause; v != nil {
+		tfMap["seconds_until_auto_pause"] = aws.ToInt32(v)
+	}
+
 	return tfMap
 }
@@ -380,6 +380,47 @@ func TestAccRDSCluster_allowMajorVersionUpgradeWithCustomParameters(t *testing.T
 	})
 }
 
+func TestAccRDSCluster_manageMajorVersionUpgrade(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster1, dbCluster2 types.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_manageMajorVersionUpgrade(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster1),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrAllowMajorVersionUpgrade),
+					resource.TestCheckResourceAttr(resourceName, "manage_major_version_upgrade", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, "data.aws_rds_engine_version.test", "version_actual"),
+				),
+			},
+			{
+				Config:      testAccClusterConfig_manageMajorVersionUpgrade(rName, true, false),
+				ExpectError: regexache.MustCompile(`requires family`),
+			},
+			{
+				Config: testAccClusterConfig_manageMajorVersionUpgrade(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, "data.aws_rds_engine_version.upgrade", "version_actual"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version_actual", "data.aws_rds_engine_version.upgrade", "version_actual"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_onlyMajorVersion(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1501,6 +1542,64 @@ func TestAccRDSCluster_engineVersionWithPrimaryInstance(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster types.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+	dataSourceName := "data.aws_rds_engine_version.test"
+	dataSourceNameUpgrade := "data.aws_rds_engine_version.upgrade"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, dataSourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.switchover_time", ""),
+				),
+			},
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, dataSourceNameUpgrade, names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "blue_green_update.0.switchover_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_invalidEngine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_BlueGreenDeployment_engine(rName, "mysql"),
+				ExpectError: regexache.MustCompile(`"blue_green_update.enabled" cannot be set when "engine" is "mysql"`),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_GlobalClusterIdentifierEngineMode_global(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster1 types.DBCluster
@@ -1973,6 +2072,53 @@ func TestAccRDSCluster_serverlessV2ScalingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_serverlessV2ScalingConfiguration_scaleToZero(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster types.DBCluster
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_serverlessV2ScalingConfigurationSecondsUntilAutoPause(rName, 64.0, 0, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr("aws_rds_cluster_instance.test", "instance_class", "db.serverless"),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.max_capacity", "64"),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.min_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.seconds_until_auto_pause", "3600"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_serverlessV2ScalingConfigurationSecondsUntilAutoPause(rName, 64.0, 0, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.min_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.seconds_until_auto_pause", "300"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_serverlessV2ScalingConfiguration(rName, 64.0, 0.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.min_capacity", "0.5"),
+				),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11698
 func TestAccRDSCluster_Scaling_defaultMinCapacity(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -3231,6 +3377,76 @@ resource "aws_db_parameter_group" "test" {
 `, engine, upgrade, mainInstanceClasses, rName, applyImmediate)
 }
 
+func testAccClusterConfig_manageMajorVersionUpgrade(rName string, upgrade, upgradeParameterGroups bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine                  = data.aws_rds_engine_version.upgrade.engine
+  latest                  = true
+  preferred_major_targets = [data.aws_rds_engine_version.upgrade.version_actual]
+}
+
+data "aws_rds_engine_version" "upgrade" {
+  engine = %[1]q
+}
+
+locals {
+  parameter_group_family = %[3]t ? data.aws_rds_engine_version.upgrade.parameter_group_family : data.aws_rds_engine_version.test.parameter_group_family
+  engine_version         = %[2]t ? data.aws_rds_engine_version.upgrade.version_actual : data.aws_rds_engine_version.test.version_actual
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version_actual
+  preferred_instance_classes = [%[4]s]
+}
+
+resource "aws_rds_cluster" "test" {
+  apply_immediately                = true
+  cluster_identifier               = %[5]q
+  db_cluster_parameter_group_name  = aws_rds_cluster_parameter_group.test.name
+  db_instance_parameter_group_name = aws_db_parameter_group.test.name
+  engine                           = data.aws_rds_engine_version.test.engine
+  engine_version                   = local.engine_version
+  manage_major_version_upgrade     = true
+  master_password                  = "mustbeeightcharaters"
+  master_username                  = "test"
+  skip_final_snapshot              = true
+}
+
+# Upgrading requires a healthy primary instance
+resource "aws_rds_cluster_instance" "test" {
+  apply_immediately  = true
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = data.aws_rds_orderable_db_instance.test.engine
+  engine_version     = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier         = %[5]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+
+  lifecycle {
+    ignore_changes = [engine_version]
+  }
+}
+
+resource "aws_rds_cluster_parameter_group" "test" {
+  name_prefix = %[5]q
+  family      = local.parameter_group_family
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_db_parameter_group" "test" {
+  name_prefix = %[5]q
+  family      = local.parameter_group_family
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, tfrds.ClusterEngineAuroraPostgreSQL, upgrade, upgradeParameterGroups, mainInstanceClasses, rName)
+}
+
 func testAccClusterConfig_majorVersionOnly(rName string, engine string, allowMajorVersionUpgrade bool) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "test" {
@@ -3895,6 +4111,91 @@ resource "aws_rds_cluster_instance" "test" {
 `, tfrds.ClusterEngineAuroraPostgreSQL, upgrade, rName, mainInstanceClasses)
 }
 
+func testAccClusterConfig_BlueGreenDeployment_engineVersion(rName string, upgrade bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine                    = %[1]q
+  latest                    = true
+  preferred_upgrade_targets = [data.aws_rds_engine_version.upgrade.version_actual]
+}
+
+data "aws_rds_engine_version" "upgrade" {
+  engine = %[1]q
+}
+
+locals {
+  parameter_group_family = %[2]t ? data.aws_rds_engine_version.upgrade.parameter_group_family : data.aws_rds_engine_version.test.parameter_group_family
+  engine_version         = %[2]t ? data.aws_rds_engine_version.upgrade.version : data.aws_rds_engine_version.test.version
+}
+
+# Blue/Green Deployments require binary logging on Aurora MySQL.
+resource "aws_rds_cluster_parameter_group" "test" {
+  name_prefix = %[3]q
+  family      = local.parameter_group_family
+
+  parameter {
+    apply_method = "pending-reboot"
+    name         = "binlog_format"
+    value        = "ROW"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[3]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = data.aws_rds_engine_version.test.engine
+  engine_version                  = local.engine_version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+  allow_major_version_upgrade     = true
+  apply_immediately               = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version
+  preferred_instance_classes = [%[4]s]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[3]q
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, tfrds.ClusterEngineAuroraMySQL, upgrade, rName, mainInstanceClasses)
+}
+
+func testAccClusterConfig_BlueGreenDeployment_engine(rName, engine string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier        = %[1]q
+  engine                    = %[2]q
+  db_cluster_instance_class = "db.m5d.large"
+  storage_type              = "io1"
+  allocated_storage         = 100
+  iops                      = 1000
+  master_password           = "avoid-plaintext-passwords"
+  master_username           = "tfacctest"
+  skip_final_snapshot       = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, engine)
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -4821,6 +5122,44 @@ resource "aws_rds_cluster" "test" {
 `, tfrds.ClusterEngineAuroraPostgreSQL, rName, maxCapacity, minCapacity)
 }
 
+func testAccClusterConfig_serverlessV2ScalingConfigurationSecondsUntilAutoPause(rName string, maxCapacity, minCapacity float64, secondsUntilAutoPause int) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine      = %[1]q
+  latest      = true
+  include_all = true
+
+  filter {
+    name   = "engine-mode"
+    values = ["serverless"]
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[2]q
+  master_password     = "barbarbarbar"
+  master_username     = "foo"
+  skip_final_snapshot = true
+  engine              = data.aws_rds_engine_version.test.engine
+  engine_version      = data.aws_rds_engine_version.test.version
+
+  serverlessv2_scaling_configuration {
+    max_capacity             = %[3]f
+    min_capacity             = %[4]f
+    seconds_until_auto_pause = %[5]d
+  }
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[2]q
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = "db.serverless"
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+}
+`, tfrds.ClusterEngineAuroraPostgreSQL, rName, maxCapacity, minCapacity, secondsUntilAutoPause)
+}
+
 func testAccClusterConfig_ScalingConfiguration_defaultMinCapacity(rName string, autoPause bool, maxCapacity, secondsUntilAutoPause int, timeoutAction string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -24,11 +24,13 @@ const (
 	clusterStatusDeleting                      = "deleting"
 	clusterStatusMigrating                     = "migrating"
 	clusterStatusModifying                     = "modifying"
+	clusterStatusPaused                        = "paused"
 	clusterStatusPreparingDataMigration        = "preparing-data-migration"
 	clusterStatusPromoting                     = "promoting"
 	clusterStatusRebooting                     = "rebooting"
 	clusterStatusRenaming                      = "renaming"
 	clusterStatusResettingMasterCredentials    = "resetting-master-credentials"
+	clusterStatusResuming                      = "resuming"
 	clusterStatusScalingCompute                = "scaling-compute"
 	clusterStatusUpgrading                     = "upgrading"
 
@@ -316,3 +318,11 @@ const (
 	parameterSourceSystem        = "system"
 	parameterSourceUser          = "user"
 )
+
+const (
+	proxyDefaultTargetGroupName = "default"
+)
+
+const (
+	sessionPinningFilterExcludeVariableSets = "EXCLUDE_VARIABLE_SETS"
+)
@@ -16,6 +16,8 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -174,6 +176,10 @@ func resourceInstance() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"switchover_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -204,6 +210,18 @@ func resourceInstance() *schema.Resource {
 					return false
 				},
 			},
+			"cloudwatch_logs_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"enabled_cloudwatch_logs_exports"},
+			},
+			"cloudwatch_logs_retention_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}),
+				RequiredWith: []string{"enabled_cloudwatch_logs_exports"},
+			},
 			"copy_tags_to_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -1848,6 +1866,15 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if instanceHasLogGroupConfiguration(d) {
+		logsConn := meta.(*conns.AWSClient).LogsClient(ctx)
+		logTypes := flex.ExpandStringValueSet(d.Get("enabled_cloudwatch_logs_exports").(*schema.Set))
+
+		if err := updateInstanceLogGroups(ctx, logsConn, d, identifier, logTypes); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s) CloudWatch Logs log groups: %s", identifier, err)
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -1999,6 +2026,35 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	dbSetResourceDataEngineVersionFromInstance(d, v)
 
+	// The exported log groups are only inspected when the practitioner has opted in to managing them.
+	if instanceHasLogGroupConfiguration(d) {
+		logsConn := meta.(*conns.AWSClient).LogsClient(ctx)
+		retentionInDays, kmsKeyID := d.Get("cloudwatch_logs_retention_in_days").(int), d.Get("cloudwatch_logs_kms_key_id").(string)
+		retentionInDaysDrift, kmsKeyIDDrift := false, false
+
+		// Every log group is compared; the first value that differs from configuration is reported.
+		for _, logType := range v.EnabledCloudwatchLogsExports {
+			logGroup, err := findLogGroupByName(ctx, logsConn, instanceLogGroupName(aws.ToString(v.DBInstanceIdentifier), logType))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading RDS DB Instance (%s) CloudWatch Logs log group (%s): %s", d.Get(names.AttrIdentifier).(string), logType, err)
+			}
+
+			if v := int(aws.ToInt32(logGroup.RetentionInDays)); v != retentionInDays && !retentionInDaysDrift {
+				retentionInDaysDrift = true
+				d.Set("cloudwatch_logs_retention_in_days", v)
+			}
+			if v := aws.ToString(logGroup.KmsKeyId); v != kmsKeyID && !kmsKeyIDDrift {
+				kmsKeyIDDrift = true
+				d.Set("cloudwatch_logs_kms_key_id", v)
+			}
+		}
+	}
+
 	setTagsOut(ctx, v.TagList)
 
 	return diags
@@ -2132,6 +2188,10 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
 			}
 
+			if err := setBlueGreenUpdateSwitchoverTime(d, time.Now().UTC()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting blue_green_update: %s", err)
+			}
+
 			target, err := findDBInstanceByID(ctx, conn, d.Get(names.AttrIdentifier).(string))
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
@@ -2240,6 +2300,15 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChanges("cloudwatch_logs_kms_key_id", "cloudwatch_logs_retention_in_days", "enabled_cloudwatch_logs_exports") {
+		logsConn := meta.(*conns.AWSClient).LogsClient(ctx)
+		logTypes := flex.ExpandStringValueSet(d.Get("enabled_cloudwatch_logs_exports").(*schema.Set))
+
+		if err := updateInstanceLogGroups(ctx, logsConn, d, d.Get(names.AttrIdentifier).(string), logTypes); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s) CloudWatch Logs log groups: %s", d.Get(names.AttrIdentifier).(string), err)
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -3003,6 +3072,114 @@ func dbInstanceValidBlueGreenEngines() []string {
 	}
 }
 
+func instanceHasLogGroupConfiguration(d *schema.ResourceData) bool {
+	return d.Get("cloudwatch_logs_kms_key_id").(string) != "" || d.Get("cloudwatch_logs_retention_in_days").(int) != 0
+}
+
+func instanceLogGroupName(identifier, logType string) string {
+	return fmt.Sprintf("/aws/rds/instance/%s/%s", identifier, logType)
+}
+
+// updateInstanceLogGroups applies the configured retention and encryption settings to the log groups
+// that RDS exports logs to. RDS creates the log groups asynchronously once log exports are enabled,
+// so when settings are configured any missing log groups are created up front; RDS uses existing log groups.
+func updateInstanceLogGroups(ctx context.Context, conn *cloudwatchlogs.Client, d *schema.ResourceData, identifier string, logTypes []string) error {
+	retentionInDays, kmsKeyID := d.Get("cloudwatch_logs_retention_in_days").(int), d.Get("cloudwatch_logs_kms_key_id").(string)
+
+	for _, logType := range logTypes {
+		name := instanceLogGroupName(identifier, logType)
+
+		if instanceHasLogGroupConfiguration(d) {
+			input := &cloudwatchlogs.CreateLogGroupInput{
+				LogGroupName: aws.String(name),
+			}
+
+			if kmsKeyID != "" {
+				input.KmsKeyId = aws.String(kmsKeyID)
+			}
+
+			_, err := conn.CreateLogGroup(ctx, input)
+
+			if err != nil && !errs.IsA[*logstypes.ResourceAlreadyExistsException](err) {
+				return fmt.Errorf("creating CloudWatch Logs log group (%s): %w", name, err)
+			}
+		} else {
+			_, err := findLogGroupByName(ctx, conn, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("reading CloudWatch Logs log group (%s): %w", name, err)
+			}
+		}
+
+		if retentionInDays != 0 {
+			_, err := conn.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+				LogGroupName:    aws.String(name),
+				RetentionInDays: aws.Int32(int32(retentionInDays)),
+			})
+
+			if err != nil {
+				return fmt.Errorf("setting CloudWatch Logs log group (%s) retention policy: %w", name, err)
+			}
+		} else if d.HasChange("cloudwatch_logs_retention_in_days") {
+			_, err := conn.DeleteRetentionPolicy(ctx, &cloudwatchlogs.DeleteRetentionPolicyInput{
+				LogGroupName: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("deleting CloudWatch Logs log group (%s) retention policy: %w", name, err)
+			}
+		}
+
+		if kmsKeyID != "" {
+			_, err := conn.AssociateKmsKey(ctx, &cloudwatchlogs.AssociateKmsKeyInput{
+				KmsKeyId:     aws.String(kmsKeyID),
+				LogGroupName: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("associating CloudWatch Logs log group (%s) KMS key: %w", name, err)
+			}
+		} else if d.HasChange("cloudwatch_logs_kms_key_id") {
+			_, err := conn.DisassociateKmsKey(ctx, &cloudwatchlogs.DisassociateKmsKeyInput{
+				LogGroupName: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("disassociating CloudWatch Logs log group (%s) KMS key: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func findLogGroupByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*logstypes.LogGroup, error) {
+	input := &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(name),
+	}
+
+	pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LogGroups {
+			if aws.ToString(v.LogGroupName) == name {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
 func flattenEndpoint(apiObject *types.Endpoint) map[string]interface{} {
 	if apiObject == nil {
 		return nil
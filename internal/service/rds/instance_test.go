@@ -4211,6 +4211,52 @@ func TestAccRDSInstance_CloudWatchLogsExport_mySQL(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_CloudWatchLogsExport_logGroupConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_cloudWatchLogsExportLogGroup(rName, 7, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs_kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs_retention_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_cloudWatchLogsExportLogGroup(rName, 30, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logs_kms_key_id", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs_retention_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_cloudWatchLogsExport(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs_kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs_retention_in_days", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_CloudWatchLogsExport_msSQL(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -5288,6 +5334,7 @@ func TestAccRDSInstance_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
 					testAccCheckDBInstanceRecreated(&v1, &v2),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, "data.aws_rds_engine_version.update", names.AttrVersion),
 					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "blue_green_update.0.switchover_time"),
 				),
 			},
 			{
@@ -5308,6 +5355,44 @@ func TestAccRDSInstance_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenDeployment_updateMajorEngineVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_majorEngineVersion(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, "data.aws_rds_engine_version.initial", names.AttrVersion),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_majorEngineVersion(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAllowMajorVersionUpgrade, acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, "data.aws_rds_engine_version.update", names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "blue_green_update.0.switchover_time"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_BlueGreenDeployment_updateParameterGroup(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -8269,6 +8354,57 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_cloudWatchLogsExportLogGroup(rName string, retentionInDays int, kms bool) string {
+	kmsKeyID := "null"
+	if kms {
+		kmsKeyID = "aws_kms_key.test.arn"
+	}
+
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		testAccInstanceConfig_baseVPC(rName),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Id": "kms-tf-1",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "*"},
+    "Action": "kms:*",
+    "Resource": "*"
+  }]
+}
+POLICY
+}
+
+resource "aws_db_instance" "test" {
+  identifier           = %[1]q
+  db_subnet_group_name = aws_db_subnet_group.test.name
+  allocated_storage    = 10
+  engine               = data.aws_rds_orderable_db_instance.test.engine
+  engine_version       = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name              = "test"
+  password             = "avoid-plaintext-passwords"
+  username             = "tfacctest"
+  skip_final_snapshot  = true
+
+  enabled_cloudwatch_logs_exports = [
+    "audit",
+    "error",
+  ]
+
+  cloudwatch_logs_kms_key_id        = %[3]s
+  cloudwatch_logs_retention_in_days = %[2]d
+}
+`, rName, retentionInDays, kmsKeyID))
+}
+
 func testAccInstanceConfig_cloudWatchLogsExportAdd(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -11919,6 +12055,54 @@ locals {
 `, rName, mainInstanceClasses, tfrds.InstanceEngineMySQL, update))
 }
 
+func testAccInstanceConfig_BlueGreenDeployment_majorEngineVersion(rName string, update bool) string {
+	return acctest.ConfigCompose(
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  parameter_group_name    = "default.${local.engine_version.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine         = local.engine_version.engine
+  engine_version = local.engine_version.version
+  license_model  = "general-public-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = [%[2]s]
+}
+
+data "aws_rds_engine_version" "initial" {
+  engine  = %[3]q
+  version = "8.0"
+  latest  = true
+}
+
+data "aws_rds_engine_version" "update" {
+  engine  = %[3]q
+  version = "8.4"
+  latest  = true
+}
+
+locals {
+  engine_version = %[4]t ? data.aws_rds_engine_version.update : data.aws_rds_engine_version.initial
+}
+`, rName, mainInstanceClasses, tfrds.InstanceEngineMySQL, update))
+}
+
 func testAccInstanceConfig_BlueGreenDeployment_pre(rName string, oddClasses bool) string {
 	var halfClasses []string
 	start := 0
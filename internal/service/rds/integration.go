@@ -13,12 +13,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -39,6 +41,7 @@ func newIntegrationResource(_ context.Context) (resource.ResourceWithConfigure,
 	r := &integrationResource{}
 
 	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
 	r.SetDefaultDeleteTimeout(30 * time.Minute)
 
 	return r, nil
@@ -56,7 +59,6 @@ const (
 
 type integrationResource struct {
 	framework.ResourceWithConfigure
-	framework.WithNoOpUpdate[integrationResourceModel]
 	framework.WithImportByID
 	framework.WithTimeouts
 }
@@ -77,7 +79,23 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 				},
 			},
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
-			names.AttrID:  framework.IDAttribute(),
+			"data_filter": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 25600),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1000),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
 			"integration_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -112,6 +130,7 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -159,6 +178,7 @@ func (r *integrationResource) Create(ctx context.Context, request resource.Creat
 	}
 
 	// Set values for unknowns.
+	data.DataFilter = fwflex.StringToFramework(ctx, integration.DataFilter)
 	data.KMSKeyID = fwflex.StringToFramework(ctx, integration.KMSKeyId)
 
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
@@ -195,6 +215,7 @@ func (r *integrationResource) Read(ctx context.Context, request resource.ReadReq
 	}
 
 	prevAdditionalEncryptionContext := data.AdditionalEncryptionContext
+	prevDescription := data.Description
 
 	// Set attributes for import.
 	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
@@ -207,11 +228,66 @@ func (r *integrationResource) Read(ctx context.Context, request resource.ReadReq
 		data.AdditionalEncryptionContext = prevAdditionalEncryptionContext
 	}
 
+	// Null vs. empty string handling.
+	if prevDescription.IsNull() && data.Description.ValueString() == "" {
+		data.Description = prevDescription
+	}
+
 	setTagsOut(ctx, output.Tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *integrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new integrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	if !new.DataFilter.Equal(old.DataFilter) || !new.Description.Equal(old.Description) {
+		input := &rds.ModifyIntegrationInput{
+			IntegrationIdentifier: aws.String(new.ID.ValueString()),
+		}
+
+		if !new.DataFilter.Equal(old.DataFilter) {
+			input.DataFilter = fwflex.StringFromFramework(ctx, new.DataFilter)
+		}
+
+		if !new.Description.Equal(old.Description) {
+			// An empty description removes any existing value.
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		_, err := conn.ModifyIntegration(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Integration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		integration, err := waitIntegrationUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Integration (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		new.DataFilter = fwflex.StringToFramework(ctx, integration.DataFilter)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
 func (r *integrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data integrationResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -327,6 +403,25 @@ func waitIntegrationCreated(ctx context.Context, conn *rds.Client, arn string, t
 	return nil, err
 }
 
+func waitIntegrationUpdated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{integrationStatusModifying, integrationStatusSyncing},
+		Target:  []string{integrationStatusActive},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, integrationError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitIntegrationDeleted(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{integrationStatusDeleting, integrationStatusActive},
@@ -352,6 +447,8 @@ func integrationError(v awstypes.IntegrationError) error {
 
 type integrationResourceModel struct {
 	AdditionalEncryptionContext fwtypes.MapValueOf[types.String] `tfsdk:"additional_encryption_context"`
+	DataFilter                  types.String                     `tfsdk:"data_filter"`
+	Description                 types.String                     `tfsdk:"description"`
 	ID                          types.String                     `tfsdk:"id"`
 	IntegrationARN              types.String                     `tfsdk:"arn"`
 	IntegrationName             types.String                     `tfsdk:"integration_name"`
@@ -46,6 +46,8 @@ func TestAccRDSIntegration_basic(t *testing.T) {
 				Config: testAccIntegrationConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIntegrationExists(ctx, resourceName, &integration),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: *.*"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
 					resource.TestCheckResourceAttr(resourceName, "integration_name", rName),
 					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_rds_cluster.test", names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
@@ -140,6 +142,54 @@ func TestAccRDSIntegration_optional(t *testing.T) {
 	})
 }
 
+func TestAccRDSIntegration_dataFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var integration awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_baseClusterWithInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					waitUntilDBInstanceRebooted(ctx, rName),
+				),
+			},
+			{
+				Config: testAccIntegrationConfig_dataFilter(rName, "include: test.mytable", "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: test.mytable"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntegrationConfig_dataFilter(rName, "include: test.*, exclude: test.mytable", "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: test.*, exclude: test.mytable"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIntegrationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -466,3 +516,22 @@ resource "aws_rds_integration" "test" {
 }
 `, rName))
 }
+
+func testAccIntegrationConfig_dataFilter(rName, dataFilter, description string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_rds_cluster.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+  data_filter      = %[2]q
+  description      = %[3]q
+
+  depends_on = [
+    aws_rds_cluster.test,
+    aws_redshiftserverless_namespace.test,
+    aws_redshiftserverless_workgroup.test,
+    aws_redshift_resource_policy.test,
+  ]
+}
+`, rName, dataFilter, description))
+}
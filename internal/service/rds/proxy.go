@@ -5,13 +5,16 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -90,6 +93,7 @@ func resourceProxy() *schema.Resource {
 				},
 				Set: sdkv2.SimpleSchemaSetFunc("auth_scheme", names.AttrDescription, "iam_auth", "secret_arn", names.AttrUsername),
 			},
+			"connection_pool_config": connectionPoolConfigSchema(),
 			"debug_logging": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -139,7 +143,11 @@ func resourceProxy() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			proxyEngineFamilyCustomizeDiff,
+			connectionPoolConfigCustomizeDiff,
+		),
 	}
 }
 
@@ -185,6 +193,12 @@ func resourceProxyCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Proxy (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("connection_pool_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := modifyDefaultDBProxyTargetGroup(ctx, conn, d.Id(), expandConnectionPoolConfiguration(v.([]interface{})[0].(map[string]interface{})), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceProxyRead(ctx, d, meta)...)
 }
 
@@ -216,6 +230,21 @@ func resourceProxyRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set(names.AttrVPCSecurityGroupIDs, dbProxy.VpcSecurityGroupIds)
 	d.Set(names.AttrEndpoint, dbProxy.Endpoint)
 
+	tg, err := findDefaultDBProxyTargetGroupByDBProxyName(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("connection_pool_config", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Proxy (%s) default target group: %s", d.Id(), err)
+	case tg.ConnectionPoolConfig != nil:
+		if err := d.Set("connection_pool_config", []interface{}{flattenConnectionPoolConfigurationInfo(tg.ConnectionPoolConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting connection_pool_config: %s", err)
+		}
+	default:
+		d.Set("connection_pool_config", nil)
+	}
+
 	return diags
 }
 
@@ -223,7 +252,7 @@ func resourceProxyUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("connection_pool_config", names.AttrTags, names.AttrTagsAll) {
 		oName, nName := d.GetChange(names.AttrName)
 		input := &rds.ModifyDBProxyInput{
 			Auth:           expandUserAuthConfigs(d.Get("auth").(*schema.Set).List()),
@@ -257,6 +286,14 @@ func resourceProxyUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	if d.HasChange("connection_pool_config") {
+		if v, ok := d.GetOk("connection_pool_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := modifyDefaultDBProxyTargetGroup(ctx, conn, d.Id(), expandConnectionPoolConfiguration(v.([]interface{})[0].(map[string]interface{})), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceProxyRead(ctx, d, meta)...)
 }
 
@@ -284,6 +321,82 @@ func resourceProxyDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func modifyDefaultDBProxyTargetGroup(ctx context.Context, conn *rds.Client, dbProxyName string, connectionPoolConfig *types.ConnectionPoolConfiguration, timeout time.Duration) error {
+	input := &rds.ModifyDBProxyTargetGroupInput{
+		ConnectionPoolConfig: connectionPoolConfig,
+		DBProxyName:          aws.String(dbProxyName),
+		TargetGroupName:      aws.String(proxyDefaultTargetGroupName),
+	}
+
+	_, err := conn.ModifyDBProxyTargetGroup(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("modifying RDS DB Proxy (%s) default target group: %w", dbProxyName, err)
+	}
+
+	if _, err := waitDefaultDBProxyTargetGroupAvailable(ctx, conn, dbProxyName, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS DB Proxy (%s) default target group update: %w", dbProxyName, err)
+	}
+
+	return nil
+}
+
+// proxyEngineFamilyCustomizeDiff rejects client password authentication types and
+// session pinning filters that the proxy's engine family does not support.
+func proxyEngineFamilyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	engineFamily := types.EngineFamily(diff.Get("engine_family").(string))
+	if engineFamily == "" {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("auth").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["client_password_auth_type"].(string)
+		if !ok || v == "" {
+			continue
+		}
+
+		if !slices.Contains(proxyClientPasswordAuthTypes(engineFamily), types.ClientPasswordAuthType(v)) {
+			return fmt.Errorf("auth client_password_auth_type %q is not supported for engine_family %q", v, engineFamily)
+		}
+	}
+
+	if v, ok := diff.GetOk("connection_pool_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if v, ok := tfMap["session_pinning_filters"].(*schema.Set); ok && v.Len() > 0 && engineFamily != types.EngineFamilyMysql {
+			return fmt.Errorf("connection_pool_config session_pinning_filters are only supported for engine_family %q", types.EngineFamilyMysql)
+		}
+	}
+
+	return nil
+}
+
+func proxyClientPasswordAuthTypes(engineFamily types.EngineFamily) []types.ClientPasswordAuthType {
+	switch engineFamily {
+	case types.EngineFamilyMysql:
+		return []types.ClientPasswordAuthType{
+			types.ClientPasswordAuthTypeMysqlCachingSha2Password,
+			types.ClientPasswordAuthTypeMysqlNativePassword,
+		}
+	case types.EngineFamilyPostgresql:
+		return []types.ClientPasswordAuthType{
+			types.ClientPasswordAuthTypePostgresMd5,
+			types.ClientPasswordAuthTypePostgresScramSha256,
+		}
+	case types.EngineFamilySqlserver:
+		return []types.ClientPasswordAuthType{
+			types.ClientPasswordAuthTypeSqlServerAuthentication,
+		}
+	default:
+		return types.ClientPasswordAuthType("").Values()
+	}
+}
+
 func findDBProxyByName(ctx context.Context, conn *rds.Client, name string) (*types.DBProxy, error) {
 	input := &rds.DescribeDBProxiesInput{
 		DBProxyName: aws.String(name),
@@ -47,49 +47,7 @@ func resourceProxyDefaultTargetGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"connection_pool_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"connection_borrow_timeout": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      120,
-							ValidateFunc: validation.IntBetween(0, 3600),
-						},
-						"init_query": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"max_connections_percent": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      100,
-							ValidateFunc: validation.IntBetween(1, 100),
-						},
-						"max_idle_connections_percent": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      50,
-							ValidateFunc: validation.IntBetween(0, 100),
-						},
-						"session_pinning_filters": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								// This isn't available as a constant
-								ValidateFunc: validation.StringInSlice([]string{
-									"EXCLUDE_VARIABLE_SETS",
-								}, false),
-							},
-						},
-					},
-				},
-			},
+			"connection_pool_config": connectionPoolConfigSchema(),
 			"db_proxy_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -101,9 +59,101 @@ func resourceProxyDefaultTargetGroup() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: connectionPoolConfigCustomizeDiff,
+	}
+}
+
+func connectionPoolConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"connection_borrow_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      120,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+				"init_query": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"max_connections_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      100,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				"max_idle_connections_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      50,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"session_pinning_filters": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						// This isn't available as a constant
+						ValidateFunc: validation.StringInSlice([]string{
+							sessionPinningFilterExcludeVariableSets,
+						}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// connectionPoolConfigCustomizeDiff plans the default connection pool configuration when the
+// connection_pool_config block is removed. The block is Optional+Computed, so removing it doesn't produce a diff.
+func connectionPoolConfigCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if v := diff.GetRawConfig().GetAttr("connection_pool_config"); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+		return nil
+	}
+
+	if v := diff.Get("connection_pool_config").([]interface{}); len(v) == 0 || v[0] == nil || isDefaultConnectionPoolConfig(v[0].(map[string]interface{})) {
+		return nil
+	}
+
+	return diff.SetNew("connection_pool_config", []interface{}{defaultConnectionPoolConfig()})
+}
+
+func defaultConnectionPoolConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"connection_borrow_timeout":    120,
+		"init_query":                   "",
+		"max_connections_percent":      100,
+		"max_idle_connections_percent": 50,
+		"session_pinning_filters":      []interface{}{},
 	}
 }
 
+func isDefaultConnectionPoolConfig(tfMap map[string]interface{}) bool {
+	defaults := defaultConnectionPoolConfig()
+
+	for _, key := range []string{"connection_borrow_timeout", "init_query", "max_connections_percent", "max_idle_connections_percent"} {
+		if tfMap[key] != defaults[key] {
+			return false
+		}
+	}
+
+	if v, ok := tfMap["session_pinning_filters"].(*schema.Set); ok && v.Len() > 0 {
+		return false
+	}
+
+	return true
+}
+
 func resourceProxyDefaultTargetGroupPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
@@ -111,7 +161,7 @@ func resourceProxyDefaultTargetGroupPut(ctx context.Context, d *schema.ResourceD
 	dbProxyName := d.Get("db_proxy_name").(string)
 	input := &rds.ModifyDBProxyTargetGroupInput{
 		DBProxyName:     aws.String(dbProxyName),
-		TargetGroupName: aws.String("default"),
+		TargetGroupName: aws.String(proxyDefaultTargetGroupName),
 	}
 
 	if v, ok := d.GetOk("connection_pool_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -260,11 +310,12 @@ func expandConnectionPoolConfiguration(tfMap map[string]interface{}) *types.Conn
 		MaxIdleConnectionsPercent: aws.Int32(int32(tfMap["max_idle_connections_percent"].(int))),
 	}
 
-	if v, ok := tfMap["init_query"].(string); ok && v != "" {
+	// Send empty values explicitly so that removed settings are cleared.
+	if v, ok := tfMap["init_query"].(string); ok {
 		apiObject.InitQuery = aws.String(v)
 	}
 
-	if v, ok := tfMap["session_pinning_filters"].(*schema.Set); ok && v.Len() > 0 {
+	if v, ok := tfMap["session_pinning_filters"].(*schema.Set); ok {
 		apiObject.SessionPinningFilters = flex.ExpandStringValueSet(v)
 	}
 
@@ -190,6 +190,102 @@ func TestAccRDSProxy_idleClientTimeout(t *testing.T) {
 	})
 }
 
+func TestAccRDSProxy_connectionPoolConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxy types.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig_connectionPoolConfig(rName, 90, 75),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.connection_borrow_timeout", "90"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "75"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.session_pinning_filters.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "connection_pool_config.0.session_pinning_filters.*", "EXCLUDE_VARIABLE_SETS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProxyConfig_connectionPoolConfig(rName, 120, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.connection_borrow_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "100"),
+				),
+			},
+			{
+				Config: testAccProxyConfig_connectionPoolConfigRemoved(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.connection_borrow_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "100"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_idle_connections_percent", "50"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.session_pinning_filters.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSProxy_engineFamilySQLServer(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxy types.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProxyConfig_engineFamilySQLServer(rName, "MYSQL_NATIVE_PASSWORD"),
+				ExpectError: regexache.MustCompile(`client_password_auth_type "MYSQL_NATIVE_PASSWORD" is not supported for engine_family "SQLSERVER"`),
+			},
+			{
+				Config: testAccProxyConfig_engineFamilySQLServer(rName, "SQL_SERVER_AUTHENTICATION"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "engine_family", "SQLSERVER"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"client_password_auth_type": "SQL_SERVER_AUTHENTICATION",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccRDSProxy_requireTLS(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -773,6 +869,83 @@ resource "aws_db_proxy" "test" {
 `, rName, idleClientTimeout))
 }
 
+func testAccProxyConfig_connectionPoolConfig(rName string, connectionBorrowTimeout, maxConnectionsPercent int) string {
+	return acctest.ConfigCompose(testAccProxyConfig_base(rName), fmt.Sprintf(`
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_iam_role_policy.test
+  ]
+
+  name           = %[1]q
+  engine_family  = "MYSQL"
+  role_arn       = aws_iam_role.test.arn
+  vpc_subnet_ids = aws_subnet.test[*].id
+
+  auth {
+    auth_scheme = "SECRETS"
+    description = "test"
+    iam_auth    = "DISABLED"
+    secret_arn  = aws_secretsmanager_secret.test.arn
+  }
+
+  connection_pool_config {
+    connection_borrow_timeout    = %[2]d
+    max_connections_percent      = %[3]d
+    max_idle_connections_percent = 50
+    session_pinning_filters      = ["EXCLUDE_VARIABLE_SETS"]
+  }
+}
+`, rName, connectionBorrowTimeout, maxConnectionsPercent))
+}
+
+func testAccProxyConfig_connectionPoolConfigRemoved(rName string) string {
+	return acctest.ConfigCompose(testAccProxyConfig_base(rName), fmt.Sprintf(`
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_iam_role_policy.test
+  ]
+
+  name           = %[1]q
+  engine_family  = "MYSQL"
+  role_arn       = aws_iam_role.test.arn
+  vpc_subnet_ids = aws_subnet.test[*].id
+
+  auth {
+    auth_scheme = "SECRETS"
+    description = "test"
+    iam_auth    = "DISABLED"
+    secret_arn  = aws_secretsmanager_secret.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccProxyConfig_engineFamilySQLServer(rName, clientPasswordAuthType string) string {
+	return acctest.ConfigCompose(testAccProxyConfig_base(rName), fmt.Sprintf(`
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_iam_role_policy.test
+  ]
+
+  name           = %[1]q
+  engine_family  = "SQLSERVER"
+  role_arn       = aws_iam_role.test.arn
+  vpc_subnet_ids = aws_subnet.test[*].id
+
+  auth {
+    auth_scheme               = "SECRETS"
+    client_password_auth_type = %[2]q
+    description               = "test"
+    iam_auth                  = "DISABLED"
+    secret_arn                = aws_secretsmanager_secret.test.arn
+  }
+}
+`, rName, clientPasswordAuthType))
+}
+
 func testAccProxyConfig_requireTLS(rName string, requireTls bool) string {
 	return acctest.ConfigCompose(testAccProxyConfig_base(rName), fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
@@ -35,6 +35,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceExportTask,
 		},
+		{
+			Factory: newShardGroupResource,
+			Name:    "Shard Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSShardGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.DBShardGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_shard_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckShardGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShardGroupConfig_basic(rName, 0, 16),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "rds", regexache.MustCompile(`shard-group:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_redundancy", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_identifier", "aws_rds_cluster.test", names.AttrClusterIdentifier),
+					resource.TestCheckResourceAttr(resourceName, "db_shard_group_identifier", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "db_shard_group_resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "max_acu", "16"),
+					resource.TestCheckResourceAttrSet(resourceName, "min_acu"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPubliclyAccessible, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr("aws_rds_cluster.test", "cluster_scalability_type", "limitless"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShardGroupConfig_basic(rName, 1, 32),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "compute_redundancy", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "max_acu", "32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSShardGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.DBShardGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_shard_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckShardGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShardGroupConfig_basic(rName, 0, 16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfrds.ResourceShardGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckShardGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_shard_group" {
+				continue
+			}
+
+			_, err := tfrds.FindDBShardGroupByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Shard Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckShardGroupExists(ctx context.Context, n string, v *awstypes.DBShardGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindDBShardGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccShardGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_rds_engine_version" "test" {
+  engine             = "aurora-postgresql"
+  latest             = true
+  preferred_versions = ["16.4-limitless", "16.6-limitless"]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "monitoring.rds.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonRDSEnhancedMonitoringRole"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_rds_cluster" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  cluster_identifier                    = %[1]q
+  cluster_scalability_type              = "limitless"
+  engine                                = data.aws_rds_engine_version.test.engine
+  engine_version                        = data.aws_rds_engine_version.test.version
+  master_password                       = "avoid-plaintext-passwords"
+  master_username                       = "tfacctest"
+  monitoring_interval                   = 5
+  monitoring_role_arn                   = aws_iam_role.test.arn
+  performance_insights_enabled          = true
+  performance_insights_retention_period = 31
+  skip_final_snapshot                   = true
+  storage_type                          = "aurora-iopt1"
+}
+`, rName)
+}
+
+func testAccShardGroupConfig_basic(rName string, computeRedundancy, maxACU int) string {
+	return acctest.ConfigCompose(testAccShardGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_shard_group" "test" {
+  db_cluster_identifier     = aws_rds_cluster.test.cluster_identifier
+  db_shard_group_identifier = %[1]q
+  compute_redundancy        = %[2]d
+  max_acu                   = %[3]d
+}
+`, rName, computeRedundancy, maxACU))
+}
@@ -5,14 +5,17 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -83,9 +86,10 @@ func resourceSnapshotCopy() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrKMSKeyID: {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidKMSKeyID,
 			},
 			"license_model": {
 				Type:     schema.TypeString,
@@ -120,6 +124,11 @@ func resourceSnapshotCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrSkipDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"snapshot_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -150,7 +159,27 @@ func resourceSnapshotCopy() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// The copy is re-encrypted with a KMS key in the Region it is created in.
+				v, ok := d.GetOk(names.AttrKMSKeyID)
+				if !ok || !arn.IsARN(v.(string)) {
+					return nil
+				}
+
+				keyARN, err := arn.Parse(v.(string))
+				if err != nil {
+					return err
+				}
+
+				if region := meta.(*conns.AWSClient).Region; keyARN.Region != region {
+					return fmt.Errorf(`"kms_key_id" (%s) must be a KMS key in the destination Region (%s)`, v, region)
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -179,6 +208,9 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 
 	if v, ok := d.GetOk("presigned_url"); ok {
 		input.PreSignedUrl = aws.String(v.(string))
+	} else if sourceRegion := snapshotCopySourceRegion(d.Get("source_db_snapshot_identifier").(string)); sourceRegion != "" && sourceRegion != meta.(*conns.AWSClient).Region {
+		// Cross-Region copies require a presigned URL, which the SDK generates from the source Region.
+		input.SourceRegion = aws.String(sourceRegion)
 	} else if v, ok := d.GetOk("destination_region"); ok {
 		output, err := rds.NewPresignClient(conn, func(o *rds.PresignOptions) {
 			o.ClientOptions = append(o.ClientOptions, func(o *rds.Options) {
@@ -298,6 +330,11 @@ func resourceSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
+	if d.Get(names.AttrSkipDestroy).(bool) {
+		log.Printf("[DEBUG] Retaining RDS DB Snapshot Copy: %s", d.Id())
+		return diags
+	}
+
 	log.Printf("[DEBUG] Deleting RDS DB Snapshot Copy: %s", d.Id())
 	_, err := conn.DeleteDBSnapshot(ctx, &rds.DeleteDBSnapshotInput{
 		DBSnapshotIdentifier: aws.String(d.Id()),
@@ -313,3 +350,14 @@ func resourceSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+// snapshotCopySourceRegion returns the Region of a source DB snapshot specified by ARN.
+// An empty string is returned for a source DB snapshot specified by identifier.
+func snapshotCopySourceRegion(source string) string {
+	v, err := arn.Parse(source)
+	if err != nil {
+		return ""
+	}
+
+	return v.Region
+}
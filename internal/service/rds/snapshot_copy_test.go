@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -216,6 +217,46 @@ func TestAccRDSSnapshotCopy_destinationRegion(t *testing.T) {
 	})
 }
 
+func TestAccRDSSnapshotCopy_crossRegionKMS(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBSnapshot
+	resourceName := "aws_db_snapshot_copy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSnapshotCopyConfig_kmsKeyIDRegion(rName),
+				ExpectError: regexache.MustCompile(`must be a KMS key in the destination Region`),
+			},
+			{
+				Config: testAccSnapshotCopyConfig_crossRegionKMS(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCopyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEncrypted, acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "source_region", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy},
+			},
+		},
+	})
+}
+
 func testAccCheckSnapshotCopyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -346,3 +387,85 @@ resource "aws_db_snapshot_copy" "test" {
   destination_region            = %[2]q
 }`, rName, acctest.AlternateRegion()))
 }
+
+func testAccSnapshotCopyConfig_baseCrossRegion(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  provider = "awsalternate"
+
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  provider = "awsalternate"
+
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  preferred_instance_classes = ["db.t3.small", "db.t2.small", "db.t2.medium"]
+}
+
+resource "aws_db_instance" "test" {
+  provider = "awsalternate"
+
+  allocated_storage       = 10
+  engine                  = data.aws_rds_engine_version.default.engine
+  engine_version          = data.aws_rds_engine_version.default.version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  identifier              = %[1]q
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  maintenance_window      = "Fri:09:00-Fri:09:30"
+  backup_retention_period = 0
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  storage_encrypted       = true
+}
+
+resource "aws_db_snapshot" "test" {
+  provider = "awsalternate"
+
+  db_instance_identifier = aws_db_instance.test.identifier
+  db_snapshot_identifier = "%[1]s-source"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccSnapshotCopyConfig_kmsKeyIDRegion(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotCopyConfig_baseCrossRegion(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_db_snapshot_copy" "test" {
+  source_db_snapshot_identifier = aws_db_snapshot.test.db_snapshot_arn
+  target_db_snapshot_identifier = "%[1]s-target"
+  kms_key_id                    = "arn:${data.aws_partition.current.partition}:kms:${data.aws_region.alternate.name}:${data.aws_caller_identity.current.account_id}:key/00000000-0000-0000-0000-000000000000"
+}
+`, rName))
+}
+
+func testAccSnapshotCopyConfig_crossRegionKMS(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotCopyConfig_baseCrossRegion(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_db_snapshot_copy" "test" {
+  source_db_snapshot_identifier = aws_db_snapshot.test.db_snapshot_arn
+  target_db_snapshot_identifier = "%[1]s-target"
+  kms_key_id                    = aws_kms_key.test.arn
+  copy_tags                     = true
+}
+`, rName))
+}
@@ -13,6 +13,7 @@ var (
 	ResourceKeySigningKey               = resourceKeySigningKey
 	ResourceQueryLog                    = resourceQueryLog
 	ResourceRecord                      = resourceRecord
+	ResourceRecordsExclusive            = newRecordsExclusiveResource
 	ResourceTrafficPolicy               = resourceTrafficPolicy
 	ResourceTrafficPolicyInstance       = resourceTrafficPolicyInstance
 	ResourceVPCAssociationAuthorization = resourceVPCAssociationAuthorization
@@ -26,6 +27,7 @@ var (
 	FindCIDRCollectionByID                      = findCIDRCollectionByID
 	FindCIDRLocationByTwoPartKey                = findCIDRLocationByTwoPartKey
 	FindDelegationSetByID                       = findDelegationSetByID
+	FindExclusiveResourceRecordSetsByZoneID     = findExclusiveResourceRecordSetsByZoneID
 	FindHealthCheckByID                         = findHealthCheckByID
 	FindHostedZoneByID                          = findHostedZoneByID
 	FindHostedZoneDNSSECByZoneID                = findHostedZoneDNSSECByZoneID
@@ -42,6 +44,7 @@ var (
 	RecordParseResourceID                       = recordParseResourceID
 	ServeSignatureNotSigning                    = serveSignatureNotSigning
 	ServeSignatureSigning                       = serveSignatureSigning
+	ValidGeoproximityCoordinate                 = validGeoproximityCoordinate
 	WaitChangeInsync                            = waitChangeInsync
 )
 
@@ -44,6 +44,10 @@ func resourceHostedZoneDNSSEC() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrHostedZoneID: {
 				Type:     schema.TypeString,
@@ -141,8 +145,10 @@ func resourceHostedZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).Route53Client(ctx)
 
 	log.Printf("[DEBUG] Deleting Route 53 Hosted Zone DNSSEC: %s", d.Id())
-	output, err := conn.DisableHostedZoneDNSSEC(ctx, &route53.DisableHostedZoneDNSSECInput{
-		HostedZoneId: aws.String(d.Id()),
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.KeySigningKeyInParentDSRecord](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DisableHostedZoneDNSSEC(ctx, &route53.DisableHostedZoneDNSSECInput{
+			HostedZoneId: aws.String(d.Id()),
+		})
 	})
 
 	if errs.IsA[*awstypes.DNSSECNotFound](err) || errs.IsA[*awstypes.NoSuchHostedZone](err) {
@@ -153,7 +159,7 @@ func resourceHostedZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "disabling Route 53 Hosted Zone DNSSEC (%s): %s", d.Id(), err)
 	}
 
-	if output.ChangeInfo != nil {
+	if output := outputRaw.(*route53.DisableHostedZoneDNSSECOutput); output.ChangeInfo != nil {
 		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Hosted Zone DNSSEC (%s) synchronize: %s", d.Id(), err)
 		}
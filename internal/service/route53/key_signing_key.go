@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -51,6 +52,10 @@ func resourceKeySigningKey() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"digest_algorithm_mnemonic": {
 				Type:     schema.TypeString,
@@ -68,6 +73,11 @@ func resourceKeySigningKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ds_propagation_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+			},
 			"ds_record": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,6 +94,7 @@ func resourceKeySigningKey() *schema.Resource {
 			"key_management_service_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			"key_tag": {
@@ -91,14 +102,27 @@ func resourceKeySigningKey() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrName: {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{names.AttrNamePrefix},
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(3, 128),
 					validation.StringMatch(regexache.MustCompile("^[0-9A-Za-z_.-]"), "must contain only alphanumeric characters, periods, underscores, or hyphens"),
 				),
 			},
+			names.AttrNamePrefix: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{names.AttrName},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128-sdkid.UniqueIDSuffixLength),
+					validation.StringMatch(regexache.MustCompile("^[0-9A-Za-z_.-]+$"), "must contain only alphanumeric characters, periods, underscores, or hyphens"),
+				),
+			},
 			names.AttrPublicKey: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -129,7 +153,7 @@ func resourceKeySigningKeyCreate(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).Route53Client(ctx)
 
 	hostedZoneID := d.Get(names.AttrHostedZoneID).(string)
-	name := d.Get(names.AttrName).(string)
+	name := create.Name(d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string))
 	status := d.Get(names.AttrStatus).(string)
 	id := errs.Must(flex.FlattenResourceId([]string{hostedZoneID, name}, keySigningKeyResourceIDPartCount, false))
 	input := &route53.CreateKeySigningKeyInput{
@@ -196,6 +220,7 @@ func resourceKeySigningKeyRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("key_management_service_arn", keySigningKey.KmsArn)
 	d.Set("key_tag", keySigningKey.KeyTag)
 	d.Set(names.AttrName, keySigningKey.Name)
+	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(keySigningKey.Name)))
 	d.Set(names.AttrPublicKey, keySigningKey.PublicKey)
 	d.Set("signing_algorithm_mnemonic", keySigningKey.SigningAlgorithmMnemonic)
 	d.Set("signing_algorithm_type", keySigningKey.SigningAlgorithmType)
@@ -272,13 +297,33 @@ func resourceKeySigningKeyDelete(ctx context.Context, d *schema.ResourceData, me
 
 	hostedZoneID, name := parts[0], parts[1]
 
+	// The DS record propagation delay counts against the delete timeout.
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutDelete))
+
 	if status := d.Get(names.AttrStatus).(string); status == keySigningKeyStatusActive || status == keySigningKeyStatusActionNeeded {
+		// Give resolvers time to pick up the DS record of any replacement key before this one is withdrawn.
+		if v, ok := d.GetOk("ds_propagation_delay"); ok {
+			delay, _ := time.ParseDuration(v.(string))
+
+			log.Printf("[DEBUG] Waiting %s for DS record propagation before deactivating Route 53 Key Signing Key: %s", delay, d.Id())
+			select {
+			case <-ctx.Done():
+				return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) DS record propagation: %s", d.Id(), ctx.Err())
+			case <-time.After(delay):
+			}
+		}
+
 		input := &route53.DeactivateKeySigningKeyInput{
 			HostedZoneId: aws.String(hostedZoneID),
 			Name:         aws.String(name),
 		}
 
-		output, err := conn.DeactivateKeySigningKey(ctx, input)
+		// The key can't be deactivated while its DS record is still in the parent zone.
+		outputRaw, err := tfresource.RetryWhenIsA[*awstypes.KeySigningKeyInParentDSRecord](ctx, deadline.Remaining(), func() (interface{}, error) {
+			return conn.DeactivateKeySigningKey(ctx, input)
+		})
 
 		if errs.IsA[*awstypes.NoSuchKeySigningKey](err) {
 			return diags
@@ -288,7 +333,7 @@ func resourceKeySigningKeyDelete(ctx context.Context, d *schema.ResourceData, me
 			return sdkdiag.AppendErrorf(diags, "deactivating Route 53 Key Signing Key (%s): %s", d.Id(), err)
 		}
 
-		if output.ChangeInfo != nil {
+		if output := outputRaw.(*route53.DeactivateKeySigningKeyOutput); output.ChangeInfo != nil {
 			if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) synchronize: %s", d.Id(), err)
 			}
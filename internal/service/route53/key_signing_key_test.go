@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -128,6 +129,110 @@ func TestAccRoute53KeySigningKey_status(t *testing.T) {
 	})
 }
 
+func TestAccRoute53KeySigningKey_nameGenerated(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_key_signing_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeySigningKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeySigningKeyConfig_nameGenerated(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccKeySigningKeyExists(ctx, resourceName),
+					acctest.CheckResourceAttrNameGenerated(resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, "terraform-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53KeySigningKey_namePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_key_signing_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeySigningKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeySigningKeyConfig_namePrefix(rName, domainName, "tf-acc-test-prefix-"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccKeySigningKeyExists(ctx, resourceName),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, names.AttrName, "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53KeySigningKey_rotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_key_signing_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeySigningKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeySigningKeyConfig_rotation(rName, domainName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccKeySigningKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ds_propagation_delay", "30s"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_management_service_arn", "aws_kms_key.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, tfroute53.KeySigningKeyStatusActive),
+					resource.TestCheckResourceAttr("aws_route53_hosted_zone_dnssec.test", "signing_status", tfroute53.ServeSignatureSigning),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ds_propagation_delay"},
+			},
+			{
+				Config: testAccKeySigningKeyConfig_rotation(rName, domainName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccKeySigningKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_management_service_arn", "aws_kms_key.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, tfroute53.KeySigningKeyStatusActive),
+					resource.TestCheckResourceAttr("aws_route53_hosted_zone_dnssec.test", "signing_status", tfroute53.ServeSignatureSigning),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeySigningKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
@@ -229,3 +334,83 @@ resource "aws_route53_key_signing_key" "test" {
 }
 `, rName, status))
 }
+
+func testAccKeySigningKeyConfig_nameGenerated(rName, domainName string) string {
+	return acctest.ConfigCompose(testAccKeySigningKeyConfig_base(rName, domainName), `
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+}
+`)
+}
+
+func testAccKeySigningKeyConfig_namePrefix(rName, domainName, namePrefix string) string {
+	return acctest.ConfigCompose(testAccKeySigningKeyConfig_base(rName, domainName), fmt.Sprintf(`
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name_prefix                = %[1]q
+}
+`, namePrefix))
+}
+
+func testAccKeySigningKeyConfig_rotation(rName, domainName string, keyIndex int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  customer_master_key_spec = "ECC_NIST_P256"
+  deletion_window_in_days  = 7
+  key_usage                = "SIGN_VERIFY"
+  policy = jsonencode({
+    Statement = [
+      {
+        Action = [
+          "kms:DescribeKey",
+          "kms:GetPublicKey",
+          "kms:Sign",
+        ],
+        Effect = "Allow"
+        Principal = {
+          Service = "api-service.dnssec.route53.aws.internal"
+        }
+        Sid = "Allow Route 53 DNSSEC Service"
+      },
+      {
+        Action = "kms:*"
+        Effect = "Allow"
+        Principal = {
+          AWS = "*"
+        }
+        Resource = "*"
+        Sid      = "Enable IAM User Permissions"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test[%[3]d].arn
+  name_prefix                = "tf-acc-test-"
+  ds_propagation_delay       = "30s"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  hosted_zone_id = aws_route53_key_signing_key.test.hosted_zone_id
+}
+`, rName, domainName, keyIndex)
+}
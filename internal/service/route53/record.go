@@ -8,10 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
@@ -32,6 +35,13 @@ import (
 
 // @SDKResource("aws_route53_record", name="Record")
 func resourceRecord() *schema.Resource {
+	// A geoproximity location is exactly one of an AWS Region, a Local Zone group or coordinates.
+	geoproximityLocationKeys := []string{
+		"geoproximity_routing_policy.0.aws_region",
+		"geoproximity_routing_policy.0.coordinates",
+		"geoproximity_routing_policy.0.local_zone_group",
+	}
+
 	//lintignore:R011
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRecordCreate,
@@ -187,8 +197,9 @@ func resourceRecord() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"aws_region": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: geoproximityLocationKeys,
 						},
 						"bias": {
 							Type:         schema.TypeInt,
@@ -196,24 +207,29 @@ func resourceRecord() *schema.Resource {
 							ValidateFunc: validation.IntBetween(-99, 99),
 						},
 						"coordinates": {
-							Type: schema.TypeSet,
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"latitude": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validGeoproximityCoordinate(-90, 90),
 									},
 									"longitude": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validGeoproximityCoordinate(-180, 180),
 									},
 								},
 							},
-							Optional: true,
+							ExactlyOneOf: geoproximityLocationKeys,
 						},
 						"local_zone_group": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: geoproximityLocationKeys,
 						},
 					},
 				},
@@ -1145,3 +1161,22 @@ func expandTxtEntry(s string) string {
 func flattenTxtEntry(s string) string {
 	return fmt.Sprintf(`"%s"`, s)
 }
+
+// validGeoproximityCoordinate validates a geoproximity latitude or longitude:
+// a decimal number between minimum and maximum with up to two decimal places.
+func validGeoproximityCoordinate(minimum, maximum float64) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(f) || f < minimum || f > maximum || !regexache.MustCompile(`^[+-]?[0-9]+(\.[0-9]{1,2})?$`).MatchString(value) {
+			errors = append(errors, fmt.Errorf("%s must be between %g and %g with up to two decimal places, got: %s", k, minimum, maximum, value))
+		}
+
+		return
+	}
+}
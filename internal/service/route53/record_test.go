@@ -27,6 +27,24 @@ func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.Route53ServiceID, testAccErrorCheckSkip)
 }
 
+func TestValidGeoproximityCoordinate(t *testing.T) {
+	t.Parallel()
+
+	validate := tfroute53.ValidGeoproximityCoordinate(-90, 90)
+
+	for _, v := range []string{"0", "-90", "90", "+45.5", "49.22", "-89.99"} {
+		if _, errs := validate(v, "latitude"); len(errs) != 0 {
+			t.Errorf("%q should be valid: %q", v, errs)
+		}
+	}
+
+	for _, v := range []string{"", "90.01", "-90.5", "149.22", "45.123", "1e1", "NaN", "Inf", "45.", "abc"} {
+		if _, errs := validate(v, "latitude"); len(errs) == 0 {
+			t.Errorf("%q should be invalid", v)
+		}
+	}
+}
+
 func TestAccRoute53Record_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.ResourceRecordSet
@@ -759,6 +777,17 @@ func TestAccRoute53Record_Geoproximity_basic(t *testing.T) {
 					testAccCheckRecordExists(ctx, "aws_route53_record.awsregion", &record1),
 					testAccCheckRecordExists(ctx, "aws_route53_record.localzonegroup", &record2),
 					testAccCheckRecordExists(ctx, "aws_route53_record.coordinates", &record3),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.0.aws_region", names.USEast1RegionID),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.0.bias", "40"),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.0.coordinates.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "geoproximity_routing_policy.0.local_zone_group", ""),
+					resource.TestCheckResourceAttr("aws_route53_record.localzonegroup", "geoproximity_routing_policy.0.local_zone_group", fmt.Sprintf("%s-atl-1", names.USEast1RegionID)),
+					resource.TestCheckResourceAttr("aws_route53_record.coordinates", "geoproximity_routing_policy.0.coordinates.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs("aws_route53_record.coordinates", "geoproximity_routing_policy.0.coordinates.*", map[string]string{
+						"latitude":  "49.22",
+						"longitude": "-74.01",
+					}),
 				),
 			},
 			{
@@ -771,6 +800,43 @@ func TestAccRoute53Record_Geoproximity_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_Geoproximity_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_geoproximityLocation(`
+    aws_region       = "us-east-1"
+    local_zone_group = "us-east-1-atl-1"
+`),
+				ExpectError: regexache.MustCompile(`only one of .+ can be specified`),
+			},
+			{
+				Config:      testAccRecordConfig_geoproximityLocation(`bias = 10`),
+				ExpectError: regexache.MustCompile(`one of .+ must be specified`),
+			},
+			{
+				Config: testAccRecordConfig_geoproximityLocation(`
+    coordinates {
+      latitude  = "149.22"
+      longitude = "-74.01"
+    }
+`),
+				ExpectError: regexache.MustCompile(`must be between -90 and 90 with up to two decimal places`),
+			},
+			{
+				Config:      testAccRecordConfig_geoproximityWeighted(),
+				ExpectError: regexache.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_HealthCheckID_setIdentifierChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1, record2 awstypes.ResourceRecordSet
@@ -2109,6 +2175,51 @@ resource "aws_route53_record" "denmark" {
 }
 `
 
+func testAccRecordConfig_geoproximityLocation(location string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "main" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "test" {
+  name    = "www"
+  zone_id = aws_route53_zone.main.zone_id
+  type    = "CNAME"
+  ttl     = "5"
+
+  geoproximity_routing_policy {
+    %[1]s
+  }
+  records        = ["dev.domain.test"]
+  set_identifier = "test"
+}
+`, location)
+}
+
+func testAccRecordConfig_geoproximityWeighted() string {
+	return `
+resource "aws_route53_zone" "main" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "test" {
+  name    = "www"
+  zone_id = aws_route53_zone.main.zone_id
+  type    = "CNAME"
+  ttl     = "5"
+
+  geoproximity_routing_policy {
+    aws_region = "us-east-1"
+  }
+  weighted_routing_policy {
+    weight = 10
+  }
+  records        = ["dev.domain.test"]
+  set_identifier = "test"
+}
+`
+}
+
 func testAccRecordConfig_geoproximityCNAME(region string, localzonegroup string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_zone" "main" {
@@ -0,0 +1,702 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of changes sent in a single ChangeResourceRecordSets call.
+	recordsExclusiveChangeBatchMaxSize = 100
+)
+
+// @FrameworkResource
+func newRecordsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recordsExclusiveResource{}
+
+	return r, nil
+}
+
+type recordsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*recordsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_route53_records_exclusive"
+}
+
+func (r *recordsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	routingPolicyValidators := []validator.List{
+		listvalidator.SizeAtMost(1),
+		listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("set_identifier")),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_record_set": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[resourceRecordSetModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"health_check_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"multivalue_answer_routing_policy": schema.BoolAttribute{
+							Optional: true,
+							Validators: []validator.Bool{
+								boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("set_identifier")),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"records": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"set_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+						"ttl": schema.Int64Attribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAlias: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[aliasModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"evaluate_target_health": schema.BoolAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"zone_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cidrRoutingPolicyModel](ctx),
+							Validators: routingPolicyValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"collection_id": schema.StringAttribute{
+										Required: true,
+									},
+									"location_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[failoverRoutingPolicyModel](ctx),
+							Validators: routingPolicyValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetFailover](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[geolocationRoutingPolicyModel](ctx),
+							Validators: routingPolicyValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"continent": schema.StringAttribute{
+										Optional: true,
+									},
+									"country": schema.StringAttribute{
+										Optional: true,
+									},
+									"subdivision": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"geoproximity_routing_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[geoproximityRoutingPolicyModel](ctx),
+							Validators: routingPolicyValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"aws_region": schema.StringAttribute{
+										Optional: true,
+									},
+									"bias": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(-99, 99),
+										},
+									},
+									"local_zone_group": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"coordinates": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[coordinatesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"latitude": schema.StringAttribute{
+													Required: true,
+												},
+												"longitude": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"latency_routing_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[latencyRoutingPolicyModel](ctx),
+							Validators: routingPolicyValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRegion: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetRegion](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"weighted_routing_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[weightedRoutingPolicyModel](ctx),
+							Validators: routingPolicyValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrWeight: schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recordsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncResourceRecordSets(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recordsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ZoneID.ValueString())
+	zoneName, output, err := findExclusiveResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Records Exclusive (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	// Retain the prior representation of resource record sets that are unchanged.
+	prior := make(map[string]resourceRecordSetModel)
+	if !data.ResourceRecordSets.IsNull() && !data.ResourceRecordSets.IsUnknown() {
+		resourceRecordSets, diags := data.ResourceRecordSets.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, resourceRecordSet := range resourceRecordSets {
+			prior[resourceRecordSetKey(resourceRecordSet.expand(ctx, zoneName))] = *resourceRecordSet
+		}
+	}
+
+	resourceRecordSets := make([]resourceRecordSetModel, 0, len(output))
+	for _, apiObject := range output {
+		resourceRecordSet := flattenExclusiveResourceRecordSet(ctx, &apiObject)
+
+		if v, ok := prior[resourceRecordSetKey(&apiObject)]; ok && resourceRecordSetsEquivalent(v.expand(ctx, zoneName), &apiObject) {
+			resourceRecordSet = v
+		}
+
+		resourceRecordSets = append(resourceRecordSets, resourceRecordSet)
+	}
+
+	data.ResourceRecordSets = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, resourceRecordSets)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncResourceRecordSets(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone_id"), request, response)
+}
+
+// syncResourceRecordSets upserts the configured resource record sets that do not exist or differ
+// and deletes any existing resource record sets that are not configured.
+// Deletions are ordered before upserts so that a record can be replaced by one of a conflicting type (e.g. A -> CNAME).
+func (r *recordsExclusiveResource) syncResourceRecordSets(ctx context.Context, data recordsExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ZoneID.ValueString())
+	zoneName, output, err := findExclusiveResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Route 53 Records Exclusive (%s)", zoneID), err.Error())
+
+		return diags
+	}
+
+	have := make(map[string]*awstypes.ResourceRecordSet)
+	for _, apiObject := range output {
+		have[resourceRecordSetKey(&apiObject)] = &apiObject
+	}
+
+	resourceRecordSets, d := data.ResourceRecordSets.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	want := make(map[string]*awstypes.ResourceRecordSet)
+	for _, resourceRecordSet := range resourceRecordSets {
+		apiObject := resourceRecordSet.expand(ctx, zoneName)
+		want[resourceRecordSetKey(apiObject)] = apiObject
+	}
+
+	var deletes, upserts []awstypes.Change
+	for key, apiObject := range have {
+		if _, ok := want[key]; !ok {
+			deletes = append(deletes, awstypes.Change{
+				Action:            awstypes.ChangeActionDelete,
+				ResourceRecordSet: apiObject,
+			})
+		}
+	}
+	for key, apiObject := range want {
+		if v, ok := have[key]; !ok || !resourceRecordSetsEquivalent(apiObject, v) {
+			upserts = append(upserts, awstypes.Change{
+				Action:            awstypes.ChangeActionUpsert,
+				ResourceRecordSet: apiObject,
+			})
+		}
+	}
+
+	// Sort for deterministic batches.
+	sortChanges := func(a, b awstypes.Change) int {
+		return cmp.Compare(resourceRecordSetKey(a.ResourceRecordSet), resourceRecordSetKey(b.ResourceRecordSet))
+	}
+	slices.SortFunc(deletes, sortChanges)
+	slices.SortFunc(upserts, sortChanges)
+
+	for chunk := range slices.Chunk(append(deletes, upserts...), recordsExclusiveChangeBatchMaxSize) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: chunk,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		tflog.Debug(ctx, "Changing Route 53 resource record sets", map[string]any{
+			"zone_id": zoneID,
+			"changes": len(chunk),
+		})
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
+			err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating Route 53 Records Exclusive (%s)", zoneID), err.Error())
+
+			return diags
+		}
+
+		if output.ChangeInfo != nil {
+			if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
+				diags.AddError(fmt.Sprintf("waiting for Route 53 Records Exclusive (%s) synchronize", zoneID), err.Error())
+
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// findExclusiveResourceRecordSetsByZoneID returns the hosted zone's name and all resource record sets
+// that can be managed exclusively, i.e. excluding the zone apex NS and SOA records and records
+// created by traffic policy instances.
+func findExclusiveResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Client, zoneID string) (string, []awstypes.ResourceRecordSet, error) {
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		if normalizeZoneName(v.Name) == normalizeZoneName(zoneName) && (v.Type == awstypes.RRTypeNs || v.Type == awstypes.RRTypeSoa) {
+			return false
+		}
+		return v.TrafficPolicyInstanceId == nil
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return zoneName, output, nil
+}
+
+// resourceRecordSetKey returns the string that uniquely identifies a resource record set within a hosted zone.
+func resourceRecordSetKey(apiObject *awstypes.ResourceRecordSet) string {
+	return strings.Join([]string{
+		normalizeZoneName(cleanRecordName(aws.ToString(apiObject.Name))),
+		string(apiObject.Type),
+		aws.ToString(apiObject.SetIdentifier),
+	}, "_")
+}
+
+// resourceRecordSetsEquivalent returns whether the two resource record sets are the same
+// once the representations returned by the Route 53 API are normalized.
+func resourceRecordSetsEquivalent(a, b *awstypes.ResourceRecordSet) bool {
+	normalize := func(v awstypes.ResourceRecordSet) awstypes.ResourceRecordSet {
+		v.Name = aws.String(normalizeZoneName(cleanRecordName(aws.ToString(v.Name))))
+		if v.AliasTarget != nil {
+			aliasTarget := *v.AliasTarget
+			aliasTarget.DNSName = aws.String(normalizeAliasName(aws.ToString(aliasTarget.DNSName)))
+			v.AliasTarget = &aliasTarget
+		}
+		if len(v.ResourceRecords) == 0 {
+			v.ResourceRecords = nil
+		} else {
+			v.ResourceRecords = slices.SortedFunc(slices.Values(v.ResourceRecords), func(a, b awstypes.ResourceRecord) int {
+				return cmp.Compare(aws.ToString(a.Value), aws.ToString(b.Value))
+			})
+		}
+		return v
+	}
+
+	return reflect.DeepEqual(normalize(*a), normalize(*b))
+}
+
+func flattenExclusiveResourceRecordSet(ctx context.Context, apiObject *awstypes.ResourceRecordSet) resourceRecordSetModel {
+	resourceRecordSet := resourceRecordSetModel{
+		Alias:                         fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []aliasModel{}),
+		CIDRRoutingPolicy:             fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []cidrRoutingPolicyModel{}),
+		FailoverRoutingPolicy:         fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []failoverRoutingPolicyModel{}),
+		GeolocationRoutingPolicy:      fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []geolocationRoutingPolicyModel{}),
+		GeoproximityRoutingPolicy:     fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []geoproximityRoutingPolicyModel{}),
+		HealthCheckID:                 fwflex.StringToFramework(ctx, apiObject.HealthCheckId),
+		LatencyRoutingPolicy:          fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []latencyRoutingPolicyModel{}),
+		MultiValueAnswerRoutingPolicy: types.BoolPointerValue(apiObject.MultiValueAnswer),
+		Name:                          types.StringValue(normalizeZoneName(cleanRecordName(aws.ToString(apiObject.Name)))),
+		Records:                       types.SetNull(types.StringType),
+		SetIdentifier:                 fwflex.StringToFramework(ctx, apiObject.SetIdentifier),
+		TTL:                           fwflex.Int64ToFramework(ctx, apiObject.TTL),
+		Type:                          fwtypes.StringEnumValue(apiObject.Type),
+		WeightedRoutingPolicy:         fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []weightedRoutingPolicyModel{}),
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		resourceRecordSet.Alias = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &aliasModel{
+			EvaluateTargetHealth: types.BoolValue(v.EvaluateTargetHealth),
+			Name:                 types.StringValue(normalizeAliasName(aws.ToString(v.DNSName))),
+			ZoneID:               fwflex.StringToFramework(ctx, v.HostedZoneId),
+		})
+	}
+
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		resourceRecordSet.CIDRRoutingPolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cidrRoutingPolicyModel{
+			CollectionID: fwflex.StringToFramework(ctx, v.CollectionId),
+			LocationName: fwflex.StringToFramework(ctx, v.LocationName),
+		})
+	}
+
+	if v := apiObject.Failover; v != "" {
+		resourceRecordSet.FailoverRoutingPolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &failoverRoutingPolicyModel{
+			Type: fwtypes.StringEnumValue(v),
+		})
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		resourceRecordSet.GeolocationRoutingPolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &geolocationRoutingPolicyModel{
+			Continent:   fwflex.StringToFramework(ctx, v.ContinentCode),
+			Country:     fwflex.StringToFramework(ctx, v.CountryCode),
+			Subdivision: fwflex.StringToFramework(ctx, v.SubdivisionCode),
+		})
+	}
+
+	if v := apiObject.GeoProximityLocation; v != nil {
+		geoproximity := &geoproximityRoutingPolicyModel{
+			AWSRegion:      fwflex.StringToFramework(ctx, v.AWSRegion),
+			Bias:           fwflex.Int32ToFramework(ctx, v.Bias),
+			Coordinates:    fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []coordinatesModel{}),
+			LocalZoneGroup: fwflex.StringToFramework(ctx, v.LocalZoneGroup),
+		}
+
+		if v := v.Coordinates; v != nil {
+			geoproximity.Coordinates = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &coordinatesModel{
+				Latitude:  fwflex.StringToFramework(ctx, v.Latitude),
+				Longitude: fwflex.StringToFramework(ctx, v.Longitude),
+			})
+		}
+
+		resourceRecordSet.GeoproximityRoutingPolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, geoproximity)
+	}
+
+	if v := apiObject.Region; v != "" {
+		resourceRecordSet.LatencyRoutingPolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &latencyRoutingPolicyModel{
+			Region: fwtypes.StringEnumValue(v),
+		})
+	}
+
+	if v := apiObject.ResourceRecords; len(v) > 0 {
+		resourceRecordSet.Records = fwflex.FlattenFrameworkStringValueSet(ctx, flattenResourceRecords(v, apiObject.Type))
+	}
+
+	if v := apiObject.Weight; v != nil {
+		resourceRecordSet.WeightedRoutingPolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &weightedRoutingPolicyModel{
+			Weight: fwflex.Int64ToFramework(ctx, v),
+		})
+	}
+
+	return resourceRecordSet
+}
+
+type recordsExclusiveResourceModel struct {
+	ResourceRecordSets fwtypes.SetNestedObjectValueOf[resourceRecordSetModel] `tfsdk:"resource_record_set"`
+	ZoneID             types.String                                           `tfsdk:"zone_id"`
+}
+
+type resourceRecordSetModel struct {
+	Alias                         fwtypes.ListNestedObjectValueOf[aliasModel]                     `tfsdk:"alias"`
+	CIDRRoutingPolicy             fwtypes.ListNestedObjectValueOf[cidrRoutingPolicyModel]         `tfsdk:"cidr_routing_policy"`
+	FailoverRoutingPolicy         fwtypes.ListNestedObjectValueOf[failoverRoutingPolicyModel]     `tfsdk:"failover_routing_policy"`
+	GeolocationRoutingPolicy      fwtypes.ListNestedObjectValueOf[geolocationRoutingPolicyModel]  `tfsdk:"geolocation_routing_policy"`
+	GeoproximityRoutingPolicy     fwtypes.ListNestedObjectValueOf[geoproximityRoutingPolicyModel] `tfsdk:"geoproximity_routing_policy"`
+	HealthCheckID                 types.String                                                    `tfsdk:"health_check_id"`
+	LatencyRoutingPolicy          fwtypes.ListNestedObjectValueOf[latencyRoutingPolicyModel]      `tfsdk:"latency_routing_policy"`
+	MultiValueAnswerRoutingPolicy types.Bool                                                      `tfsdk:"multivalue_answer_routing_policy"`
+	Name                          types.String                                                    `tfsdk:"name"`
+	Records                       types.Set                                                       `tfsdk:"records"`
+	SetIdentifier                 types.String                                                    `tfsdk:"set_identifier"`
+	TTL                           types.Int64                                                     `tfsdk:"ttl"`
+	Type                          fwtypes.StringEnum[awstypes.RRType]                             `tfsdk:"type"`
+	WeightedRoutingPolicy         fwtypes.ListNestedObjectValueOf[weightedRoutingPolicyModel]     `tfsdk:"weighted_routing_policy"`
+}
+
+// expand returns the API representation of the resource record set, with the record name fully qualified in the specified zone.
+func (m resourceRecordSetModel) expand(ctx context.Context, zoneName string) *awstypes.ResourceRecordSet {
+	rrType := m.Type.ValueEnum()
+	apiObject := &awstypes.ResourceRecordSet{
+		HealthCheckId:    fwflex.StringFromFramework(ctx, m.HealthCheckID),
+		MultiValueAnswer: fwflex.BoolFromFramework(ctx, m.MultiValueAnswerRoutingPolicy),
+		Name:             aws.String(expandRecordName(m.Name.ValueString(), zoneName)),
+		SetIdentifier:    fwflex.StringFromFramework(ctx, m.SetIdentifier),
+		TTL:              fwflex.Int64FromFramework(ctx, m.TTL),
+		Type:             rrType,
+	}
+
+	if !m.Records.IsNull() && !m.Records.IsUnknown() {
+		apiObject.ResourceRecords = expandResourceRecords(fwflex.ExpandFrameworkStringValueSet(ctx, m.Records), rrType)
+	}
+
+	if v, _ := m.Alias.ToPtr(ctx); v != nil {
+		apiObject.AliasTarget = &awstypes.AliasTarget{
+			DNSName:              fwflex.StringFromFramework(ctx, v.Name),
+			EvaluateTargetHealth: v.EvaluateTargetHealth.ValueBool(),
+			HostedZoneId:         fwflex.StringFromFramework(ctx, v.ZoneID),
+		}
+	}
+
+	if v, _ := m.CIDRRoutingPolicy.ToPtr(ctx); v != nil {
+		apiObject.CidrRoutingConfig = &awstypes.CidrRoutingConfig{
+			CollectionId: fwflex.StringFromFramework(ctx, v.CollectionID),
+			LocationName: fwflex.StringFromFramework(ctx, v.LocationName),
+		}
+	}
+
+	if v, _ := m.FailoverRoutingPolicy.ToPtr(ctx); v != nil {
+		apiObject.Failover = v.Type.ValueEnum()
+	}
+
+	if v, _ := m.GeolocationRoutingPolicy.ToPtr(ctx); v != nil {
+		apiObject.GeoLocation = &awstypes.GeoLocation{
+			ContinentCode:   fwflex.StringFromFramework(ctx, v.Continent),
+			CountryCode:     fwflex.StringFromFramework(ctx, v.Country),
+			SubdivisionCode: fwflex.StringFromFramework(ctx, v.Subdivision),
+		}
+	}
+
+	if v, _ := m.GeoproximityRoutingPolicy.ToPtr(ctx); v != nil {
+		apiObject.GeoProximityLocation = &awstypes.GeoProximityLocation{
+			AWSRegion:      fwflex.StringFromFramework(ctx, v.AWSRegion),
+			Bias:           fwflex.Int32FromFramework(ctx, v.Bias),
+			LocalZoneGroup: fwflex.StringFromFramework(ctx, v.LocalZoneGroup),
+		}
+
+		if v, _ := v.Coordinates.ToPtr(ctx); v != nil {
+			apiObject.GeoProximityLocation.Coordinates = &awstypes.Coordinates{
+				Latitude:  fwflex.StringFromFramework(ctx, v.Latitude),
+				Longitude: fwflex.StringFromFramework(ctx, v.Longitude),
+			}
+		}
+	}
+
+	if v, _ := m.LatencyRoutingPolicy.ToPtr(ctx); v != nil {
+		apiObject.Region = v.Region.ValueEnum()
+	}
+
+	if v, _ := m.WeightedRoutingPolicy.ToPtr(ctx); v != nil {
+		apiObject.Weight = fwflex.Int64FromFramework(ctx, v.Weight)
+	}
+
+	return apiObject
+}
+
+type aliasModel struct {
+	EvaluateTargetHealth types.Bool   `tfsdk:"evaluate_target_health"`
+	Name                 types.String `tfsdk:"name"`
+	ZoneID               types.String `tfsdk:"zone_id"`
+}
+
+type cidrRoutingPolicyModel struct {
+	CollectionID types.String `tfsdk:"collection_id"`
+	LocationName types.String `tfsdk:"location_name"`
+}
+
+type failoverRoutingPolicyModel struct {
+	Type fwtypes.StringEnum[awstypes.ResourceRecordSetFailover] `tfsdk:"type"`
+}
+
+type geolocationRoutingPolicyModel struct {
+	Continent   types.String `tfsdk:"continent"`
+	Country     types.String `tfsdk:"country"`
+	Subdivision types.String `tfsdk:"subdivision"`
+}
+
+type geoproximityRoutingPolicyModel struct {
+	AWSRegion      types.String                                      `tfsdk:"aws_region"`
+	Bias           types.Int64                                       `tfsdk:"bias"`
+	Coordinates    fwtypes.ListNestedObjectValueOf[coordinatesModel] `tfsdk:"coordinates"`
+	LocalZoneGroup types.String                                      `tfsdk:"local_zone_group"`
+}
+
+type coordinatesModel struct {
+	Latitude  types.String `tfsdk:"latitude"`
+	Longitude types.String `tfsdk:"longitude"`
+}
+
+type latencyRoutingPolicyModel struct {
+	Region fwtypes.StringEnum[awstypes.ResourceRecordSetRegion] `tfsdk:"region"`
+}
+
+type weightedRoutingPolicyModel struct {
+	Weight types.Int64 `tfsdk:"weight"`
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func TestResourceRecordSetsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b *awstypes.ResourceRecordSet
+		want bool
+	}{
+		"API representation": {
+			a: &awstypes.ResourceRecordSet{
+				Name:            aws.String("www.example.com"),
+				ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.2")}, {Value: aws.String("192.0.2.1")}},
+				TTL:             aws.Int64(300),
+				Type:            awstypes.RRTypeA,
+			},
+			b: &awstypes.ResourceRecordSet{
+				Name:            aws.String("WWW.example.com."),
+				ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}, {Value: aws.String("192.0.2.2")}},
+				TTL:             aws.Int64(300),
+				Type:            awstypes.RRTypeA,
+			},
+			want: true,
+		},
+		"wildcard": {
+			a: &awstypes.ResourceRecordSet{
+				Name:            aws.String("*.example.com"),
+				ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				Type:            awstypes.RRTypeA,
+			},
+			b: &awstypes.ResourceRecordSet{
+				Name:            aws.String(`\052.example.com.`),
+				ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				Type:            awstypes.RRTypeA,
+			},
+			want: true,
+		},
+		"alias": {
+			a: &awstypes.ResourceRecordSet{
+				AliasTarget: &awstypes.AliasTarget{
+					DNSName:      aws.String("Example-LB.us-west-2.elb.amazonaws.com"),
+					HostedZoneId: aws.String("Z1H1FL5HABSF5"),
+				},
+				Name:            aws.String("example.com"),
+				ResourceRecords: []awstypes.ResourceRecord{},
+				Type:            awstypes.RRTypeA,
+			},
+			b: &awstypes.ResourceRecordSet{
+				AliasTarget: &awstypes.AliasTarget{
+					DNSName:      aws.String("example-lb.us-west-2.elb.amazonaws.com."),
+					HostedZoneId: aws.String("Z1H1FL5HABSF5"),
+				},
+				Name: aws.String("example.com."),
+				Type: awstypes.RRTypeA,
+			},
+			want: true,
+		},
+		"different TTL": {
+			a: &awstypes.ResourceRecordSet{
+				Name:            aws.String("www.example.com"),
+				ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				TTL:             aws.Int64(300),
+				Type:            awstypes.RRTypeA,
+			},
+			b: &awstypes.ResourceRecordSet{
+				Name:            aws.String("www.example.com."),
+				ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				TTL:             aws.Int64(60),
+				Type:            awstypes.RRTypeA,
+			},
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := resourceRecordSetsEquivalent(testCase.a, testCase.b), testCase.want; got != want {
+				t.Errorf("resourceRecordSetsEquivalent = %t, want %t", got, want)
+			}
+			if got, want := resourceRecordSetKey(testCase.a) == resourceRecordSetKey(testCase.b), true; got != want {
+				t.Errorf("resourceRecordSetKey equal = %t, want %t", got, want)
+			}
+		})
+	}
+}
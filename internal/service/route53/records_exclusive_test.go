@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: "www." + zoneName.String(),
+						names.AttrType: "A",
+						"ttl":          "300",
+						"records.#":    acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: "alias." + zoneName.String(),
+						names.AttrType: "CNAME",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: zoneName.String(),
+						names.AttrType: "TXT",
+						"records.#":    acctest.Ct1,
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordsExclusiveImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone_id",
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: "www." + zoneName.String(),
+						names.AttrType: "CNAME",
+						"ttl":          "60",
+						"records.#":    acctest.Ct1,
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_empty(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_outOfBandRecord(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 3),
+					testAccCheckRecordsExclusiveUpsertRecord(ctx, resourceName, "oob."+zoneName.String(), "192.0.2.1"),
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 4),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		_, output, err := tfroute53.FindExclusiveResourceRecordSetsByZoneID(ctx, conn, tfroute53.CleanZoneID(rs.Primary.Attributes["zone_id"]))
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Route 53 Hosted Zone (%s) resource record set count = %d, want %d", rs.Primary.Attributes["zone_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExclusiveUpsertRecord(ctx context.Context, n, recordName, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: []awstypes.Change{{
+					Action: awstypes.ChangeActionUpsert,
+					ResourceRecordSet: &awstypes.ResourceRecordSet{
+						Name:            aws.String(recordName),
+						ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(value)}},
+						TTL:             aws.Int64(60),
+						Type:            awstypes.RRTypeA,
+					},
+				}},
+			},
+			HostedZoneId: aws.String(tfroute53.CleanZoneID(rs.Primary.Attributes["zone_id"])),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfroute53.WaitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id))
+
+		return err
+	}
+}
+
+func testAccRecordsExclusiveImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["zone_id"], nil
+	}
+}
+
+func testAccRecordsExclusiveConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), fmt.Sprintf(`
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.10", "192.0.2.11"]
+  }
+
+  resource_record_set {
+    name    = "alias.%[1]s"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.%[1]s"]
+  }
+
+  resource_record_set {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName))
+}
+
+func testAccRecordsExclusiveConfig_updated(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), fmt.Sprintf(`
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = "www.%[1]s"
+    type    = "CNAME"
+    ttl     = 60
+    records = ["example.com"]
+  }
+
+  resource_record_set {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName))
+}
+
+func testAccRecordsExclusiveConfig_empty(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsExclusiveConfig_base(zoneName), `
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+}
+`)
+}
@@ -29,6 +29,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newCIDRLocationResource,
 		},
+		{
+			Factory: newRecordsExclusiveResource,
+		},
 	}
 }
 
@@ -9,12 +9,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -124,10 +126,35 @@ func resourceEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceEndpointCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("protocols") {
+		return nil
+	}
+
+	protocols := flex.ExpandStringyValueSet[awstypes.Protocol](diff.Get("protocols").(*schema.Set))
+
+	if !slices.Contains(protocols, awstypes.ProtocolDohfips) {
+		return nil
+	}
+
+	if direction := diff.Get("direction").(string); direction != string(awstypes.ResolverEndpointDirectionInbound) {
+		return fmt.Errorf(`protocol %q is only supported for %q endpoints`, awstypes.ProtocolDohfips, awstypes.ResolverEndpointDirectionInbound)
+	}
+
+	if slices.Contains(protocols, awstypes.ProtocolDoh) {
+		return fmt.Errorf(`protocols %q and %q cannot be used together`, awstypes.ProtocolDoh, awstypes.ProtocolDohfips)
+	}
+
+	return nil
+}
+
 func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
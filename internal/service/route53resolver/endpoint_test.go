@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -195,6 +196,52 @@ func TestAccRoute53ResolverEndpoint_resolverEndpointType(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverEndpoint_protocols(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ep awstypes.ResolverEndpoint
+	resourceName := "aws_route53_resolver_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEndpointConfig_protocols(rName, "OUTBOUND", `"DoH-FIPS"`),
+				ExpectError: regexache.MustCompile(`only supported for "INBOUND" endpoints`),
+			},
+			{
+				Config:      testAccEndpointConfig_protocols(rName, "INBOUND", `"DoH", "DoH-FIPS"`),
+				ExpectError: regexache.MustCompile(`cannot be used together`),
+			},
+			{
+				Config: testAccEndpointConfig_protocols(rName, "INBOUND", `"Do53", "DoH-FIPS"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName, &ep),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protocols.*", "Do53"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protocols.*", "DoH-FIPS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointConfig_protocols(rName, "INBOUND", `"DoH"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName, &ep),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protocols.*", "DoH"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverClient(ctx)
@@ -419,6 +466,27 @@ resource "aws_route53_resolver_endpoint" "test" {
 `, name))
 }
 
+func testAccEndpointConfig_protocols(rName, direction, protocols string) string {
+	return acctest.ConfigCompose(testAccEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_route53_resolver_endpoint" "test" {
+  direction = %[2]q
+  name      = %[1]q
+
+  security_group_ids = aws_security_group.test[*].id
+
+  ip_address {
+    subnet_id = aws_subnet.test[0].id
+  }
+
+  ip_address {
+    subnet_id = aws_subnet.test[1].id
+  }
+
+  protocols = [%[3]s]
+}
+`, rName, direction, protocols))
+}
+
 func testAccEndpointConfig_resolverEndpointType(rName, resolverEndpointType string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
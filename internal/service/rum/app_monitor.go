@@ -132,6 +132,34 @@ func resourceAppMonitor() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deobfuscation_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"javascript_source_maps": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrStatus: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.DeobfuscationStatus](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrDomain: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -171,6 +199,10 @@ func resourceAppMonitorCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.CustomEvents = expandCustomEvents(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("deobfuscation_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeobfuscationConfiguration = expandDeobfuscationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateAppMonitor(ctx, input)
 
 	if err != nil {
@@ -206,6 +238,14 @@ func resourceAppMonitorRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "setting custom_events: %s", err)
 	}
 
+	if appMon.DeobfuscationConfiguration != nil {
+		if err := d.Set("deobfuscation_configuration", []interface{}{flattenDeobfuscationConfiguration(appMon.DeobfuscationConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deobfuscation_configuration: %s", err)
+		}
+	} else {
+		d.Set("deobfuscation_configuration", nil)
+	}
+
 	d.Set("app_monitor_id", appMon.Id)
 	name := aws.ToString(appMon.Name)
 	arn := arn.ARN{
@@ -243,6 +283,12 @@ func resourceAppMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.CustomEvents = expandCustomEvents(d.Get("custom_events").([]interface{})[0].(map[string]interface{}))
 		}
 
+		if d.HasChange("deobfuscation_configuration") {
+			if v, ok := d.GetOk("deobfuscation_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DeobfuscationConfiguration = expandDeobfuscationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("cw_log_enabled") {
 			input.CwLogEnabled = aws.Bool(d.Get("cw_log_enabled").(bool))
 		}
@@ -421,3 +467,65 @@ func flattenCustomEvents(apiObject *awstypes.CustomEvents) map[string]interface{
 
 	return tfMap
 }
+
+func expandDeobfuscationConfiguration(tfMap map[string]interface{}) *awstypes.DeobfuscationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	config := &awstypes.DeobfuscationConfiguration{}
+
+	if v, ok := tfMap["javascript_source_maps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.JavaScriptSourceMaps = expandJavaScriptSourceMaps(v[0].(map[string]interface{}))
+	}
+
+	return config
+}
+
+func expandJavaScriptSourceMaps(tfMap map[string]interface{}) *awstypes.JavaScriptSourceMaps {
+	if tfMap == nil {
+		return nil
+	}
+
+	config := &awstypes.JavaScriptSourceMaps{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		config.S3Uri = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		config.Status = awstypes.DeobfuscationStatus(v)
+	}
+
+	return config
+}
+
+func flattenDeobfuscationConfiguration(apiObject *awstypes.DeobfuscationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.JavaScriptSourceMaps; v != nil {
+		tfMap["javascript_source_maps"] = []interface{}{flattenJavaScriptSourceMaps(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJavaScriptSourceMaps(apiObject *awstypes.JavaScriptSourceMaps) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrStatus: apiObject.Status,
+	}
+
+	if v := apiObject.S3Uri; v != nil {
+		tfMap["s3_uri"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
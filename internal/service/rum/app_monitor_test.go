@@ -116,6 +116,46 @@ func TestAccRUMAppMonitor_customEvents(t *testing.T) {
 	})
 }
 
+func TestAccRUMAppMonitor_deobfuscationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var appMon awstypes.AppMonitor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rum_app_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RUMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppMonitorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppMonitorConfig_deobfuscationConfigurationEnabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppMonitorExists(ctx, resourceName, &appMon),
+					resource.TestCheckResourceAttr(resourceName, "deobfuscation_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deobfuscation_configuration.0.javascript_source_maps.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deobfuscation_configuration.0.javascript_source_maps.0.status", "ENABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "deobfuscation_configuration.0.javascript_source_maps.0.s3_uri"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppMonitorConfig_deobfuscationConfigurationDisabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppMonitorExists(ctx, resourceName, &appMon),
+					resource.TestCheckResourceAttr(resourceName, "deobfuscation_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deobfuscation_configuration.0.javascript_source_maps.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deobfuscation_configuration.0.javascript_source_maps.0.status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRUMAppMonitor_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var appMon awstypes.AppMonitor
@@ -292,3 +332,44 @@ resource "aws_rum_app_monitor" "test" {
 }
 `, rName, enabled)
 }
+
+func testAccAppMonitorConfig_deobfuscationConfigurationEnabled(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_rum_app_monitor" "test" {
+  name   = %[1]q
+  domain = "localhost"
+
+  deobfuscation_configuration {
+    javascript_source_maps {
+      status = "ENABLED"
+      s3_uri = "s3://${aws_s3_bucket.test.bucket}/source-maps"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAppMonitorConfig_deobfuscationConfigurationDisabled(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_rum_app_monitor" "test" {
+  name   = %[1]q
+  domain = "localhost"
+
+  deobfuscation_configuration {
+    javascript_source_maps {
+      status = "DISABLED"
+    }
+  }
+}
+`, rName)
+}
@@ -41,6 +41,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_rum_metrics_destination",
 			Name:     "Metrics Destination",
 		},
+		{
+			Factory:  resourceResourcePolicy,
+			TypeName: "aws_rum_resource_policy",
+			Name:     "Resource Policy",
+		},
 	}
 }
 
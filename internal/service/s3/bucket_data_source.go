@@ -7,10 +7,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -43,10 +47,114 @@ func dataSourceBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"include_configuration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrPolicy: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrRegion: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRule: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mfa_delete": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"website_domain": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -101,7 +209,11 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[WARN] HostedZoneIDForRegion: %s", err)
 	}
 	d.Set(names.AttrRegion, region)
-	if _, err := findBucketWebsite(ctx, conn, bucket, ""); err == nil {
+
+	// Read the bucket's configuration from the bucket's Region, which may differ from the provider's.
+	optFns = append(optFns, func(o *s3.Options) { o.Region = region })
+
+	if _, err := findBucketWebsite(ctx, conn, bucket, "", optFns...); err == nil {
 		endpoint, domain := bucketWebsiteEndpointAndDomain(bucket, region)
 		d.Set("website_domain", domain)
 		d.Set("website_endpoint", endpoint)
@@ -109,5 +221,153 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[WARN] Reading S3 Bucket (%s) Website: %s", bucket, err)
 	}
 
+	if d.Get("include_configuration").(bool) {
+		diags = append(diags, dataSourceBucketReadConfiguration(ctx, d, conn, bucket, optFns...)...)
+	}
+
 	return diags
 }
+
+func dataSourceBucketReadConfiguration(ctx context.Context, d *schema.ResourceData, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	//
+	// Bucket Lifecycle Configuration.
+	//
+	lifecycleRules, err := findLifecycleRules(ctx, conn, bucket, "", optFns...)
+
+	switch {
+	case err == nil:
+		if err := d.Set("lifecycle_rule", flattenBucketLifecycleRuleSummaries(lifecycleRules)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting lifecycle_rule: %s", err)
+		}
+	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+		d.Set("lifecycle_rule", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) lifecycle configuration: %s", bucket, err)
+	}
+
+	//
+	// Bucket Policy.
+	//
+	policy, err := findBucketPolicy(ctx, conn, bucket, optFns...)
+
+	switch {
+	case err == nil:
+		policy, err := structure.NormalizeJsonString(policy)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		d.Set(names.AttrPolicy, policy)
+	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+		d.Set(names.AttrPolicy, nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) policy: %s", bucket, err)
+	}
+
+	//
+	// Bucket Public Access Block.
+	//
+	publicAccessBlock, err := findPublicAccessBlockConfiguration(ctx, conn, bucket, optFns...)
+
+	switch {
+	case err == nil:
+		if err := d.Set("public_access_block", flattenBucketPublicAccessBlockSummary(publicAccessBlock)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting public_access_block: %s", err)
+		}
+	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+		d.Set("public_access_block", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) public access block: %s", bucket, err)
+	}
+
+	//
+	// Bucket Server-side Encryption Configuration.
+	//
+	encryptionConfiguration, err := findServerSideEncryptionConfiguration(ctx, conn, bucket, "", optFns...)
+
+	switch {
+	case err == nil:
+		if err := d.Set("server_side_encryption_configuration", flattenBucketServerSideEncryptionConfiguration(encryptionConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting server_side_encryption_configuration: %s", err)
+		}
+	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented, errCodeUnsupportedOperation):
+		d.Set("server_side_encryption_configuration", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) server-side encryption configuration: %s", bucket, err)
+	}
+
+	//
+	// Bucket Versioning.
+	//
+	versioning, err := findBucketVersioning(ctx, conn, bucket, "", optFns...)
+
+	switch {
+	case err == nil:
+		if err := d.Set("versioning", flattenBucketVersioningStatus(versioning)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting versioning: %s", err)
+		}
+	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+		d.Set("versioning", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) versioning: %s", bucket, err)
+	}
+
+	return diags
+}
+
+func flattenBucketLifecycleRuleSummaries(rules []types.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, rule := range rules {
+		tfMap := map[string]interface{}{
+			names.AttrID:     aws.ToString(rule.ID),
+			names.AttrStatus: rule.Status,
+		}
+
+		switch filter := rule.Filter; {
+		case filter != nil && filter.And != nil:
+			tfMap[names.AttrPrefix] = aws.ToString(filter.And.Prefix)
+		case filter != nil && filter.Prefix != nil:
+			tfMap[names.AttrPrefix] = aws.ToString(filter.Prefix)
+		case rule.Prefix != nil:
+			tfMap[names.AttrPrefix] = aws.ToString(rule.Prefix)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBucketPublicAccessBlockSummary(apiObject *types.PublicAccessBlockConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"block_public_acls":       aws.ToBool(apiObject.BlockPublicAcls),
+		"block_public_policy":     aws.ToBool(apiObject.BlockPublicPolicy),
+		"ignore_public_acls":      aws.ToBool(apiObject.IgnorePublicAcls),
+		"restrict_public_buckets": aws.ToBool(apiObject.RestrictPublicBuckets),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenBucketVersioningStatus(apiObject *s3.GetBucketVersioningOutput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"mfa_delete":     apiObject.MFADelete,
+		names.AttrStatus: apiObject.Status,
+	}
+
+	if apiObject.Status == "" {
+		tfMap[names.AttrStatus] = bucketVersioningStatusDisabled
+	}
+
+	return []interface{}{tfMap}
+}
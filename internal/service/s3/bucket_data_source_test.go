@@ -107,6 +107,82 @@ func TestAccS3BucketDataSource_accessPointAlias(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketDataSource_includeConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrPolicy, ""),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", "0"),
+				),
+			},
+			{
+				Config: testAccBucketDataSourceConfig_includeConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "include_configuration", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", "expire-logs"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.status", "Enabled"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_policy", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.ignore_public_acls", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.restrict_public_buckets", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.status", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketDataSource_includeConfigurationCrossRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketDataSourceConfig_includeConfigurationCrossRegion(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", "expire-logs"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.status", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
 func testAccBucketDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -191,3 +267,187 @@ data "aws_s3_bucket" "test" {
 }
 `, rName)
 }
+
+func testAccBucketDataSourceConfig_includeConfiguration(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = "expire-logs"
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 30
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "s3:GetObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+
+  depends_on = [aws_s3_bucket_public_access_block.test]
+}
+
+data "aws_s3_bucket" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  include_configuration = true
+
+  depends_on = [
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_server_side_encryption_configuration.test,
+    aws_s3_bucket_versioning.test,
+  ]
+}
+`, rName)
+}
+
+func testAccBucketDataSourceConfig_includeConfigurationCrossRegion(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = "expire-logs"
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 30
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "s3:GetObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+
+  depends_on = [aws_s3_bucket_public_access_block.test]
+}
+
+data "aws_s3_bucket" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  include_configuration = true
+
+  depends_on = [
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_server_side_encryption_configuration.test,
+    aws_s3_bucket_versioning.test,
+  ]
+}
+`, rName))
+}
@@ -252,6 +252,12 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"transition_default_minimum_object_size": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.TransitionDefaultMinimumObjectSize](),
+			},
 		},
 	}
 }
@@ -261,6 +267,9 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
@@ -272,15 +281,14 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 	if expectedBucketOwner != "" {
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		input.TransitionDefaultMinimumObjectSize = types.TransitionDefaultMinimumObjectSize(v.(string))
+	}
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
 	}, errCodeNoSuchBucket)
 
-	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
-		err = errDirectoryBucket(err)
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Lifecycle Configuration: %s", bucket, err)
 	}
@@ -305,18 +313,22 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	const (
 		lifecycleConfigurationExtraRetryDelay    = 5 * time.Second
 		lifecycleConfigurationRulesSteadyTimeout = 2 * time.Minute
 	)
-	var lastOutput, output []types.LifecycleRule
+	var lastOutput, output *s3.GetBucketLifecycleConfigurationOutput
 
 	err = retry.RetryContext(ctx, lifecycleConfigurationRulesSteadyTimeout, func() *retry.RetryError {
 		var err error
 
 		time.Sleep(lifecycleConfigurationExtraRetryDelay)
 
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
 		if d.IsNewResource() && tfresource.NotFound(err) {
 			return retry.RetryableError(err)
@@ -326,7 +338,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 			return retry.NonRetryableError(err)
 		}
 
-		if lastOutput == nil || !lifecycleRulesEqual(lastOutput, output) {
+		if lastOutput == nil || !lifecycleRulesEqual(lastOutput.Rules, output.Rules) {
 			lastOutput = output
 			return retry.RetryableError(fmt.Errorf("S3 Bucket Lifecycle Configuration (%s) has not stablized; retrying", d.Id()))
 		}
@@ -335,7 +347,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -350,9 +362,10 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 
 	d.Set(names.AttrBucket, bucket)
 	d.Set(names.AttrExpectedBucketOwner, expectedBucketOwner)
-	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, output)); err != nil {
+	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, output.Rules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
+	d.Set("transition_default_minimum_object_size", output.TransitionDefaultMinimumObjectSize)
 
 	return diags
 }
@@ -366,6 +379,10 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -376,6 +393,9 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 	if expectedBucketOwner != "" {
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		input.TransitionDefaultMinimumObjectSize = types.TransitionDefaultMinimumObjectSize(v.(string))
+	}
 
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
@@ -403,6 +423,10 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	input := &s3.DeleteBucketLifecycleInput{
 		Bucket: aws.String(bucket),
 	}
@@ -454,7 +478,17 @@ func suppressMissingFilterConfigurationBlock(k, old, new string, d *schema.Resou
 	return false
 }
 
-func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) ([]types.LifecycleRule, error) {
+func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, optFns ...func(*s3.Options)) ([]types.LifecycleRule, error) {
+	output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Rules, nil
+}
+
+func findBucketLifecycleConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
 	input := &s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
@@ -462,7 +496,7 @@ func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBu
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetBucketLifecycleConfiguration(ctx, input)
+	output, err := conn.GetBucketLifecycleConfiguration(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchLifecycleConfiguration) {
 		return nil, &retry.NotFoundError{
@@ -479,7 +513,7 @@ func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBu
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.Rules, nil
+	return output, nil
 }
 
 func lifecycleRulesEqual(rules1, rules2 []types.LifecycleRule) bool {
@@ -579,8 +613,8 @@ func expandLifecycleRules(ctx context.Context, l []interface{}) []types.Lifecycl
 			// apply the Default behavior from v3.x of the provider;
 			// otherwise, set the prefix as specified in Terraform.
 			if v == "" {
-				result.Filter = &types.LifecycleRuleFilterMemberPrefix{
-					Value: v,
+				result.Filter = &types.LifecycleRuleFilter{
+					Prefix: aws.String(v),
 				}
 			} else {
 				result.Prefix = aws.String(v)
@@ -657,12 +691,12 @@ func expandLifecycleExpiration(l []interface{}) *types.LifecycleExpiration {
 	return result
 }
 
-func expandLifecycleRuleFilter(ctx context.Context, l []interface{}) types.LifecycleRuleFilter {
+func expandLifecycleRuleFilter(ctx context.Context, l []interface{}) *types.LifecycleRuleFilter {
 	if len(l) == 0 {
 		return nil
 	}
 
-	var result types.LifecycleRuleFilter
+	var result *types.LifecycleRuleFilter
 
 	if l[0] == nil {
 		return result
@@ -670,84 +704,84 @@ func expandLifecycleRuleFilter(ctx context.Context, l []interface{}) types.Lifec
 
 	m := l[0].(map[string]interface{})
 
+	// Per AWS S3 API, "A Filter must have exactly one of Prefix, Tag, or And specified";
+	// Specifying more than one of the listed parameters results in a MalformedXML error.
+	// In practice, this also includes ObjectSizeGreaterThan and ObjectSizeLessThan.
 	if v, ok := m["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandLifecycleRuleFilterMemberAnd(ctx, v[0].(map[string]interface{}))
+		result = &types.LifecycleRuleFilter{
+			And: expandLifecycleRuleAndOperator(ctx, v[0].(map[string]interface{})),
+		}
 	}
 
 	if v, null, _ := nullable.Int(m["object_size_greater_than"].(string)).ValueInt64(); !null && v >= 0 {
-		result = &types.LifecycleRuleFilterMemberObjectSizeGreaterThan{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			ObjectSizeGreaterThan: aws.Int64(v),
 		}
 	}
 
 	if v, null, _ := nullable.Int(m["object_size_less_than"].(string)).ValueInt64(); !null && v > 0 {
-		result = &types.LifecycleRuleFilterMemberObjectSizeLessThan{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			ObjectSizeLessThan: aws.Int64(v),
 		}
 	}
 
 	if v, ok := m["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandLifecycleRuleFilterMemberTag(v[0].(map[string]interface{}))
+		result = &types.LifecycleRuleFilter{
+			Tag: expandLifecycleRuleFilterTag(v[0].(map[string]interface{})),
+		}
 	}
 
-	// Per AWS S3 API, "A Filter must have exactly one of Prefix, Tag, or And specified";
-	// Specifying more than one of the listed parameters results in a MalformedXML error.
-	// In practice, this also includes ObjectSizeGreaterThan and ObjectSizeLessThan.
 	if v, ok := m[names.AttrPrefix].(string); ok && result == nil {
-		result = &types.LifecycleRuleFilterMemberPrefix{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			Prefix: aws.String(v),
 		}
 	}
 
 	return result
 }
 
-func expandLifecycleRuleFilterMemberAnd(ctx context.Context, m map[string]interface{}) *types.LifecycleRuleFilterMemberAnd {
+func expandLifecycleRuleAndOperator(ctx context.Context, m map[string]interface{}) *types.LifecycleRuleAndOperator {
 	if len(m) == 0 {
 		return nil
 	}
 
-	result := &types.LifecycleRuleFilterMemberAnd{
-		Value: types.LifecycleRuleAndOperator{},
-	}
+	result := &types.LifecycleRuleAndOperator{}
 
 	if v, ok := m["object_size_greater_than"].(int); ok && v > 0 {
-		result.Value.ObjectSizeGreaterThan = aws.Int64(int64(v))
+		result.ObjectSizeGreaterThan = aws.Int64(int64(v))
 	}
 
 	if v, ok := m["object_size_less_than"].(int); ok && v > 0 {
-		result.Value.ObjectSizeLessThan = aws.Int64(int64(v))
+		result.ObjectSizeLessThan = aws.Int64(int64(v))
 	}
 
 	if v, ok := m[names.AttrPrefix].(string); ok {
-		result.Value.Prefix = aws.String(v)
+		result.Prefix = aws.String(v)
 	}
 
 	if v, ok := m[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
 		tags := Tags(tftags.New(ctx, v).IgnoreAWS())
 		if len(tags) > 0 {
-			result.Value.Tags = tags
+			result.Tags = tags
 		}
 	}
 
 	return result
 }
 
-func expandLifecycleRuleFilterMemberTag(m map[string]interface{}) *types.LifecycleRuleFilterMemberTag {
+func expandLifecycleRuleFilterTag(m map[string]interface{}) *types.Tag {
 	if len(m) == 0 {
 		return nil
 	}
 
-	result := &types.LifecycleRuleFilterMemberTag{
-		Value: types.Tag{},
-	}
+	result := &types.Tag{}
 
 	if key, ok := m[names.AttrKey].(string); ok {
-		result.Value.Key = aws.String(key)
+		result.Key = aws.String(key)
 	}
 
 	if value, ok := m[names.AttrValue].(string); ok {
-		result.Value.Value = aws.String(value)
+		result.Value = aws.String(value)
 	}
 
 	return result
@@ -929,24 +963,24 @@ func flattenLifecycleExpiration(expiration *types.LifecycleExpiration) []interfa
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilter(ctx context.Context, filter types.LifecycleRuleFilter) []interface{} {
+func flattenLifecycleRuleFilter(ctx context.Context, filter *types.LifecycleRuleFilter) []interface{} {
 	if filter == nil {
 		return nil
 	}
 
 	m := make(map[string]interface{})
 
-	switch v := filter.(type) {
-	case *types.LifecycleRuleFilterMemberAnd:
-		m["and"] = flattenLifecycleRuleFilterMemberAnd(ctx, v)
-	case *types.LifecycleRuleFilterMemberObjectSizeGreaterThan:
-		m["object_size_greater_than"] = strconv.FormatInt(v.Value, 10)
-	case *types.LifecycleRuleFilterMemberObjectSizeLessThan:
-		m["object_size_less_than"] = strconv.FormatInt(v.Value, 10)
-	case *types.LifecycleRuleFilterMemberPrefix:
-		m[names.AttrPrefix] = v.Value
-	case *types.LifecycleRuleFilterMemberTag:
-		m["tag"] = flattenLifecycleRuleFilterMemberTag(v)
+	switch {
+	case filter.And != nil:
+		m["and"] = flattenLifecycleRuleAndOperator(ctx, filter.And)
+	case filter.ObjectSizeGreaterThan != nil:
+		m["object_size_greater_than"] = strconv.FormatInt(aws.ToInt64(filter.ObjectSizeGreaterThan), 10)
+	case filter.ObjectSizeLessThan != nil:
+		m["object_size_less_than"] = strconv.FormatInt(aws.ToInt64(filter.ObjectSizeLessThan), 10)
+	case filter.Prefix != nil:
+		m[names.AttrPrefix] = aws.ToString(filter.Prefix)
+	case filter.Tag != nil:
+		m["tag"] = flattenLifecycleRuleFilterTag(filter.Tag)
 	default:
 		return nil
 	}
@@ -954,39 +988,39 @@ func flattenLifecycleRuleFilter(ctx context.Context, filter types.LifecycleRuleF
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilterMemberAnd(ctx context.Context, andOp *types.LifecycleRuleFilterMemberAnd) []interface{} {
+func flattenLifecycleRuleAndOperator(ctx context.Context, andOp *types.LifecycleRuleAndOperator) []interface{} {
 	if andOp == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"object_size_greater_than": andOp.Value.ObjectSizeGreaterThan,
-		"object_size_less_than":    andOp.Value.ObjectSizeLessThan,
+		"object_size_greater_than": andOp.ObjectSizeGreaterThan,
+		"object_size_less_than":    andOp.ObjectSizeLessThan,
 	}
 
-	if v := andOp.Value.Prefix; v != nil {
+	if v := andOp.Prefix; v != nil {
 		m[names.AttrPrefix] = aws.ToString(v)
 	}
 
-	if v := andOp.Value.Tags; v != nil {
+	if v := andOp.Tags; v != nil {
 		m[names.AttrTags] = keyValueTags(ctx, v).IgnoreAWS().Map()
 	}
 
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilterMemberTag(op *types.LifecycleRuleFilterMemberTag) []interface{} {
+func flattenLifecycleRuleFilterTag(op *types.Tag) []interface{} {
 	if op == nil {
 		return nil
 	}
 
 	m := make(map[string]interface{})
 
-	if v := op.Value.Key; v != nil {
+	if v := op.Key; v != nil {
 		m[names.AttrKey] = aws.ToString(v)
 	}
 
-	if v := op.Value.Value; v != nil {
+	if v := op.Value; v != nil {
 		m[names.AttrValue] = aws.ToString(v)
 	}
 
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_metadata_table_configuration", name="Bucket Metadata Table Configuration")
+func resourceBucketMetadataTableConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketMetadataTableConfigurationCreate,
+		ReadWithoutTimeout:   resourceBucketMetadataTableConfigurationRead,
+		DeleteWithoutTimeout: resourceBucketMetadataTableConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			names.AttrExpectedBucketOwner: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"metadata_table_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_tables_destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"table_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"table_bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrTableName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"table_namespace": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketMetadataTableConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
+	input := &s3.CreateBucketMetadataTableConfigurationInput{
+		Bucket:                     aws.String(bucket),
+		MetadataTableConfiguration: expandMetadataTableConfiguration(d.Get("metadata_table_configuration").([]interface{})),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateBucketMetadataTableConfiguration(ctx, input)
+	}, errCodeNoSuchBucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Metadata Table Configuration: %s", bucket, err)
+	}
+
+	d.SetId(CreateResourceID(bucket, expectedBucketOwner))
+
+	if _, err := waitBucketMetadataTableConfigurationCreated(ctx, conn, bucket, expectedBucketOwner, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Metadata Table Configuration (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketMetadataTableConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceBucketMetadataTableConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, expectedBucketOwner, err := ParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Metadata Table Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Metadata Table Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, bucket)
+	d.Set(names.AttrExpectedBucketOwner, expectedBucketOwner)
+	if err := d.Set("metadata_table_configuration", flattenMetadataTableConfigurationResult(output.MetadataTableConfigurationResult)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metadata_table_configuration: %s", err)
+	}
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceBucketMetadataTableConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, expectedBucketOwner, err := ParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &s3.DeleteBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Metadata Table Configuration: %s", d.Id())
+	_, err = conn.DeleteBucketMetadataTableConfiguration(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataTableConfigurationNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Metadata Table Configuration (%s): %s", d.Id(), err)
+	}
+
+	// Only the configuration is removed. The metadata table itself is retained in the table bucket.
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Metadata Table Configuration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findBucketMetadataTableConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*types.GetBucketMetadataTableConfigurationResult, error) {
+	input := &s3.GetBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketMetadataTableConfiguration(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataTableConfigurationNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.GetBucketMetadataTableConfigurationResult == nil || output.GetBucketMetadataTableConfigurationResult.MetadataTableConfigurationResult == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.GetBucketMetadataTableConfigurationResult, nil
+}
+
+func statusBucketMetadataTableConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitBucketMetadataTableConfigurationCreated(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, timeout time.Duration) (*types.GetBucketMetadataTableConfigurationResult, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{metadataTableStatusCreating},
+		Target:         []string{metadataTableStatusActive},
+		Refresh:        statusBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.GetBucketMetadataTableConfigurationResult); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	metadataTableStatusActive   = "ACTIVE"
+	metadataTableStatusCreating = "CREATING"
+)
+
+func expandMetadataTableConfiguration(tfList []interface{}) *types.MetadataTableConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.MetadataTableConfiguration{}
+
+	if v, ok := tfMap["s3_tables_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3TablesDestination = &types.S3TablesDestination{
+			TableBucketArn: aws.String(tfMap["table_bucket_arn"].(string)),
+			TableName:      aws.String(tfMap[names.AttrTableName].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMetadataTableConfigurationResult(apiObject *types.MetadataTableConfigurationResult) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3TablesDestinationResult; v != nil {
+		tfMap["s3_tables_destination"] = []interface{}{
+			map[string]interface{}{
+				"table_arn":         aws.ToString(v.TableArn),
+				"table_bucket_arn":  aws.ToString(v.TableBucketArn),
+				names.AttrTableName: aws.ToString(v.TableName),
+				"table_namespace":   aws.ToString(v.TableNamespace),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	s3tablestypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketMetadataTableConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	tableName := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3_bucket_metadata_table_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataTableConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_basic(rName, tableName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3_tables_destination.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_bucket_arn", "aws_s3tables_table_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_name", tableName),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3_tables_destination.0.table_namespace", "aws_s3_metadata"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_base(rName),
+				Check:  testAccDeleteBucketMetadataTable(ctx, "aws_s3tables_table_bucket.test", tableName),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketMetadataTableConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	tableName := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3_bucket_metadata_table_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataTableConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_basic(rName, tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketMetadataTableConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_base(rName),
+				Check:  testAccDeleteBucketMetadataTable(ctx, "aws_s3tables_table_bucket.test", tableName),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketMetadataTableConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_metadata_table_configuration" {
+				continue
+			}
+
+			bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3.FindBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Metadata Table Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketMetadataTableConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3.FindBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+		return err
+	}
+}
+
+// testAccDeleteBucketMetadataTable deletes the metadata table and its namespace, which are created by S3
+// in the table bucket and are not removed with the metadata table configuration, so that the table bucket can be destroyed.
+func testAccDeleteBucketMetadataTable(ctx context.Context, n, tableName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		tableBucketARN := rs.Primary.Attributes[names.AttrARN]
+		const namespace = "aws_s3_metadata"
+
+		_, err := conn.DeleteTable(ctx, &s3tables.DeleteTableInput{
+			Name:           aws.String(tableName),
+			Namespace:      aws.String(namespace),
+			TableBucketARN: aws.String(tableBucketARN),
+		})
+
+		if err != nil && !errs.IsA[*s3tablestypes.NotFoundException](err) {
+			return fmt.Errorf("deleting S3 Tables Table (%s): %w", tableName, err)
+		}
+
+		_, err = conn.DeleteNamespace(ctx, &s3tables.DeleteNamespaceInput{
+			Namespace:      aws.String(namespace),
+			TableBucketARN: aws.String(tableBucketARN),
+		})
+
+		if err != nil && !errs.IsA[*s3tablestypes.NotFoundException](err) {
+			return fmt.Errorf("deleting S3 Tables Namespace (%s): %w", namespace, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketMetadataTableConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketMetadataTableConfigurationConfig_basic(rName, tableName string) string {
+	return acctest.ConfigCompose(testAccBucketMetadataTableConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_metadata_table_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  metadata_table_configuration {
+    s3_tables_destination {
+      table_bucket_arn = aws_s3tables_table_bucket.test.arn
+      table_name       = %[1]q
+    }
+  }
+}
+`, tableName))
+}
@@ -5,9 +5,14 @@ package s3
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -43,8 +49,8 @@ func resourceBucketPolicy() *schema.Resource {
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
-				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				ValidateFunc:          validation.All(validation.StringIsJSON, validBucketPolicy),
+				DiffSuppressFunc:      suppressEquivalentBucketPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
@@ -115,6 +121,10 @@ func resourceBucketPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Policy (%s): %s", d.Id(), err)
 	}
 
+	if existing := d.Get(names.AttrPolicy).(string); bucketPoliciesEquivalent(existing, policy) {
+		policy = existing
+	}
+
 	policy, err = verify.PolicyToSet(d.Get(names.AttrPolicy).(string), policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -157,12 +167,12 @@ func resourceBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
-func findBucketPolicy(ctx context.Context, conn *s3.Client, bucket string) (string, error) {
+func findBucketPolicy(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (string, error) {
 	input := &s3.GetBucketPolicyInput{
 		Bucket: aws.String(bucket),
 	}
 
-	output, err := conn.GetBucketPolicy(ctx, input)
+	output, err := conn.GetBucketPolicy(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchBucketPolicy) {
 		return "", &retry.NotFoundError{
@@ -181,3 +191,170 @@ func findBucketPolicy(ctx context.Context, conn *s3.Client, bucket string) (stri
 
 	return aws.ToString(output.Policy), nil
 }
+
+const (
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/BucketRestrictions.html.
+	bucketPolicyMaxSize = 20 * 1024
+)
+
+// validBucketPolicy validates the size of a bucket policy and the format of the condition keys used in its statements.
+func validBucketPolicy(v interface{}, k string) (ws []string, errors []error) {
+	value, err := structure.NormalizeJsonString(v)
+	if err != nil {
+		// Reported by validation.StringIsJSON.
+		return
+	}
+
+	if n := len(value); n > bucketPolicyMaxSize {
+		errors = append(errors, fmt.Errorf("%q must not exceed %d bytes, got %d bytes", k, bucketPolicyMaxSize, n))
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		return
+	}
+
+	for _, statement := range bucketPolicyStatements(document) {
+		conditions, ok := statement["Condition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, operator := range conditions {
+			keys, ok := operator.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for key := range keys {
+				if !isWellFormedBucketPolicyConditionKey(key) {
+					errors = append(errors, fmt.Errorf("%q contains malformed condition key %q: condition keys must have the form \"<prefix>:<name>\", e.g. \"aws:SecureTransport\"", k, key))
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// isWellFormedBucketPolicyConditionKey reports whether a condition key has the form "<prefix>:<name>".
+// Keys aren't checked against the condition keys supported by S3, which change over time.
+func isWellFormedBucketPolicyConditionKey(key string) bool {
+	prefix, name, ok := strings.Cut(key, ":")
+
+	return ok && prefix != "" && name != ""
+}
+
+// suppressEquivalentBucketPolicyDiffs suppresses differences between equivalent bucket policies.
+// In addition to the equivalences handled by verify.SuppressEquivalentPolicyDiffs, S3 rewrites
+// principals: account IDs become root user ARNs (or vice versa), duplicates are removed and
+// `{"AWS": "*"}` becomes `"*"`.
+func suppressEquivalentBucketPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return bucketPoliciesEquivalent(old, new)
+}
+
+func bucketPoliciesEquivalent(s1, s2 string) bool {
+	if verify.PolicyStringsEquivalent(s1, s2) {
+		return true
+	}
+
+	n1, err := normalizeBucketPolicyPrincipals(s1)
+	if err != nil {
+		return false
+	}
+
+	n2, err := normalizeBucketPolicyPrincipals(s2)
+	if err != nil {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(n1, n2)
+}
+
+// normalizeBucketPolicyPrincipals rewrites the principals of each statement in a bucket policy
+// to a canonical form.
+func normalizeBucketPolicyPrincipals(policy string) (string, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return "", err
+	}
+
+	for _, statement := range bucketPolicyStatements(document) {
+		for _, key := range []string{"Principal", "NotPrincipal"} {
+			if v, ok := statement[key]; ok {
+				statement[key] = normalizeBucketPolicyPrincipal(v)
+			}
+		}
+	}
+
+	b, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func normalizeBucketPolicyPrincipal(principal interface{}) interface{} {
+	switch v := principal.(type) {
+	case string:
+		if v == "*" {
+			return map[string]interface{}{"AWS": []interface{}{"*"}}
+		}
+		return v
+	case map[string]interface{}:
+		for typ, values := range v {
+			var principals []string
+			switch values := values.(type) {
+			case string:
+				principals = []string{values}
+			case []interface{}:
+				for _, value := range values {
+					if value, ok := value.(string); ok {
+						principals = append(principals, value)
+					}
+				}
+			default:
+				continue
+			}
+
+			if typ == "AWS" {
+				principals = tfslices.ApplyToAll(principals, func(v string) string {
+					// arn:PARTITION:iam::ACCOUNTID:root == ACCOUNTID.
+					if parsedARN, err := arn.Parse(v); err == nil && parsedARN.Service == "iam" && parsedARN.Resource == "root" {
+						return parsedARN.AccountID
+					}
+					return v
+				})
+			}
+
+			slices.Sort(principals)
+			principals = slices.Compact(principals)
+			v[typ] = tfslices.ApplyToAll(principals, func(v string) interface{} {
+				return v
+			})
+		}
+		return v
+	default:
+		return principal
+	}
+}
+
+// bucketPolicyStatements returns the statements of a policy document.
+// A single statement may be specified as an object rather than an array.
+func bucketPolicyStatements(document map[string]interface{}) []map[string]interface{} {
+	var statements []map[string]interface{}
+
+	switch v := document["Statement"].(type) {
+	case map[string]interface{}:
+		statements = append(statements, v)
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				statements = append(statements, v)
+			}
+		}
+	}
+
+	return statements
+}
@@ -6,6 +6,7 @@ package s3_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
@@ -19,6 +20,105 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestBucketPoliciesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1  string
+		policy2  string
+		expected bool
+	}{
+		"identical": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: true,
+		},
+		"account ID and root ARN": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::210987654321:root"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","210987654321"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: true,
+		},
+		"duplicate principals": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: true,
+		},
+		"wildcard principal": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: true,
+		},
+		"reordered statements": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"a","Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"},{"Sid":"b","Effect":"Deny","Principal":"*","Action":"s3:DeleteObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"b","Effect":"Deny","Principal":"*","Action":"s3:DeleteObject","Resource":"arn:aws:s3:::example/*"},{"Sid":"a","Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: true,
+		},
+		"different accounts": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: false,
+		},
+		"role and account": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/example"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.BucketPoliciesEquivalent(testCase.policy1, testCase.policy2), testCase.expected; got != want {
+				t.Errorf("BucketPoliciesEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestValidBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy        string
+		expectedError bool
+	}{
+		"valid": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::example/*","Condition":{"Bool":{"aws:SecureTransport":"false"},"StringNotEquals":{"s3:x-amz-server-side-encryption":"aws:kms"}}}]}`,
+		},
+		"single statement": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::example/*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}}`,
+		},
+		"other service condition key": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::example/*","Condition":{"StringEquals":{"ec2:Region":"us-west-2"}}}]}`,
+		},
+		"condition key without name": {
+			policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::example/*","Condition":{"Bool":{"aws:":"false"}}}]}`,
+			expectedError: true,
+		},
+		"condition key without service prefix": {
+			policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::example/*","Condition":{"Bool":{"SecureTransport":"false"}}}]}`,
+			expectedError: true,
+		},
+		"too large": {
+			policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":["arn:aws:s3:::example/` + strings.Repeat("x", 20*1024) + `"]}]}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := tfs3.ValidBucketPolicy(testCase.policy, names.AttrPolicy)
+
+			if got, want := len(errs) > 0, testCase.expectedError; got != want {
+				t.Errorf("ValidBucketPolicy() errors = %v, expected error %t", errs, want)
+			}
+		})
+	}
+}
+
 func TestAccS3BucketPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -188,12 +188,12 @@ func resourceBucketPublicAccessBlockDelete(ctx context.Context, d *schema.Resour
 	return diags
 }
 
-func findPublicAccessBlockConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*types.PublicAccessBlockConfiguration, error) {
+func findPublicAccessBlockConfiguration(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (*types.PublicAccessBlockConfiguration, error) {
 	input := &s3.GetPublicAccessBlockInput{
 		Bucket: aws.String(bucket),
 	}
 
-	output, err := conn.GetPublicAccessBlock(ctx, input)
+	output, err := conn.GetPublicAccessBlock(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchPublicAccessBlockConfiguration) {
 		return nil, &retry.NotFoundError{
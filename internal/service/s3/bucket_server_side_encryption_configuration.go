@@ -87,6 +87,9 @@ func resourceBucketServerSideEncryptionConfigurationCreate(ctx context.Context,
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
 	input := &s3.PutBucketEncryptionInput{
 		Bucket: aws.String(bucket),
@@ -102,10 +105,6 @@ func resourceBucketServerSideEncryptionConfigurationCreate(ctx context.Context,
 		return conn.PutBucketEncryption(ctx, input)
 	}, errCodeNoSuchBucket, errCodeOperationAborted)
 
-	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "ServerSideEncryptionConfiguration is not valid, expected CreateBucketConfiguration") {
-		err = errDirectoryBucket(err)
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Server-side Encryption Configuration: %s", bucket, err)
 	}
@@ -132,6 +131,10 @@ func resourceBucketServerSideEncryptionConfigurationRead(ctx context.Context, d
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	sse, err := findServerSideEncryptionConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -162,6 +165,10 @@ func resourceBucketServerSideEncryptionConfigurationUpdate(ctx context.Context,
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	input := &s3.PutBucketEncryptionInput{
 		Bucket: aws.String(bucket),
 		ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
@@ -192,6 +199,10 @@ func resourceBucketServerSideEncryptionConfigurationDelete(ctx context.Context,
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	input := &s3.DeleteBucketEncryptionInput{
 		Bucket: aws.String(bucket),
 	}
@@ -214,7 +225,7 @@ func resourceBucketServerSideEncryptionConfigurationDelete(ctx context.Context,
 	return diags
 }
 
-func findServerSideEncryptionConfiguration(ctx context.Context, conn *s3.Client, bucketName, expectedBucketOwner string) (*types.ServerSideEncryptionConfiguration, error) {
+func findServerSideEncryptionConfiguration(ctx context.Context, conn *s3.Client, bucketName, expectedBucketOwner string, optFns ...func(*s3.Options)) (*types.ServerSideEncryptionConfiguration, error) {
 	input := &s3.GetBucketEncryptionInput{
 		Bucket: aws.String(bucketName),
 	}
@@ -222,7 +233,7 @@ func findServerSideEncryptionConfiguration(ctx context.Context, conn *s3.Client,
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetBucketEncryption(ctx, input)
+	output, err := conn.GetBucketEncryption(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeServerSideEncryptionConfigurationNotFound) {
 		return nil, &retry.NotFoundError{
@@ -296,7 +296,7 @@ func flattenVersioning(config *s3.GetBucketVersioningOutput) []interface{} {
 	return []interface{}{m}
 }
 
-func findBucketVersioning(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketVersioningOutput, error) {
+func findBucketVersioning(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
 	input := &s3.GetBucketVersioningInput{
 		Bucket: aws.String(bucket),
 	}
@@ -304,7 +304,7 @@ func findBucketVersioning(ctx context.Context, conn *s3.Client, bucket, expected
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetBucketVersioning(ctx, input)
+	output, err := conn.GetBucketVersioning(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		return nil, &retry.NotFoundError{
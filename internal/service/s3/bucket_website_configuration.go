@@ -403,7 +403,7 @@ func resourceBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.Res
 	return diags
 }
 
-func findBucketWebsite(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketWebsiteOutput, error) {
+func findBucketWebsite(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, optFns ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
 	input := &s3.GetBucketWebsiteInput{
 		Bucket: aws.String(bucket),
 	}
@@ -411,7 +411,7 @@ func findBucketWebsite(ctx context.Context, conn *s3.Client, bucket, expectedBuc
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetBucketWebsite(ctx, input)
+	output, err := conn.GetBucketWebsite(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchWebsiteConfiguration) {
 		return nil, &retry.NotFoundError{
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3_directory_bucket", name="Directory Bucket")
+func newDirectoryBucketDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &directoryBucketDataSource{}
+
+	return d, nil
+}
+
+type directoryBucketDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *directoryBucketDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_bucket"
+}
+
+func (d *directoryBucketDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(directoryBucketNameRegex, `must be in the format [bucket_name]--[azid]--x-s3. Use the aws_s3_bucket data source to read general purpose buckets`),
+				},
+			},
+			"data_redundancy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataRedundancy](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrLocation: schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[locationInfoModel](ctx),
+				Computed:   true,
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BucketType](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *directoryBucketDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data directoryBucketDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3ExpressClient(ctx)
+
+	bucket := data.Bucket.ValueString()
+	output, err := findDirectoryBucket(ctx, conn, bucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+
+	data.ARN = types.StringValue(d.RegionalARN("s3express", fmt.Sprintf("bucket/%s", bucket)))
+	// No API to return data redundancy or bucket type.
+	data.DataRedundancy = fwtypes.StringEnumValue(awstypes.DataRedundancySingleAvailabilityZone)
+	data.ID = types.StringValue(bucket)
+	location := &locationInfoModel{
+		Name: flex.StringToFramework(ctx, output.BucketLocationName),
+		Type: fwtypes.StringEnumValue(output.BucketLocationType),
+	}
+	if location.Name.IsNull() {
+		if matches := directoryBucketNameRegex.FindStringSubmatch(bucket); len(matches) == 3 {
+			location.Name = flex.StringValueToFramework(ctx, matches[2])
+			location.Type = fwtypes.StringEnumValue(awstypes.LocationTypeAvailabilityZone)
+		}
+	}
+	data.Location = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, location)
+	data.Region = flex.StringToFramework(ctx, output.BucketRegion)
+	if data.Region.IsNull() {
+		data.Region = types.StringValue(d.Meta().Region)
+	}
+	data.Type = fwtypes.StringEnumValue(awstypes.BucketTypeDirectory)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDirectoryBucket(ctx context.Context, conn *s3.Client, bucket string) (*s3.HeadBucketOutput, error) {
+	input := &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.HeadBucket(ctx, input)
+
+	// For directory buckets that no longer exist it's the CreateSession call invoked by HeadBucket that returns "NoSuchBucket",
+	// and that error code is flattend into HeadBucket's error message -- hence the 'errs.Contains' call.
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) || tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) || errs.Contains(err, errCodeNoSuchBucket) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type directoryBucketDataSourceModel struct {
+	ARN            types.String                                       `tfsdk:"arn"`
+	Bucket         types.String                                       `tfsdk:"bucket"`
+	DataRedundancy fwtypes.StringEnum[awstypes.DataRedundancy]        `tfsdk:"data_redundancy"`
+	ID             types.String                                       `tfsdk:"id"`
+	Location       fwtypes.ListNestedObjectValueOf[locationInfoModel] `tfsdk:"location"`
+	Region         types.String                                       `tfsdk:"region"`
+	Type           fwtypes.StringEnum[awstypes.BucketType]            `tfsdk:"type"`
+}
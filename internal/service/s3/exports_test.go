@@ -13,6 +13,7 @@ var (
 	ResourceBucketInventory                         = resourceBucketInventory
 	ResourceBucketLifecycleConfiguration            = resourceBucketLifecycleConfiguration
 	ResourceBucketLogging                           = resourceBucketLogging
+	ResourceBucketMetadataTableConfiguration        = resourceBucketMetadataTableConfiguration
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
@@ -28,6 +29,7 @@ var (
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
+	BucketPoliciesEquivalent              = bucketPoliciesEquivalent
 	BucketUpdateTags                      = bucketUpdateTags
 	BucketRegionalDomainName              = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
@@ -37,6 +39,7 @@ var (
 	FindBucket                            = findBucket
 	FindBucketACL                         = findBucketACL
 	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
+	FindBucketMetadataTableConfiguration  = findBucketMetadataTableConfiguration
 	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
 	FindBucketPolicy                      = findBucketPolicy
 	FindBucketRequestPayment              = findBucketRequestPayment
@@ -56,10 +59,13 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	ObjectCopyPartSize                    = objectCopyPartSize
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
+	ParseObjectCopySource                 = parseObjectCopySource
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 	ValidBucketName                       = validBucketName
+	ValidBucketPolicy                     = validBucketPolicy
 
 	BucketPropagationTimeout       = bucketPropagationTimeout
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
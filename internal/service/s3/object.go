@@ -21,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,6 +41,11 @@ import (
 	"github.com/mitchellh/go-homedir"
 )
 
+const (
+	// Maximum size of a single part (or of an object uploaded in a single PutObject operation).
+	maxUploadPartSize int64 = 5 * 1024 * 1024 * 1024 // 5 GiB
+)
+
 // @SDKResource("aws_s3_object", name="Object")
 // @Tags(identifierAttribute="arn", resourceType="Object")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/s3;s3.GetObjectOutput")
@@ -105,6 +111,10 @@ func resourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"checksum_crc64nvme": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"checksum_sha1": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -179,6 +189,27 @@ func resourceObject() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				ValidateFunc: validateMetadataIsLowerCase,
 			},
+			"multipart_upload": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      manager.DefaultUploadConcurrency,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"part_size": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          manager.DefaultUploadPartSize,
+							ValidateDiagFunc: validUploadPartSize(),
+						},
+					},
+				},
+			},
 			"object_lock_legal_hold_status": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -294,6 +325,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("cache_control", output.CacheControl)
 	d.Set("checksum_crc32", output.ChecksumCRC32)
 	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_crc64nvme", output.ChecksumCRC64NVME)
 	d.Set("checksum_sha1", output.ChecksumSHA1)
 	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
@@ -588,7 +620,32 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	partSize, concurrency := manager.DefaultUploadPartSize, manager.DefaultUploadConcurrency
+	if v, ok := d.GetOk("multipart_upload"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		partSize = int64(tfMap["part_size"].(int))
+		concurrency = tfMap["concurrency"].(int)
+	}
+
+	// An object's ETag is the MD5 digest of its content only if it was uploaded in a single part.
+	// If etag is configured, upload in a single part so that the ETag can be compared with the configured value.
+	if v := d.GetRawConfig().GetAttr("etag"); v.IsKnown() && !v.IsNull() {
+		size, err := readSeekerSize(body)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s) content size: %s", aws.ToString(input.Key), err)
+		}
+
+		if size > maxUploadPartSize {
+			return sdkdiag.AppendErrorf(diags, "S3 Object (%s) content size (%d bytes) exceeds the maximum size that can be uploaded in a single part (%d bytes); use source_hash instead of etag to detect changes", aws.ToString(input.Key), size, maxUploadPartSize)
+		}
+
+		partSize = max(partSize, size)
+	}
+
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		u.Concurrency = concurrency
+		u.PartSize = partSize
+	})
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -601,6 +658,26 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// readSeekerSize returns the number of bytes remaining in the specified io.ReadSeeker.
+// The current offset is preserved.
+func readSeekerSize(r io.ReadSeeker) (int64, error) {
+	current, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := r.Seek(current, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return end - current, nil
+}
+
 func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
 	// Only set non-default KMS key ID (one that doesn't match default).
 	if sseKMSKeyID != "" {
@@ -785,3 +862,22 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	return data
 }
+
+// validUploadPartSize validates a multipart upload part size.
+// The bounds are compared as int64 as the maximum part size overflows int on 32-bit platforms.
+func validUploadPartSize() schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if size := int64(v.(int)); size < manager.MinUploadPartSize || size > maxUploadPartSize {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid part size",
+				Detail:        fmt.Sprintf("Part size must be between %d and %d bytes, got: %d", manager.MinUploadPartSize, maxUploadPartSize, size),
+				AttributePath: path,
+			})
+		}
+
+		return diags
+	}
+}
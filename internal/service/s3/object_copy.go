@@ -8,13 +8,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/sync/errgroup"
 )
 
 // @SDKResource("aws_s3_object_copy", name="Object Copy")
@@ -80,6 +84,10 @@ func resourceObjectCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"checksum_crc64nvme": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"checksum_sha1": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -369,6 +377,7 @@ func resourceObjectCopyRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("cache_control", output.CacheControl)
 	d.Set("checksum_crc32", output.ChecksumCRC32)
 	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_crc64nvme", output.ChecksumCRC64NVME)
 	d.Set("checksum_sha1", output.ChecksumSHA1)
 	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
@@ -660,6 +669,11 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 
 	output, err := conn.CopyObject(ctx, input, optFns...)
 
+	// CopyObject only supports source objects of up to 5 GB. Larger objects must be copied in parts.
+	if tfawserr.ErrMessageContains(err, errCodeInvalidRequest, "maximum allowable size for a copy source") {
+		output, err = copyObjectMultipart(ctx, meta.(*conns.AWSClient), conn, input, d.Get(names.AttrSource).(string), optFns...)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "copying %s to S3 Bucket (%s) Object (%s): %s", aws.ToString(input.CopySource), aws.ToString(input.Bucket), aws.ToString(input.Key), err)
 	}
@@ -676,6 +690,244 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 	return append(diags, resourceObjectCopyRead(ctx, d, meta)...)
 }
 
+const (
+	objectCopyMultipartConcurrency = 10
+	objectCopyMultipartMaxParts    = 10000
+	objectCopyMultipartPartSize    = 512 * 1024 * 1024 // 512 MiB.
+)
+
+// copyObjectMultipart copies an object of any size using a multipart upload whose parts are copied server-side
+// with UploadPartCopy. The parameters of the copy are taken from the CopyObject input that was rejected.
+func copyObjectMultipart(ctx context.Context, awsClient *conns.AWSClient, conn *s3.Client, input *s3.CopyObjectInput, source string, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+	sourceBucket, sourceKey, err := parseObjectCopySource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceOptFns := slices.Clone(optFns)
+	if !arn.IsARN(sourceBucket) {
+		region, err := findBucketRegion(ctx, awsClient, sourceBucket, optFns...)
+		if err != nil {
+			return nil, fmt.Errorf("reading S3 Bucket (%s) Region: %w", sourceBucket, err)
+		}
+
+		sourceOptFns = append(sourceOptFns, func(o *s3.Options) { o.Region = region })
+	}
+
+	headInput := &s3.HeadObjectInput{
+		Bucket:               aws.String(sourceBucket),
+		ExpectedBucketOwner:  input.ExpectedSourceBucketOwner,
+		IfMatch:              input.CopySourceIfMatch,
+		IfModifiedSince:      input.CopySourceIfModifiedSince,
+		IfNoneMatch:          input.CopySourceIfNoneMatch,
+		IfUnmodifiedSince:    input.CopySourceIfUnmodifiedSince,
+		Key:                  aws.String(sourceKey),
+		RequestPayer:         input.RequestPayer,
+		SSECustomerAlgorithm: input.CopySourceSSECustomerAlgorithm,
+		SSECustomerKey:       input.CopySourceSSECustomerKey,
+		SSECustomerKeyMD5:    input.CopySourceSSECustomerKeyMD5,
+	}
+
+	sourceObject, err := findObject(ctx, conn, headInput, sourceOptFns...)
+	if err != nil {
+		return nil, fmt.Errorf("reading source S3 Object (%s): %w", source, err)
+	}
+
+	createInput := &s3.CreateMultipartUploadInput{
+		ACL:                       input.ACL,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ChecksumAlgorithm:         input.ChecksumAlgorithm,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		ExpectedBucketOwner:       input.ExpectedBucketOwner,
+		Expires:                   input.Expires,
+		GrantFullControl:          input.GrantFullControl,
+		GrantRead:                 input.GrantRead,
+		GrantReadACP:              input.GrantReadACP,
+		GrantWriteACP:             input.GrantWriteACP,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		RequestPayer:              input.RequestPayer,
+		SSECustomerAlgorithm:      input.SSECustomerAlgorithm,
+		SSECustomerKey:            input.SSECustomerKey,
+		SSECustomerKeyMD5:         input.SSECustomerKeyMD5,
+		SSEKMSEncryptionContext:   input.SSEKMSEncryptionContext,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+
+	// Emulate the COPY directives, the defaults, which a multipart upload does not apply.
+	if input.MetadataDirective != types.MetadataDirectiveReplace {
+		createInput.CacheControl = sourceObject.CacheControl
+		createInput.ContentDisposition = sourceObject.ContentDisposition
+		createInput.ContentEncoding = sourceObject.ContentEncoding
+		createInput.ContentLanguage = sourceObject.ContentLanguage
+		createInput.ContentType = sourceObject.ContentType
+		createInput.Expires = nil
+		if v := aws.ToString(sourceObject.ExpiresString); v != "" {
+			if t, err := http.ParseTime(v); err == nil { // formatted in RFC1123
+				createInput.Expires = aws.Time(t)
+			}
+		}
+		createInput.Metadata = sourceObject.Metadata
+		createInput.WebsiteRedirectLocation = sourceObject.WebsiteRedirectLocation
+	}
+
+	if input.TaggingDirective != types.TaggingDirectiveReplace {
+		tags, err := objectListTags(ctx, conn, sourceBucket, sourceKey, sourceOptFns...)
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for source S3 Object (%s): %w", source, err)
+		}
+
+		createInput.Tagging = nil
+		if len(tags) > 0 {
+			createInput.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
+		}
+	}
+
+	createOutput, err := conn.CreateMultipartUpload(ctx, createInput, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("creating multipart upload: %w", err)
+	}
+
+	uploadID := aws.ToString(createOutput.UploadId)
+	abort := func() {
+		// Use a context that isn't cancelled so that an interrupted copy is still cleaned up.
+		_, err := conn.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:              input.Bucket,
+			ExpectedBucketOwner: input.ExpectedBucketOwner,
+			Key:                 input.Key,
+			RequestPayer:        input.RequestPayer,
+			UploadId:            aws.String(uploadID),
+		}, optFns...)
+
+		if err != nil {
+			log.Printf("[WARN] aborting S3 multipart upload (%s): %s", uploadID, err)
+		}
+	}
+
+	size := aws.ToInt64(sourceObject.ContentLength)
+	partSize := objectCopyPartSize(size)
+	parts := make([]types.CompletedPart, (size+partSize-1)/partSize)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(objectCopyMultipartConcurrency)
+	for i := range parts {
+		partNumber := int32(i + 1)
+		first := int64(i) * partSize
+		last := min(first+partSize, size) - 1
+
+		g.Go(func() error {
+			output, err := conn.UploadPartCopy(gCtx, &s3.UploadPartCopyInput{
+				Bucket:                         input.Bucket,
+				CopySource:                     input.CopySource,
+				CopySourceIfMatch:              input.CopySourceIfMatch,
+				CopySourceIfModifiedSince:      input.CopySourceIfModifiedSince,
+				CopySourceIfNoneMatch:          input.CopySourceIfNoneMatch,
+				CopySourceIfUnmodifiedSince:    input.CopySourceIfUnmodifiedSince,
+				CopySourceRange:                aws.String(fmt.Sprintf("bytes=%d-%d", first, last)),
+				CopySourceSSECustomerAlgorithm: input.CopySourceSSECustomerAlgorithm,
+				CopySourceSSECustomerKey:       input.CopySourceSSECustomerKey,
+				CopySourceSSECustomerKeyMD5:    input.CopySourceSSECustomerKeyMD5,
+				ExpectedBucketOwner:            input.ExpectedBucketOwner,
+				ExpectedSourceBucketOwner:      input.ExpectedSourceBucketOwner,
+				Key:                            input.Key,
+				PartNumber:                     aws.Int32(partNumber),
+				RequestPayer:                   input.RequestPayer,
+				SSECustomerAlgorithm:           input.SSECustomerAlgorithm,
+				SSECustomerKey:                 input.SSECustomerKey,
+				SSECustomerKeyMD5:              input.SSECustomerKeyMD5,
+				UploadId:                       aws.String(uploadID),
+			}, optFns...)
+
+			if err != nil {
+				return fmt.Errorf("copying part %d: %w", partNumber, err)
+			}
+
+			part := types.CompletedPart{
+				PartNumber: aws.Int32(partNumber),
+			}
+			if v := output.CopyPartResult; v != nil {
+				part.ChecksumCRC32 = v.ChecksumCRC32
+				part.ChecksumCRC32C = v.ChecksumCRC32C
+				part.ChecksumCRC64NVME = v.ChecksumCRC64NVME
+				part.ChecksumSHA1 = v.ChecksumSHA1
+				part.ChecksumSHA256 = v.ChecksumSHA256
+				part.ETag = v.ETag
+			}
+			parts[partNumber-1] = part
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		abort()
+		return nil, err
+	}
+
+	completeOutput, err := conn.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:              input.Bucket,
+		ExpectedBucketOwner: input.ExpectedBucketOwner,
+		Key:                 input.Key,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		RequestPayer:         input.RequestPayer,
+		SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+		SSECustomerKey:       input.SSECustomerKey,
+		SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+		UploadId:             aws.String(uploadID),
+	}, optFns...)
+
+	if err != nil {
+		abort()
+		return nil, fmt.Errorf("completing multipart upload: %w", err)
+	}
+
+	return &s3.CopyObjectOutput{
+		BucketKeyEnabled:     completeOutput.BucketKeyEnabled,
+		CopySourceVersionId:  sourceObject.VersionId,
+		Expiration:           completeOutput.Expiration,
+		RequestCharged:       completeOutput.RequestCharged,
+		SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+		SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+		SSEKMSKeyId:          completeOutput.SSEKMSKeyId,
+		ServerSideEncryption: completeOutput.ServerSideEncryption,
+		VersionId:            completeOutput.VersionId,
+	}, nil
+}
+
+// objectCopyPartSize returns the part size to use when copying an object of the specified size,
+// growing the default part size as needed to stay within the maximum number of parts.
+func objectCopyPartSize(size int64) int64 {
+	return max(objectCopyMultipartPartSize, (size+objectCopyMultipartMaxParts-1)/objectCopyMultipartMaxParts)
+}
+
+// parseObjectCopySource returns the bucket (or access point ARN) and key of an aws_s3_object_copy source.
+func parseObjectCopySource(source string) (string, string, error) {
+	if arn.IsARN(source) {
+		// arn:aws:s3:<Region>:<account-id>:accesspoint/<access-point-name>/object/<key>.
+		if bucket, key, ok := strings.Cut(source, "/object/"); ok && key != "" {
+			return bucket, key, nil
+		}
+	} else if bucket, key, ok := strings.Cut(strings.TrimPrefix(source, "/"), "/"); ok && bucket != "" && key != "" {
+		return bucket, key, nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for source (%s), expected <bucket>/<key> or <access-point-arn>/object/<key>", source)
+}
+
 type s3Grants struct {
 	FullControl *string
 	Read        *string
@@ -46,6 +46,7 @@ func TestAccS3ObjectCopy_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 					resource.TestCheckResourceAttr(resourceName, "content_disposition", ""),
@@ -266,6 +267,7 @@ func TestAccS3ObjectCopy_checksumAlgorithm(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32C"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", "7y1BJA=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 				),
@@ -277,6 +279,7 @@ func TestAccS3ObjectCopy_checksumAlgorithm(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA1"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", "7MuLDoLjuZB9Uv63Krr4E7U5x30="),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 				),
@@ -398,6 +401,7 @@ func TestAccS3ObjectCopy_directoryBucket(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 					resource.TestCheckResourceAttr(resourceName, "content_disposition", ""),
@@ -469,6 +473,7 @@ func TestAccS3ObjectCopy_basicViaAccessPoint(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 					resource.TestCheckResourceAttr(resourceName, "content_disposition", ""),
@@ -859,3 +864,106 @@ resource "aws_s3_object_copy" "test" {
 }
 `, sourceBucket, sourceKey, targetBucket, targetKey)
 }
+
+func TestParseObjectCopySource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		source      string
+		expectedErr bool
+		bucket      string
+		key         string
+	}{
+		"bucket and key": {
+			source: "source-bucket/path/to/key.json",
+			bucket: "source-bucket",
+			key:    "path/to/key.json",
+		},
+		"leading slash": {
+			source: "/source-bucket/key",
+			bucket: "source-bucket",
+			key:    "key",
+		},
+		"access point ARN": {
+			source: "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point/object/path/to/key.json", //lintignore:AWSAT003,AWSAT005
+			bucket: "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point",                         //lintignore:AWSAT003,AWSAT005
+			key:    "path/to/key.json",
+		},
+		"bucket only": {
+			source:      "source-bucket",
+			expectedErr: true,
+		},
+		"access point ARN without key": {
+			source:      "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point", //lintignore:AWSAT003,AWSAT005
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bucket, key, err := tfs3.ParseObjectCopySource(testCase.source)
+
+			if err == nil && testCase.expectedErr {
+				t.Fatalf("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectedErr {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if bucket != testCase.bucket {
+				t.Errorf("got bucket %q, expected %q", bucket, testCase.bucket)
+			}
+
+			if key != testCase.key {
+				t.Errorf("got key %q, expected %q", key, testCase.key)
+			}
+		})
+	}
+}
+
+func TestObjectCopyPartSize(t *testing.T) {
+	t.Parallel()
+
+	const (
+		mib = int64(1024 * 1024)
+		gib = 1024 * mib
+		tib = 1024 * gib
+	)
+
+	testCases := map[string]struct {
+		size     int64
+		expected int64
+	}{
+		"6 GiB": {
+			size:     6 * gib,
+			expected: 512 * mib,
+		},
+		"1 TiB": {
+			size:     tib,
+			expected: 512 * mib,
+		},
+		"5 TiB": {
+			size:     5 * tib,
+			expected: (5*tib + 9999) / 10000,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfs3.ObjectCopyPartSize(testCase.size)
+
+			if got != testCase.expected {
+				t.Errorf("got %d, expected %d", got, testCase.expected)
+			}
+
+			if parts := (testCase.size + got - 1) / got; parts > 10000 {
+				t.Errorf("got %d parts, expected at most 10000", parts)
+			}
+		})
+	}
+}
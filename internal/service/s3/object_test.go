@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -131,6 +132,7 @@ func TestAccS3Object_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrContent),
@@ -421,6 +423,84 @@ func TestAccS3Object_sourceHashTrigger(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 11 MiB of content is uploaded in 3 parts of 5 MiB (or less).
+	filename := testAccObjectCreateTempFile(t, strings.Repeat("a", 11*1024*1024))
+	defer os.Remove(filename)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, filename, 5*1024*1024, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.concurrency", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.part_size", "5242880"),
+				),
+			},
+			{
+				// Changing the multipart upload settings does not cause the object to be uploaded again.
+				Config: testAccObjectConfig_multipartUpload(rName, filename, 6*1024*1024, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.concurrency", "4"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.part_size", "6291456"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "multipart_upload", names.AttrSource, "source_hash"},
+				ImportStateIdFunc:       testAccObjectImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_MultipartUpload_etag(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	filename := testAccObjectCreateTempFile(t, strings.Repeat("a", 11*1024*1024))
+	defer os.Remove(filename)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Configuring etag forces a single part upload so that the ETag is the MD5 digest of the content.
+				Config: testAccObjectConfig_multipartUploadETag(rName, filename, 5*1024*1024),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`^[0-9a-f]{32}$`)),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_multipartUploadETag(rName, filename, 5*1024*1024),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccS3Object_withContentCharacteristics(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1591,6 +1671,7 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "q/d4Ig=="),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 				),
@@ -1610,10 +1691,24 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
 				),
 			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC64NVME"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC64NVME"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", "easTZmYRIl8="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
 		},
 	})
 }
@@ -1678,6 +1773,7 @@ func TestAccS3Object_directoryBucket(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrContent),
@@ -2310,6 +2406,45 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_multipartUpload(rName string, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = "test-key"
+  source      = %[2]q
+  source_hash = filemd5(%[2]q)
+
+  multipart_upload {
+    part_size   = %[3]d
+    concurrency = %[4]d
+  }
+}
+`, rName, source, partSize, concurrency)
+}
+
+func testAccObjectConfig_multipartUploadETag(rName string, source string, partSize int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+  etag   = filemd5(%[2]q)
+
+  multipart_upload {
+    part_size = %[3]d
+  }
+}
+`, rName, source, partSize)
+}
+
 func testAccObjectConfig_updateable(rName string, bucketVersioning bool, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_3" {
@@ -5,6 +5,8 @@ package s3
 
 import (
 	"context"
+	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -12,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -48,6 +51,15 @@ func dataSourceObjects() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"include_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"key_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -58,6 +70,51 @@ func dataSourceObjects() *schema.Resource {
 				Optional: true,
 				Default:  1000,
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checksum_algorithms": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"checksum_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSize: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrStorageClass: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"owners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -113,11 +170,28 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta int
 		input.FetchOwner = aws.Bool(v.(bool))
 	}
 
+	includeMetadata := d.Get("include_metadata").(bool)
+	if includeMetadata {
+		input.FetchOwner = aws.Bool(true)
+	}
+
+	// Keys are filtered by regular expression client-side, after any server-side prefix filtering.
+	var keyRegex *regexp.Regexp
+	if v, ok := d.GetOk("key_regex"); ok {
+		re, err := regexp.Compile(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "compiling key_regex: %s", err)
+		}
+		keyRegex = re
+	}
+
 	// "input.MaxKeys" refers to max keys returned in a single request
 	// (i.e. page size), not the total number of keys returned if you page
 	// through the results. "max_keys" does refer to total keys returned.
+	// When filtering by regular expression, the page size cannot be limited
+	// as keys may not match.
 	maxKeys := int64(d.Get("max_keys").(int))
-	if maxKeys <= keyRequestPageSize {
+	if maxKeys <= keyRequestPageSize && keyRegex == nil {
 		input.MaxKeys = aws.Int32(int32(maxKeys))
 	}
 
@@ -135,6 +209,7 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	var nKeys int64
 	var commonPrefixes, keys, owners []string
+	var objects []interface{}
 	var requestCharged string
 
 	pages := s3.NewListObjectsV2Paginator(conn, input)
@@ -157,7 +232,16 @@ pageLoop:
 				break pageLoop
 			}
 
-			keys = append(keys, aws.ToString(v.Key))
+			key := aws.ToString(v.Key)
+			if keyRegex != nil && !keyRegex.MatchString(key) {
+				continue
+			}
+
+			keys = append(keys, key)
+
+			if includeMetadata {
+				objects = append(objects, flattenObjectSummary(v))
+			}
 
 			if v := v.Owner; v != nil {
 				owners = append(owners, aws.ToString(v.ID))
@@ -170,8 +254,33 @@ pageLoop:
 	d.SetId(bucket)
 	d.Set("common_prefixes", commonPrefixes)
 	d.Set("keys", keys)
+	if err := d.Set("objects", objects); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting objects: %s", err)
+	}
 	d.Set("owners", owners)
 	d.Set("request_charged", requestCharged)
 
 	return diags
 }
+
+func flattenObjectSummary(apiObject types.Object) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"checksum_algorithms":  enum.Slice(apiObject.ChecksumAlgorithm...),
+		"checksum_type":        string(apiObject.ChecksumType),
+		"etag":                 aws.ToString(apiObject.ETag),
+		names.AttrKey:          aws.ToString(apiObject.Key),
+		names.AttrSize:         aws.ToInt64(apiObject.Size),
+		names.AttrStorageClass: string(apiObject.StorageClass),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = v.Format(time.RFC3339)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner_display_name"] = aws.ToString(v.DisplayName)
+		tfMap["owner_id"] = aws.ToString(v.ID)
+	}
+
+	return tfMap
+}
@@ -220,6 +220,69 @@ func TestAccS3ObjectsDataSource_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectsDataSource_keyRegex(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsDataSourceConfig_keyRegex(rName, 3, `/sub2/[12]$`, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "prefix1/sub2/1"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "prefix1/sub2/2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccObjectsDataSourceConfig_keyRegex(rName, 3, `/sub2/[12]$`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "prefix1/sub2/1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsDataSource_includeMetadata(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsDataSourceConfig_includeMetadata(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "owners.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "prefix1/sub1/0"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "26"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.owner_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.2.key", "prefix3/checksum"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.2.checksum_algorithms.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.2.checksum_algorithms.0", "SHA256"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectsDataSource_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -382,6 +445,39 @@ data "aws_s3_objects" "test" {
 `)
 }
 
+func testAccObjectsDataSourceConfig_keyRegex(rName string, n int, keyRegex string, maxKeys int) string {
+	return acctest.ConfigCompose(testAccObjectsDataSourceConfig_base(rName, n), fmt.Sprintf(`
+data "aws_s3_objects" "test" {
+  bucket    = aws_s3_bucket.test.id
+  prefix    = "prefix1/"
+  key_regex = %[1]q
+  max_keys  = %[2]d
+
+  depends_on = [aws_s3_object.test1, aws_s3_object.test2, aws_s3_object.test3]
+}
+`, keyRegex, maxKeys))
+}
+
+func testAccObjectsDataSourceConfig_includeMetadata(rName string, n int) string {
+	return acctest.ConfigCompose(testAccObjectsDataSourceConfig_base(rName, n), `
+resource "aws_s3_object" "test4" {
+  bucket             = aws_s3_bucket.test.id
+  key                = "prefix3/checksum"
+  content            = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+  checksum_algorithm = "SHA256"
+}
+
+data "aws_s3_objects" "test" {
+  bucket           = aws_s3_bucket.test.id
+  prefix           = "prefix"
+  key_regex        = "^prefix(1/sub1|2|3)/"
+  include_metadata = true
+
+  depends_on = [aws_s3_object.test1, aws_s3_object.test2, aws_s3_object.test3, aws_s3_object.test4]
+}
+`)
+}
+
 func testAccObjectsDataSourceConfig_directoryBucket(rName string, n int) string {
 	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), fmt.Sprintf(`
 resource "aws_s3_directory_bucket" "test" {
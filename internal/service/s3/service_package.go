@@ -34,6 +34,12 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 			}
 			o.UsePathStyle = config["s3_use_path_style"].(bool)
 
+			// Maintain the pre-v1.73.0 AWS SDK for Go v2 S3 behavior of only calculating and validating
+			// checksums when an operation requires it or a checksum algorithm is configured explicitly.
+			// Default integrity protections break many S3-compatible endpoints.
+			o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+			o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+
 			o.Retryer = conns.AddIsErrorRetryables(cfg.Retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
 				if tfawserr.ErrMessageContains(err, errCodeOperationAborted, "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
 					return aws.TrueTernary
@@ -14,6 +14,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDirectoryBucketDataSource,
+			Name:    "Directory Bucket",
+		},
 		{
 			Factory: newDirectoryBucketsDataSource,
 			Name:    "Directory Buckets",
@@ -129,6 +133,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_logging",
 			Name:     "Bucket Logging",
 		},
+		{
+			Factory:  resourceBucketMetadataTableConfiguration,
+			TypeName: "aws_s3_bucket_metadata_table_configuration",
+			Name:     "Bucket Metadata Table Configuration",
+		},
 		{
 			Factory:  resourceBucketMetric,
 			TypeName: "aws_s3_bucket_metric",
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -118,7 +119,7 @@ func (r *accessGrantsInstanceResource) Create(ctx context.Context, request resou
 	// Set values for unknowns.
 	data.AccessGrantsInstanceARN = flex.StringToFramework(ctx, output.AccessGrantsInstanceArn)
 	data.AccessGrantsInstanceID = flex.StringToFramework(ctx, output.AccessGrantsInstanceId)
-	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
+	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterApplicationArn)
 	data.setID()
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -159,7 +160,8 @@ func (r *accessGrantsInstanceResource) Read(ctx context.Context, request resourc
 	// Set attributes for import.
 	data.AccessGrantsInstanceARN = flex.StringToFramework(ctx, output.AccessGrantsInstanceArn)
 	data.AccessGrantsInstanceID = flex.StringToFramework(ctx, output.AccessGrantsInstanceId)
-	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
+	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterApplicationArn)
+	data.IdentityCenterARN = flex.StringToFrameworkARN(ctx, output.IdentityCenterInstanceArn)
 
 	tags, err := listTags(ctx, conn, data.AccessGrantsInstanceARN.ValueString(), data.AccountID.ValueString())
 
@@ -207,6 +209,16 @@ func (r *accessGrantsInstanceResource) Update(ctx context.Context, request resou
 				return
 			}
 		}
+
+		output, err := findAccessGrantsInstance(ctx, conn, new.AccountID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterApplicationArn)
 	}
 
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
@@ -256,6 +268,27 @@ func (r *accessGrantsInstanceResource) Delete(ctx context.Context, request resou
 
 func (r *accessGrantsInstanceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state accessGrantsInstanceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Associating or dissociating an IAM Identity Center instance changes the Identity Center application.
+	if !plan.IdentityCenterARN.Equal(state.IdentityCenterARN) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("identity_center_application_arn"), types.StringUnknown())...)
+	}
 }
 
 func associateAccessGrantsInstanceIdentityCenterInstance(ctx context.Context, conn *s3control.Client, accountID, identityCenterARN string) error {
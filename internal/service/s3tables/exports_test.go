@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+// Exports for use in tests only.
+var (
+	FindNamespaceByTwoPartKey     = findNamespaceByTwoPartKey
+	FindTableBucketByARN          = findTableBucketByARN
+	FindTableBucketPolicyByARN    = findTableBucketPolicyByARN
+	FindTableByThreePartKey       = findTableByThreePartKey
+	FindTablePolicyByThreePartKey = findTablePolicyByThreePartKey
+)
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3tables_namespace", name="Namespace")
+func newNamespaceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &namespaceResource{}, nil
+}
+
+type namespaceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (*namespaceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3tables_namespace"
+}
+
+func (r *namespaceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: namespaceNameValidators,
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *namespaceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data namespaceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	tableBucketARN, namespace := data.TableBucketARN.ValueString(), data.Namespace.ValueString()
+	input := &s3tables.CreateNamespaceInput{
+		Namespace:      []string{namespace},
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	_, err := conn.CreateNamespace(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Tables Namespace (%s)", namespace), err.Error())
+
+		return
+	}
+
+	output, err := findNamespaceByTwoPartKey(ctx, conn, tableBucketARN, namespace)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Namespace (%s)", namespace), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Namespace"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *namespaceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data namespaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	tableBucketARN, namespace := data.TableBucketARN.ValueString(), data.Namespace.ValueString()
+	output, err := findNamespaceByTwoPartKey(ctx, conn, tableBucketARN, namespace)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Namespace (%s)", namespace), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Namespace"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *namespaceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data namespaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	namespace := data.Namespace.ValueString()
+	_, err := conn.DeleteNamespace(ctx, &s3tables.DeleteNamespaceInput{
+		Namespace:      aws.String(namespace),
+		TableBucketARN: fwflex.StringFromFramework(ctx, data.TableBucketARN),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Tables Namespace (%s)", namespace), err.Error())
+
+		return
+	}
+}
+
+func (r *namespaceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.Split(request.ID, namespaceIDSeparator)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		response.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("unexpected format for import ID (%s), expected table-bucket-arn%[2]snamespace", request.ID, namespaceIDSeparator))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("table_bucket_arn"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrNamespace), parts[1])...)
+}
+
+const (
+	namespaceIDSeparator = ";"
+)
+
+var namespaceNameValidators = []validator.String{
+	stringvalidator.LengthBetween(1, 255),
+	stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z_]*[0-9a-z]$|^[0-9a-z]$`), "must contain only lowercase letters, numbers, and underscores, and must begin and end with a letter or number"),
+}
+
+func findNamespaceByTwoPartKey(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace string) (*s3tables.GetNamespaceOutput, error) {
+	input := &s3tables.GetNamespaceInput{
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	output, err := conn.GetNamespace(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type namespaceResourceModel struct {
+	CreatedAt      timetypes.RFC3339 `tfsdk:"created_at"`
+	CreatedBy      types.String      `tfsdk:"created_by"`
+	Namespace      types.String      `tfsdk:"namespace"`
+	OwnerAccountID types.String      `tfsdk:"owner_account_id"`
+	TableBucketARN fwtypes.ARN       `tfsdk:"table_bucket_arn"`
+}
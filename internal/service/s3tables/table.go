@@ -0,0 +1,625 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3tables_table", name="Table")
+func newTableResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &tableResource{}, nil
+}
+
+type tableResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*tableResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3tables_table"
+}
+
+func (r *tableResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OpenTableFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"maintenance_configuration": schema.SingleNestedAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[tableMaintenanceConfigurationModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"iceberg_compaction": schema.SingleNestedAttribute{
+						CustomType: fwtypes.NewObjectTypeOf[icebergCompactionModel](ctx),
+						Required:   true,
+						Attributes: map[string]schema.Attribute{
+							"settings": schema.SingleNestedAttribute{
+								CustomType: fwtypes.NewObjectTypeOf[icebergCompactionSettingsModel](ctx),
+								Required:   true,
+								Attributes: map[string]schema.Attribute{
+									"target_file_size_mb": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(64, 512),
+										},
+									},
+								},
+							},
+							names.AttrStatus: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+								Required:   true,
+							},
+						},
+					},
+					"iceberg_snapshot_management": schema.SingleNestedAttribute{
+						CustomType: fwtypes.NewObjectTypeOf[icebergSnapshotManagementModel](ctx),
+						Required:   true,
+						Attributes: map[string]schema.Attribute{
+							"settings": schema.SingleNestedAttribute{
+								CustomType: fwtypes.NewObjectTypeOf[icebergSnapshotManagementSettingsModel](ctx),
+								Required:   true,
+								Attributes: map[string]schema.Attribute{
+									"max_snapshot_age_hours": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"min_snapshots_to_keep": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+							names.AttrStatus: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+			"metadata_location": schema.StringAttribute{
+				Computed: true,
+			},
+			"modified_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"modified_by": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required:   true,
+				Validators: namespaceNameValidators,
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				Required:   true,
+				Validators: namespaceNameValidators,
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TableType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_token": schema.StringAttribute{
+				Computed: true,
+			},
+			"warehouse_location": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *tableResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	tableBucketARN, namespace, name := data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString()
+	input := &s3tables.CreateTableInput{
+		Format:         data.Format.ValueEnum(),
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	_, err := conn.CreateTable(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Tables Table (%s)", name), err.Error())
+
+		return
+	}
+
+	if !data.MaintenanceConfiguration.IsNull() && !data.MaintenanceConfiguration.IsUnknown() {
+		response.Diagnostics.Append(putTableMaintenanceConfiguration(ctx, conn, tableBucketARN, namespace, name, data.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			// Set the identifying attributes so as to taint the resource.
+			response.State.SetAttribute(ctx, path.Root("table_bucket_arn"), tableBucketARN)
+			response.State.SetAttribute(ctx, path.Root(names.AttrNamespace), namespace)
+			response.State.SetAttribute(ctx, path.Root(names.AttrName), name)
+			return
+		}
+	}
+
+	output, err := findTableByThreePartKey(ctx, conn, tableBucketARN, namespace, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, conn, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *tableResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findTableByThreePartKey(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, conn, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	tableBucketARN := new.TableBucketARN.ValueString()
+
+	if !new.Name.Equal(old.Name) || !new.Namespace.Equal(old.Namespace) {
+		input := &s3tables.RenameTableInput{
+			Name:           fwflex.StringFromFramework(ctx, old.Name),
+			Namespace:      fwflex.StringFromFramework(ctx, old.Namespace),
+			TableBucketARN: aws.String(tableBucketARN),
+			VersionToken:   fwflex.StringFromFramework(ctx, old.VersionToken),
+		}
+		if !new.Name.Equal(old.Name) {
+			input.NewName = fwflex.StringFromFramework(ctx, new.Name)
+		}
+		if !new.Namespace.Equal(old.Namespace) {
+			input.NewNamespaceName = fwflex.StringFromFramework(ctx, new.Namespace)
+		}
+
+		_, err := conn.RenameTable(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("renaming S3 Tables Table (%s)", old.Name.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.MaintenanceConfiguration.IsUnknown() && !new.MaintenanceConfiguration.Equal(old.MaintenanceConfiguration) {
+		response.Diagnostics.Append(putTableMaintenanceConfiguration(ctx, conn, tableBucketARN, new.Namespace.ValueString(), new.Name.ValueString(), new.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	name := new.Name.ValueString()
+	output, err := findTableByThreePartKey(ctx, conn, tableBucketARN, new.Namespace.ValueString(), name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, conn, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	name := data.Name.ValueString()
+	_, err := conn.DeleteTable(ctx, &s3tables.DeleteTableInput{
+		Name:           aws.String(name),
+		Namespace:      fwflex.StringFromFramework(ctx, data.Namespace),
+		TableBucketARN: fwflex.StringFromFramework(ctx, data.TableBucketARN),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Tables Table (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *tableResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.Split(request.ID, tableIDSeparator)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		response.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("unexpected format for import ID (%s), expected table-bucket-arn%[2]snamespace%[2]sname", request.ID, tableIDSeparator))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("table_bucket_arn"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrNamespace), parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrName), parts[2])...)
+}
+
+// flatten sets the table's attributes and its maintenance configuration in the model.
+func (r *tableResource) flatten(ctx context.Context, conn *s3tables.Client, output *s3tables.GetTableOutput, data *tableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data, fwflex.WithIgnoredFieldNamesAppend("Namespace"))...)
+	if diags.HasError() {
+		return diags
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.TableARN)
+	if len(output.Namespace) > 0 {
+		data.Namespace = types.StringValue(output.Namespace[0])
+	}
+
+	tableBucketARN, namespace, name := data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString()
+	configuration, err := findTableMaintenanceConfigurationByThreePartKey(ctx, conn, tableBucketARN, namespace, name)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading S3 Tables Table (%s) maintenance configuration", name), err.Error())
+
+		return diags
+	}
+
+	maintenanceConfiguration, d := flattenTableMaintenanceConfiguration(ctx, configuration)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	data.MaintenanceConfiguration = maintenanceConfiguration
+
+	return diags
+}
+
+const (
+	tableIDSeparator = ";"
+)
+
+func findTableByThreePartKey(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string) (*s3tables.GetTableOutput, error) {
+	input := &s3tables.GetTableInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	output, err := conn.GetTable(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findTableMaintenanceConfigurationByThreePartKey(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string) (map[string]awstypes.TableMaintenanceConfigurationValue, error) {
+	input := &s3tables.GetTableMaintenanceConfigurationInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	output, err := conn.GetTableMaintenanceConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Configuration, nil
+}
+
+func putTableMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string, v fwtypes.ObjectValueOf[tableMaintenanceConfigurationModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	maintenanceConfiguration, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || maintenanceConfiguration == nil {
+		return diags
+	}
+
+	if compaction, d := maintenanceConfiguration.IcebergCompaction.ToPtr(ctx); d.HasError() {
+		diags.Append(d...)
+		return diags
+	} else if compaction != nil {
+		settings, d := compaction.Settings.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		value := &awstypes.TableMaintenanceConfigurationValue{
+			Status: compaction.Status.ValueEnum(),
+		}
+		if settings != nil {
+			value.Settings = &awstypes.TableMaintenanceSettingsMemberIcebergCompaction{
+				Value: awstypes.IcebergCompactionSettings{
+					TargetFileSizeMB: fwflex.Int32FromFramework(ctx, settings.TargetFileSizeMB),
+				},
+			}
+		}
+
+		diags.Append(putTableMaintenanceConfigurationValue(ctx, conn, tableBucketARN, namespace, name, awstypes.TableMaintenanceTypeIcebergCompaction, value)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if snapshotManagement, d := maintenanceConfiguration.IcebergSnapshotManagement.ToPtr(ctx); d.HasError() {
+		diags.Append(d...)
+		return diags
+	} else if snapshotManagement != nil {
+		settings, d := snapshotManagement.Settings.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		value := &awstypes.TableMaintenanceConfigurationValue{
+			Status: snapshotManagement.Status.ValueEnum(),
+		}
+		if settings != nil {
+			value.Settings = &awstypes.TableMaintenanceSettingsMemberIcebergSnapshotManagement{
+				Value: awstypes.IcebergSnapshotManagementSettings{
+					MaxSnapshotAgeHours: fwflex.Int32FromFramework(ctx, settings.MaxSnapshotAgeHours),
+					MinSnapshotsToKeep:  fwflex.Int32FromFramework(ctx, settings.MinSnapshotsToKeep),
+				},
+			}
+		}
+
+		diags.Append(putTableMaintenanceConfigurationValue(ctx, conn, tableBucketARN, namespace, name, awstypes.TableMaintenanceTypeIcebergSnapshotManagement, value)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func putTableMaintenanceConfigurationValue(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string, typ awstypes.TableMaintenanceType, value *awstypes.TableMaintenanceConfigurationValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &s3tables.PutTableMaintenanceConfigurationInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+		Type:           typ,
+		Value:          value,
+	}
+
+	_, err := conn.PutTableMaintenanceConfiguration(ctx, input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("putting S3 Tables Table (%s) maintenance configuration (%s)", name, typ), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func flattenTableMaintenanceConfiguration(ctx context.Context, configuration map[string]awstypes.TableMaintenanceConfigurationValue) (fwtypes.ObjectValueOf[tableMaintenanceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	compaction, okCompaction := configuration[string(awstypes.TableMaintenanceTypeIcebergCompaction)]
+	snapshotManagement, okSnapshotManagement := configuration[string(awstypes.TableMaintenanceTypeIcebergSnapshotManagement)]
+	if !okCompaction || !okSnapshotManagement {
+		return fwtypes.NewObjectValueOfNull[tableMaintenanceConfigurationModel](ctx), diags
+	}
+
+	compactionSettings := fwtypes.NewObjectValueOfNull[icebergCompactionSettingsModel](ctx)
+	if v, ok := compaction.Settings.(*awstypes.TableMaintenanceSettingsMemberIcebergCompaction); ok {
+		compactionSettings = fwtypes.NewObjectValueOfMust(ctx, &icebergCompactionSettingsModel{
+			TargetFileSizeMB: fwflex.Int32ToFramework(ctx, v.Value.TargetFileSizeMB),
+		})
+	}
+
+	snapshotManagementSettings := fwtypes.NewObjectValueOfNull[icebergSnapshotManagementSettingsModel](ctx)
+	if v, ok := snapshotManagement.Settings.(*awstypes.TableMaintenanceSettingsMemberIcebergSnapshotManagement); ok {
+		snapshotManagementSettings = fwtypes.NewObjectValueOfMust(ctx, &icebergSnapshotManagementSettingsModel{
+			MaxSnapshotAgeHours: fwflex.Int32ToFramework(ctx, v.Value.MaxSnapshotAgeHours),
+			MinSnapshotsToKeep:  fwflex.Int32ToFramework(ctx, v.Value.MinSnapshotsToKeep),
+		})
+	}
+
+	return fwtypes.NewObjectValueOf(ctx, &tableMaintenanceConfigurationModel{
+		IcebergCompaction: fwtypes.NewObjectValueOfMust(ctx, &icebergCompactionModel{
+			Settings: compactionSettings,
+			Status:   fwtypes.StringEnumValue(compaction.Status),
+		}),
+		IcebergSnapshotManagement: fwtypes.NewObjectValueOfMust(ctx, &icebergSnapshotManagementModel{
+			Settings: snapshotManagementSettings,
+			Status:   fwtypes.StringEnumValue(snapshotManagement.Status),
+		}),
+	})
+}
+
+type tableResourceModel struct {
+	ARN                      types.String                                              `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                         `tfsdk:"created_at"`
+	CreatedBy                types.String                                              `tfsdk:"created_by"`
+	Format                   fwtypes.StringEnum[awstypes.OpenTableFormat]              `tfsdk:"format"`
+	MaintenanceConfiguration fwtypes.ObjectValueOf[tableMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration"`
+	MetadataLocation         types.String                                              `tfsdk:"metadata_location"`
+	ModifiedAt               timetypes.RFC3339                                         `tfsdk:"modified_at"`
+	ModifiedBy               types.String                                              `tfsdk:"modified_by"`
+	Name                     types.String                                              `tfsdk:"name"`
+	Namespace                types.String                                              `tfsdk:"namespace"`
+	OwnerAccountID           types.String                                              `tfsdk:"owner_account_id"`
+	TableBucketARN           fwtypes.ARN                                               `tfsdk:"table_bucket_arn"`
+	Type                     fwtypes.StringEnum[awstypes.TableType]                    `tfsdk:"type"`
+	VersionToken             types.String                                              `tfsdk:"version_token"`
+	WarehouseLocation        types.String                                              `tfsdk:"warehouse_location"`
+}
+
+type tableMaintenanceConfigurationModel struct {
+	IcebergCompaction         fwtypes.ObjectValueOf[icebergCompactionModel]         `tfsdk:"iceberg_compaction"`
+	IcebergSnapshotManagement fwtypes.ObjectValueOf[icebergSnapshotManagementModel] `tfsdk:"iceberg_snapshot_management"`
+}
+
+type icebergCompactionModel struct {
+	Settings fwtypes.ObjectValueOf[icebergCompactionSettingsModel] `tfsdk:"settings"`
+	Status   fwtypes.StringEnum[awstypes.MaintenanceStatus]        `tfsdk:"status"`
+}
+
+type icebergCompactionSettingsModel struct {
+	TargetFileSizeMB types.Int64 `tfsdk:"target_file_size_mb"`
+}
+
+type icebergSnapshotManagementModel struct {
+	Settings fwtypes.ObjectValueOf[icebergSnapshotManagementSettingsModel] `tfsdk:"settings"`
+	Status   fwtypes.StringEnum[awstypes.MaintenanceStatus]                `tfsdk:"status"`
+}
+
+type icebergSnapshotManagementSettingsModel struct {
+	MaxSnapshotAgeHours types.Int64 `tfsdk:"max_snapshot_age_hours"`
+	MinSnapshotsToKeep  types.Int64 `tfsdk:"min_snapshots_to_keep"`
+}
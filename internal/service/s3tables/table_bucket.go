@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3tables_table_bucket", name="Table Bucket")
+func newTableBucketResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &tableBucketResource{}, nil
+}
+
+type tableBucketResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*tableBucketResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3tables_table_bucket"
+}
+
+func (r *tableBucketResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"maintenance_configuration": schema.SingleNestedAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[tableBucketMaintenanceConfigurationModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"iceberg_unreferenced_file_removal": schema.SingleNestedAttribute{
+						CustomType: fwtypes.NewObjectTypeOf[icebergUnreferencedFileRemovalModel](ctx),
+						Required:   true,
+						Attributes: map[string]schema.Attribute{
+							"settings": schema.SingleNestedAttribute{
+								CustomType: fwtypes.NewObjectTypeOf[icebergUnreferencedFileRemovalSettingsModel](ctx),
+								Required:   true,
+								Attributes: map[string]schema.Attribute{
+									"non_current_days": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"unreferenced_days": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+							names.AttrStatus: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z-]*[0-9a-z]$`), "must contain only lowercase letters, numbers, and hyphens, and must begin and end with a letter or number"),
+				},
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *tableBucketResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &s3tables.CreateTableBucketInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.CreateTableBucket(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Tables Table Bucket (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Arn)
+
+	if !data.MaintenanceConfiguration.IsNull() && !data.MaintenanceConfiguration.IsUnknown() {
+		response.Diagnostics.Append(putTableBucketMaintenanceConfiguration(ctx, conn, arn, data.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			return
+		}
+	}
+
+	bucket, err := findTableBucketByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, bucket, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceConfiguration, diags := findAndFlattenTableBucketMaintenanceConfiguration(ctx, conn, arn)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.MaintenanceConfiguration = maintenanceConfiguration
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *tableBucketResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findTableBucketByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceConfiguration, diags := findAndFlattenTableBucketMaintenanceConfiguration(ctx, conn, arn)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.MaintenanceConfiguration = maintenanceConfiguration
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableBucketResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableBucketResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	arn := new.ARN.ValueString()
+
+	if !new.MaintenanceConfiguration.Equal(old.MaintenanceConfiguration) {
+		response.Diagnostics.Append(putTableBucketMaintenanceConfiguration(ctx, conn, arn, new.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		maintenanceConfiguration, diags := findAndFlattenTableBucketMaintenanceConfiguration(ctx, conn, arn)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		new.MaintenanceConfiguration = maintenanceConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableBucketResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	arn := data.ARN.ValueString()
+	_, err := conn.DeleteTableBucket(ctx, &s3tables.DeleteTableBucketInput{
+		TableBucketARN: aws.String(arn),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Tables Table Bucket (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *tableBucketResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findTableBucketByARN(ctx context.Context, conn *s3tables.Client, arn string) (*s3tables.GetTableBucketOutput, error) {
+	input := &s3tables.GetTableBucketInput{
+		TableBucketARN: aws.String(arn),
+	}
+
+	output, err := conn.GetTableBucket(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findTableBucketMaintenanceConfigurationByARN(ctx context.Context, conn *s3tables.Client, arn string) (map[string]awstypes.TableBucketMaintenanceConfigurationValue, error) {
+	input := &s3tables.GetTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: aws.String(arn),
+	}
+
+	output, err := conn.GetTableBucketMaintenanceConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Configuration, nil
+}
+
+func findAndFlattenTableBucketMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client, arn string) (fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, err := findTableBucketMaintenanceConfigurationByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s) maintenance configuration", arn), err.Error())
+
+		return fwtypes.NewObjectValueOfNull[tableBucketMaintenanceConfigurationModel](ctx), diags
+	}
+
+	return flattenTableBucketMaintenanceConfiguration(ctx, configuration)
+}
+
+func putTableBucketMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client, arn string, v fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	maintenanceConfiguration, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || maintenanceConfiguration == nil {
+		return diags
+	}
+
+	unreferencedFileRemoval, d := maintenanceConfiguration.IcebergUnreferencedFileRemoval.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || unreferencedFileRemoval == nil {
+		return diags
+	}
+
+	settings, d := unreferencedFileRemoval.Settings.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	value := &awstypes.TableBucketMaintenanceConfigurationValue{
+		Status: unreferencedFileRemoval.Status.ValueEnum(),
+	}
+	if settings != nil {
+		value.Settings = &awstypes.TableBucketMaintenanceSettingsMemberIcebergUnreferencedFileRemoval{
+			Value: awstypes.IcebergUnreferencedFileRemovalSettings{
+				NonCurrentDays:   fwflex.Int32FromFramework(ctx, settings.NonCurrentDays),
+				UnreferencedDays: fwflex.Int32FromFramework(ctx, settings.UnreferencedDays),
+			},
+		}
+	}
+
+	input := &s3tables.PutTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: aws.String(arn),
+		Type:           awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval,
+		Value:          value,
+	}
+
+	_, err := conn.PutTableBucketMaintenanceConfiguration(ctx, input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("putting S3 Tables Table Bucket (%s) maintenance configuration", arn), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func flattenTableBucketMaintenanceConfiguration(ctx context.Context, configuration map[string]awstypes.TableBucketMaintenanceConfigurationValue) (fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := configuration[string(awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval)]
+	if !ok {
+		return fwtypes.NewObjectValueOfNull[tableBucketMaintenanceConfigurationModel](ctx), diags
+	}
+
+	settings := fwtypes.NewObjectValueOfNull[icebergUnreferencedFileRemovalSettingsModel](ctx)
+	if v, ok := v.Settings.(*awstypes.TableBucketMaintenanceSettingsMemberIcebergUnreferencedFileRemoval); ok {
+		settings = fwtypes.NewObjectValueOfMust(ctx, &icebergUnreferencedFileRemovalSettingsModel{
+			NonCurrentDays:   fwflex.Int32ToFramework(ctx, v.Value.NonCurrentDays),
+			UnreferencedDays: fwflex.Int32ToFramework(ctx, v.Value.UnreferencedDays),
+		})
+	}
+
+	return fwtypes.NewObjectValueOf(ctx, &tableBucketMaintenanceConfigurationModel{
+		IcebergUnreferencedFileRemoval: fwtypes.NewObjectValueOfMust(ctx, &icebergUnreferencedFileRemovalModel{
+			Settings: settings,
+			Status:   fwtypes.StringEnumValue(v.Status),
+		}),
+	})
+}
+
+type tableBucketResourceModel struct {
+	ARN                      types.String                                                    `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                               `tfsdk:"created_at"`
+	MaintenanceConfiguration fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration"`
+	Name                     types.String                                                    `tfsdk:"name"`
+	OwnerAccountID           types.String                                                    `tfsdk:"owner_account_id"`
+}
+
+type tableBucketMaintenanceConfigurationModel struct {
+	IcebergUnreferencedFileRemoval fwtypes.ObjectValueOf[icebergUnreferencedFileRemovalModel] `tfsdk:"iceberg_unreferenced_file_removal"`
+}
+
+type icebergUnreferencedFileRemovalModel struct {
+	Settings fwtypes.ObjectValueOf[icebergUnreferencedFileRemovalSettingsModel] `tfsdk:"settings"`
+	Status   fwtypes.StringEnum[awstypes.MaintenanceStatus]                     `tfsdk:"status"`
+}
+
+type icebergUnreferencedFileRemovalSettingsModel struct {
+	NonCurrentDays   types.Int64 `tfsdk:"non_current_days"`
+	UnreferencedDays types.Int64 `tfsdk:"unreferenced_days"`
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3tables "github.com/hashicorp/terraform-provider-aws/internal/service/s3tables"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesTableBucket_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableBucketOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3tables_table_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableBucketConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableBucketExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "s3tables", "bucket/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.settings.non_current_days", "10"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.settings.unreferenced_days", "3"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.status", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwnerAccountID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTableBucketImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTableBucket_maintenanceConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableBucketOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3tables_table_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableBucketConfig_maintenanceConfiguration(rName, "enabled", 20, 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableBucketExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.settings.non_current_days", "20"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.settings.unreferenced_days", "6"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.status", "enabled"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTableBucketImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+			{
+				Config: testAccTableBucketConfig_maintenanceConfiguration(rName, "disabled", 15, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableBucketExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.settings.non_current_days", "15"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.settings.unreferenced_days", "4"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_unreferenced_file_removal.status", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableBucketDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3tables_table_bucket" {
+				continue
+			}
+
+			_, err := tfs3tables.FindTableBucketByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Tables Table Bucket %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableBucketExists(ctx context.Context, n string, v *s3tables.GetTableBucketOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		output, err := tfs3tables.FindTableBucketByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableBucketImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes[names.AttrARN], nil
+	}
+}
+
+func testAccTableBucketConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccTableBucketConfig_maintenanceConfiguration(rName, status string, nonCurrentDays, unreferencedDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[1]q
+
+  maintenance_configuration = {
+    iceberg_unreferenced_file_removal = {
+      settings = {
+        non_current_days  = %[3]d
+        unreferenced_days = %[4]d
+      }
+      status = %[2]q
+    }
+  }
+}
+`, rName, status, nonCurrentDays, unreferencedDays)
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3tables "github.com/hashicorp/terraform-provider-aws/internal/service/s3tables"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableOutput
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	namespace := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic(rName, namespace, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "ICEBERG"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.settings.target_file_size_mb", "512"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.status", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.max_snapshot_age_hours", "120"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.min_snapshots_to_keep", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.status", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrNamespace, "aws_s3tables_namespace.test", names.AttrNamespace),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwnerAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, "table_bucket_arn", "aws_s3tables_table_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "customer"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "warehouse_location"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTableImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_rename(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableOutput
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	namespace := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rNameUpdated := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic(rName, namespace, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				Config: testAccTableConfig_basic(rNameUpdated, namespace, bucketName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_maintenanceConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableOutput
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	namespace := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_maintenanceConfiguration(rName, namespace, bucketName, 64, 240, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.settings.target_file_size_mb", "64"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.status", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.max_snapshot_age_hours", "240"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.min_snapshots_to_keep", "2"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.status", "enabled"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTableImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccTableConfig_maintenanceConfiguration(rName, namespace, bucketName, 128, 48, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.settings.target_file_size_mb", "128"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.max_snapshot_age_hours", "48"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.min_snapshots_to_keep", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3tables_table" {
+				continue
+			}
+
+			_, err := tfs3tables.FindTableByThreePartKey(ctx, conn, rs.Primary.Attributes["table_bucket_arn"], rs.Primary.Attributes[names.AttrNamespace], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Tables Table %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableExists(ctx context.Context, n string, v *s3tables.GetTableOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		output, err := tfs3tables.FindTableByThreePartKey(ctx, conn, rs.Primary.Attributes["table_bucket_arn"], rs.Primary.Attributes[names.AttrNamespace], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["table_bucket_arn"] + ";" + rs.Primary.Attributes[names.AttrNamespace] + ";" + rs.Primary.Attributes[names.AttrName], nil
+	}
+}
+
+func testAccTableConfig_base(namespace, bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[2]q
+}
+
+resource "aws_s3tables_namespace" "test" {
+  namespace        = %[1]q
+  table_bucket_arn = aws_s3tables_table_bucket.test.arn
+}
+`, namespace, bucketName)
+}
+
+func testAccTableConfig_basic(rName, namespace, bucketName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_base(namespace, bucketName), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+}
+`, rName))
+}
+
+func testAccTableConfig_maintenanceConfiguration(rName, namespace, bucketName string, targetFileSizeMB, maxSnapshotAgeHours, minSnapshotsToKeep int) string {
+	return acctest.ConfigCompose(testAccTableConfig_base(namespace, bucketName), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+
+  maintenance_configuration = {
+    iceberg_compaction = {
+      settings = {
+        target_file_size_mb = %[2]d
+      }
+      status = "enabled"
+    }
+    iceberg_snapshot_management = {
+      settings = {
+        max_snapshot_age_hours = %[3]d
+        min_snapshots_to_keep  = %[4]d
+      }
+      status = "enabled"
+    }
+  }
+}
+`, rName, targetFileSizeMB, maxSnapshotAgeHours, minSnapshotsToKeep))
+}
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -65,6 +66,9 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 			},
 			"rule_order": schema.Int64Attribute{
 				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
 			},
 			"rule_status": schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.RuleStatus](),
@@ -101,9 +105,15 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 								Attributes: map[string]schema.Attribute{
 									"confidence": schema.Int64Attribute{
 										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 100),
+										},
 									},
 									"criticality": schema.Int64Attribute{
 										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 100),
+										},
 									},
 									"types": schema.ListAttribute{
 										CustomType:  fwtypes.ListOfStringType,
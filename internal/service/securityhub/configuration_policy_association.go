@@ -99,6 +99,13 @@ func resourceConfigurationPolicyAssociationRead(ctx context.Context, d *schema.R
 
 	output, err := findConfigurationPolicyAssociationByID(ctx, conn, d.Id())
 
+	// Once disassociated, the target reports the configuration inherited from its parent.
+	if err == nil && output.AssociationType == types.AssociationTypeInherited {
+		err = &retry.NotFoundError{
+			Message: "configuration policy association is inherited",
+		}
+	}
+
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Hub Configuration Policy Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
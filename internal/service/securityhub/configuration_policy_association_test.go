@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -151,7 +152,7 @@ func testAccCheckConfigurationPolicyAssociationDestroy(ctx context.Context) reso
 				continue
 			}
 
-			_, err := tfsecurityhub.FindConfigurationPolicyAssociationByID(ctx, conn, rs.Primary.ID)
+			output, err := tfsecurityhub.FindConfigurationPolicyAssociationByID(ctx, conn, rs.Primary.ID)
 
 			if tfresource.NotFound(err) {
 				continue
@@ -161,6 +162,10 @@ func testAccCheckConfigurationPolicyAssociationDestroy(ctx context.Context) reso
 				return err
 			}
 
+			if output.AssociationType == types.AssociationTypeInherited {
+				continue
+			}
+
 			return fmt.Errorf("Security Hub Configuration Policy Association %s still exists", rs.Primary.ID)
 		}
 
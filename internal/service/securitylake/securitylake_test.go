@@ -62,6 +62,7 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"sqs_basic":          testAccSubscriberNotification_sqs_basic,
 			"apiKeyNameOnly":     testAccSubscriberNotification_https_apiKeyNameOnly,
 			"apiKey":             testAccSubscriberNotification_https_apiKey,
+			"exactlyOneEndpoint": testAccSubscriberNotification_configurationExactlyOne,
 		},
 	}
 
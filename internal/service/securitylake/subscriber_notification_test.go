@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -355,6 +356,30 @@ func testAccSubscriberNotification_https_apiKey(t *testing.T) {
 	})
 }
 
+func testAccSubscriberNotification_configurationExactlyOne(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubscriberNotificationConfig_noEndpoint,
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccSubscriberNotificationConfig_bothEndpoints,
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 func testAccCheckSubscriberNotificationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
@@ -611,3 +636,24 @@ resource "aws_apigatewayv2_api" "test" {
 }
 `, rName, keyName, keyValue))
 }
+
+const testAccSubscriberNotificationConfig_noEndpoint = `
+resource "aws_securitylake_subscriber_notification" "test" {
+  subscriber_id = "00000000-0000-0000-0000-000000000000"
+  configuration {}
+}
+`
+
+const testAccSubscriberNotificationConfig_bothEndpoints = `
+resource "aws_securitylake_subscriber_notification" "test" {
+  subscriber_id = "00000000-0000-0000-0000-000000000000"
+  configuration {
+    https_notification_configuration {
+      endpoint        = "https://example.com"
+      target_role_arn = "arn:aws:iam::123456789012:role/example"
+    }
+
+    sqs_notification_configuration {}
+  }
+}
+`
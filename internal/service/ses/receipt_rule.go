@@ -171,6 +171,11 @@ func ResourceReceiptRule() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						names.AttrIAMRoleARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
 						names.AttrKMSKeyARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -382,6 +387,10 @@ func resourceReceiptRuleRead(ctx context.Context, d *schema.ResourceData, meta i
 				"position":           i + 1,
 			}
 
+			if element.S3Action.IamRoleArn != nil {
+				s3Action[names.AttrIAMRoleARN] = aws.ToString(element.S3Action.IamRoleArn)
+			}
+
 			if element.S3Action.KmsKeyArn != nil {
 				s3Action[names.AttrKMSKeyARN] = aws.ToString(element.S3Action.KmsKeyArn)
 			}
@@ -661,6 +670,10 @@ func buildReceiptRule(d *schema.ResourceData) *awstypes.ReceiptRule {
 				BucketName: aws.String(elem[names.AttrBucketName].(string)),
 			}
 
+			if elem[names.AttrIAMRoleARN] != "" {
+				s3Action.IamRoleArn = aws.String(elem[names.AttrIAMRoleARN].(string))
+			}
+
 			if elem[names.AttrKMSKeyARN] != "" {
 				s3Action.KmsKeyArn = aws.String(elem[names.AttrKMSKeyARN].(string))
 			}
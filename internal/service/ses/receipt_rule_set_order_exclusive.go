@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ses
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @FrameworkResource("aws_ses_receipt_rule_set_order_exclusive", name="Receipt Rule Set Order Exclusive")
+func newReceiptRuleSetOrderExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &receiptRuleSetOrderExclusiveResource{}, nil
+}
+
+type receiptRuleSetOrderExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*receiptRuleSetOrderExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ses_receipt_rule_set_order_exclusive"
+}
+
+func (r *receiptRuleSetOrderExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rule_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"rule_set_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *receiptRuleSetOrderExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data receiptRuleSetOrderExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncRuleOrder(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *receiptRuleSetOrderExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data receiptRuleSetOrderExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SESClient(ctx)
+
+	ruleSetName := data.RuleSetName.ValueString()
+	ruleNames, err := findReceiptRuleNamesByRuleSetName(ctx, conn, ruleSetName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Receipt Rule Set Order Exclusive (%s)", ruleSetName), err.Error())
+
+		return
+	}
+
+	data.RuleNames = fwflex.FlattenFrameworkStringValueListLegacy(ctx, ruleNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *receiptRuleSetOrderExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data receiptRuleSetOrderExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncRuleOrder(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *receiptRuleSetOrderExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("rule_set_name"), request, response)
+}
+
+// syncRuleOrder reorders the receipt rules in the rule set to match the configured order.
+// The configured list must name every rule in the rule set; rules are never created or deleted.
+func (r *receiptRuleSetOrderExclusiveResource) syncRuleOrder(ctx context.Context, data receiptRuleSetOrderExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().SESClient(ctx)
+
+	ruleSetName := data.RuleSetName.ValueString()
+	have, err := findReceiptRuleNamesByRuleSetName(ctx, conn, ruleSetName)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading SES Receipt Rule Set Order Exclusive (%s)", ruleSetName), err.Error())
+
+		return diags
+	}
+
+	want := fwflex.ExpandFrameworkStringValueList(ctx, data.RuleNames)
+
+	if slices.Equal(have, want) {
+		return diags
+	}
+
+	haveSet, wantSet := itypes.Set[string](have), itypes.Set[string](want)
+	if missing, unmanaged := wantSet.Difference(haveSet), haveSet.Difference(wantSet); len(missing) > 0 || len(unmanaged) > 0 {
+		var msgs []string
+		if len(missing) > 0 {
+			msgs = append(msgs, fmt.Sprintf("rules not found in rule set: %s", strings.Join(missing, ", ")))
+		}
+		if len(unmanaged) > 0 {
+			msgs = append(msgs, fmt.Sprintf("rules in rule set not present in rule_names: %s", strings.Join(unmanaged, ", ")))
+		}
+		diags.AddError(fmt.Sprintf("reordering SES Receipt Rule Set (%s)", ruleSetName), strings.Join(msgs, "; "))
+
+		return diags
+	}
+
+	input := &ses.ReorderReceiptRuleSetInput{
+		RuleNames:   want,
+		RuleSetName: aws.String(ruleSetName),
+	}
+
+	_, err = conn.ReorderReceiptRuleSet(ctx, input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reordering SES Receipt Rule Set (%s)", ruleSetName), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+// findReceiptRuleNamesByRuleSetName returns the names of the receipt rules in the specified rule set, in evaluation order.
+func findReceiptRuleNamesByRuleSetName(ctx context.Context, conn *ses.Client, ruleSetName string) ([]string, error) {
+	input := &ses.DescribeReceiptRuleSetInput{
+		RuleSetName: aws.String(ruleSetName),
+	}
+	output, err := conn.DescribeReceiptRuleSet(ctx, input)
+
+	if errs.IsA[*awstypes.RuleSetDoesNotExistException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Metadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfslices.ApplyToAll(output.Rules, func(v awstypes.ReceiptRule) string {
+		return aws.ToString(v.Name)
+	}), nil
+}
+
+type receiptRuleSetOrderExclusiveResourceModel struct {
+	RuleNames   types.List   `tfsdk:"rule_names"`
+	RuleSetName types.String `tfsdk:"rule_set_name"`
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ses_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfses "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSESReceiptRuleSetOrderExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_receipt_rule_set_order_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptRuleSetOrderExclusiveConfig_basic(rName, "first", "second", "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleSetOrder(ctx, resourceName, "first", "second", "third"),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.0", "first"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.1", "second"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.2", "third"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        rName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "rule_set_name",
+			},
+			{
+				Config: testAccReceiptRuleSetOrderExclusiveConfig_basic(rName, "third", "first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleSetOrder(ctx, resourceName, "third", "first", "second"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.0", "third"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.1", "first"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.2", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESReceiptRuleSetOrderExclusive_outOfBandReorder(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_receipt_rule_set_order_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptRuleSetOrderExclusiveConfig_basic(rName, "first", "second", "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleSetOrder(ctx, resourceName, "first", "second", "third"),
+					testAccCheckReceiptRuleSetReorderOutOfBand(ctx, resourceName, "second", "third", "first"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The out-of-band reorder is reverted.
+				Config: testAccReceiptRuleSetOrderExclusiveConfig_basic(rName, "first", "second", "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleSetOrder(ctx, resourceName, "first", "second", "third"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReceiptRuleSetOrder(ctx context.Context, n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESClient(ctx)
+
+		got, err := tfses.FindReceiptRuleNamesByRuleSetName(ctx, conn, rs.Primary.Attributes["rule_set_name"])
+
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("SES Receipt Rule Set (%s) rule order is %v, want %v", rs.Primary.Attributes["rule_set_name"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckReceiptRuleSetReorderOutOfBand(ctx context.Context, n string, ruleNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESClient(ctx)
+
+		_, err := conn.ReorderReceiptRuleSet(ctx, &ses.ReorderReceiptRuleSetInput{
+			RuleNames:   ruleNames,
+			RuleSetName: aws.String(rs.Primary.Attributes["rule_set_name"]),
+		})
+
+		return err
+	}
+}
+
+func testAccReceiptRuleSetOrderExclusiveConfig_basic(rName string, ruleNames ...string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "first" {
+  name          = "first"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule" "second" {
+  name          = "second"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule" "third" {
+  name          = "third"
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule_set_order_exclusive" "test" {
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  rule_names    = [%[2]s]
+
+  depends_on = [
+    aws_ses_receipt_rule.first,
+    aws_ses_receipt_rule.second,
+    aws_ses_receipt_rule.third,
+  ]
+}
+`, rName, `"`+strings.Join(ruleNames, `", "`)+`"`)
+}
@@ -105,6 +105,43 @@ func TestAccSESReceiptRule_s3Action(t *testing.T) {
 	})
 }
 
+func TestAccSESReceiptRule_s3ActionIAMRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ReceiptRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_receipt_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptRuleConfig_s3ActionIAMRole(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "s3_action.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "s3_action.*.bucket_name", "aws_s3_bucket.test", names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "s3_action.*.iam_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "s3_action.*", map[string]string{
+						"position": acctest.Ct1,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccReceiptRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccSESReceiptRule_snsAction(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rule awstypes.ReceiptRule
@@ -518,6 +555,67 @@ resource "aws_ses_receipt_rule" "test" {
 `, rName, acctest.DefaultEmailAddress)
 }
 
+func testAccReceiptRuleConfig_s3ActionIAMRole(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "ses.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:PutObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  name          = %[1]q
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  recipients    = [%[2]q]
+  enabled       = true
+  scan_enabled  = true
+  tls_policy    = "Require"
+
+  s3_action {
+    bucket_name  = aws_s3_bucket.test.id
+    iam_role_arn = aws_iam_role.test.arn
+    position     = 1
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, acctest.DefaultEmailAddress)
+}
+
 func testAccReceiptRuleConfig_snsAction(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ses_receipt_rule_set" "test" {
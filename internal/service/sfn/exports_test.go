@@ -5,9 +5,10 @@ package sfn
 
 // Exports for use in tests only.
 var (
-	ResourceActivity     = resourceActivity
-	ResourceAlias        = resourceAlias
-	ResourceStateMachine = resourceStateMachine
+	ResourceActivity            = resourceActivity
+	ResourceAlias               = resourceAlias
+	ResourceStateMachine        = resourceStateMachine
+	ResourceStateMachineVersion = resourceStateMachineVersion
 
 	FindActivityByARN     = findActivityByARN
 	FindAliasByARN        = findAliasByARN
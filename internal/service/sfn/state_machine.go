@@ -192,6 +192,7 @@ func resourceStateMachine() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			checkEncryptionConfiguration,
 			stateMachineUpdateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -371,7 +372,7 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 				d.HasChange("logging_configuration.0.include_execution_data") && output.LoggingConfiguration != nil && output.LoggingConfiguration.IncludeExecutionData != d.Get("logging_configuration.0.include_execution_data").(bool) ||
 				d.HasChange("logging_configuration.0.level") && output.LoggingConfiguration != nil && string(output.LoggingConfiguration.Level) != d.Get("logging_configuration.0.level").(string) ||
 				d.HasChange("encryption_configuration.0.kms_key_id") && output.EncryptionConfiguration != nil && output.EncryptionConfiguration.KmsKeyId != nil && aws.ToString(output.EncryptionConfiguration.KmsKeyId) != d.Get("encryption_configuration.0.kms_key_id") ||
-				d.HasChange("encryption_configuration.0.encryption_type") && output.EncryptionConfiguration != nil && string(output.EncryptionConfiguration.Type) != d.Get("encryption_configuration.0.encryption_type").(string) ||
+				d.HasChange("encryption_configuration.0.type") && output.EncryptionConfiguration != nil && string(output.EncryptionConfiguration.Type) != d.Get("encryption_configuration.0.type").(string) ||
 				d.HasChange("encryption_configuration.0.kms_data_key_reuse_period_seconds") && output.EncryptionConfiguration != nil && output.EncryptionConfiguration.KmsDataKeyReusePeriodSeconds != nil && aws.ToInt32(output.EncryptionConfiguration.KmsDataKeyReusePeriodSeconds) != int32(d.Get("encryption_configuration.0.kms_data_key_reuse_period_seconds").(int)) {
 				return retry.RetryableError(fmt.Errorf("Step Functions State Machine (%s) eventual consistency", d.Id()))
 			}
@@ -534,6 +535,27 @@ func flattenTracingConfiguration(apiObject *awstypes.TracingConfiguration) map[s
 	return tfMap
 }
 
+// checkEncryptionConfiguration ensures that a KMS key is only configured with customer managed key encryption.
+func checkEncryptionConfiguration(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch awstypes.EncryptionType(d.Get("encryption_configuration.0.type").(string)) {
+	case awstypes.EncryptionTypeAwsOwnedKey:
+		if v, ok := d.GetOk("encryption_configuration.0.kms_key_id"); ok && v.(string) != "" {
+			return fmt.Errorf("encryption_configuration.0.kms_key_id cannot be set when encryption_configuration.0.type is %s", awstypes.EncryptionTypeAwsOwnedKey)
+		}
+		if v, ok := d.GetOk("encryption_configuration.0.kms_data_key_reuse_period_seconds"); ok && v.(int) != 0 {
+			return fmt.Errorf("encryption_configuration.0.kms_data_key_reuse_period_seconds cannot be set when encryption_configuration.0.type is %s", awstypes.EncryptionTypeAwsOwnedKey)
+		}
+	case awstypes.EncryptionTypeCustomerManagedKmsKey:
+		if v, ok := d.GetOk("encryption_configuration.0.kms_key_id"); !ok || v.(string) == "" {
+			if d.NewValueKnown("encryption_configuration.0.kms_key_id") {
+				return fmt.Errorf("encryption_configuration.0.kms_key_id must be set when encryption_configuration.0.type is %s", awstypes.EncryptionTypeCustomerManagedKmsKey)
+			}
+		}
+	}
+
+	return nil
+}
+
 func stateMachineUpdateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	publish := d.Get("publish").(bool)
 	if publish && stateMachineNeedsConfigUpdate(d) {
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sfn_state_machine_version", name="State Machine Version")
+func resourceStateMachineVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStateMachineVersionCreate,
+		ReadWithoutTimeout:   resourceStateMachineVersionRead,
+		UpdateWithoutTimeout: schema.NoopContext, // skip_destroy isn't ForceNew.
+		DeleteWithoutTimeout: resourceStateMachineVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrSkipDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state_machine_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStateMachineVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	stateMachineARN := d.Get("state_machine_arn").(string)
+	input := &sfn.PublishStateMachineVersionInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("revision_id"); ok {
+		input.RevisionId = aws.String(v.(string))
+	}
+
+	output, err := conn.PublishStateMachineVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "publishing Step Functions State Machine (%s) Version: %s", stateMachineARN, err)
+	}
+
+	d.SetId(aws.ToString(output.StateMachineVersionArn))
+
+	return append(diags, resourceStateMachineVersionRead(ctx, d, meta)...)
+}
+
+func resourceStateMachineVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	output, err := findStateMachineByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Step Functions State Machine Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Step Functions State Machine Version (%s): %s", d.Id(), err)
+	}
+
+	stateMachineARN, version, err := stateMachineVersionParseARN(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrARN, d.Id())
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("revision_id", output.RevisionId)
+	d.Set("state_machine_arn", stateMachineARN)
+	d.Set(names.AttrVersion, version)
+
+	return diags
+}
+
+func resourceStateMachineVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SFNClient(ctx)
+
+	if d.Get(names.AttrSkipDestroy).(bool) {
+		log.Printf("[DEBUG] Retaining Step Functions State Machine Version: %s", d.Id())
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Step Functions State Machine Version: %s", d.Id())
+	_, err := conn.DeleteStateMachineVersion(ctx, &sfn.DeleteStateMachineVersionInput{
+		StateMachineVersionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.StateMachineDoesNotExist](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Step Functions State Machine Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// stateMachineVersionParseARN splits a state machine version ARN into the state machine ARN and version number.
+func stateMachineVersionParseARN(arn string) (string, string, error) {
+	i := strings.LastIndex(arn, ":")
+	if i < 0 || i == len(arn)-1 {
+		return "", "", fmt.Errorf("unexpected format for Step Functions State Machine Version ARN (%s), expected STATE_MACHINE_ARN:VERSION", arn)
+	}
+
+	return arn[:i], arn[i+1:], nil
+}
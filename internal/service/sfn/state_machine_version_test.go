@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version sfn.DescribeStateMachineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version.test"
+	stateMachineResourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &version),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "states", fmt.Sprintf("stateMachine:%s:1", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "state_machine_arn", stateMachineResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy},
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineVersion_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version sfn.DescribeStateMachineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionConfig_skipDestroy(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				// Updating skip_destroy doesn't publish a new version.
+				Config: testAccStateMachineVersionConfig_skipDestroy(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version sfn.DescribeStateMachineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &version),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsfn.ResourceStateMachineVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineVersion_aliasRouting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version sfn.DescribeStateMachineOutput
+	var alias sfn.DescribeStateMachineAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine_version.test"
+	aliasResourceName := "aws_sfn_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionConfig_aliasRouting(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &version),
+					testAccCheckAliasExists(ctx, aliasResourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccStateMachineVersionConfig_aliasRouting(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateMachineVersionExists(ctx, resourceName, &version),
+					testAccCheckAliasExists(ctx, aliasResourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStateMachineVersionExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
+
+		output, err := tfsfn.FindStateMachineByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStateMachineVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sfn_state_machine_version" {
+				continue
+			}
+
+			_, err := tfsfn.FindStateMachineByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Step Functions State Machine Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccStateMachineVersionConfig_base(rName, result string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "states.${data.aws_region.current.name}.amazonaws.com"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition = jsonencode({
+    StartAt = "Pass"
+    States = {
+      Pass = {
+        Type   = "Pass"
+        Result = %[2]q
+        End    = true
+      }
+    }
+  })
+}
+`, rName, result)
+}
+
+func testAccStateMachineVersionConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccStateMachineVersionConfig_base(rName, "result"), fmt.Sprintf(`
+resource "aws_sfn_state_machine_version" "test" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  description       = %[1]q
+}
+`, description))
+}
+
+func testAccStateMachineVersionConfig_skipDestroy(rName string, skipDestroy bool) string {
+	return acctest.ConfigCompose(testAccStateMachineVersionConfig_base(rName, "result"), fmt.Sprintf(`
+resource "aws_sfn_state_machine_version" "test" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  skip_destroy      = %[1]t
+}
+`, skipDestroy))
+}
+
+func testAccStateMachineVersionConfig_aliasRouting(rName, result string) string {
+	return acctest.ConfigCompose(testAccStateMachineVersionConfig_base(rName, result), fmt.Sprintf(`
+resource "aws_sfn_state_machine_version" "previous" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  revision_id       = aws_sfn_state_machine.test.revision_id
+  skip_destroy      = true
+
+  lifecycle {
+    ignore_changes = [revision_id]
+  }
+}
+
+resource "aws_sfn_state_machine_version" "test" {
+  state_machine_arn = aws_sfn_state_machine.test.arn
+  revision_id       = aws_sfn_state_machine.test.revision_id
+  skip_destroy      = true
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  dynamic "routing_configuration" {
+    for_each = toset(distinct([aws_sfn_state_machine_version.previous.arn, aws_sfn_state_machine_version.test.arn]))
+
+    content {
+      state_machine_version_arn = routing_configuration.value
+      weight                    = aws_sfn_state_machine_version.previous.arn == aws_sfn_state_machine_version.test.arn ? 100 : 50
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_timestreaminfluxdb_db_parameter_group", name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb;timestreaminfluxdb.GetDbParameterGroupOutput")
+func newResourceDBParameterGroup(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDBParameterGroup{}
+
+	return r, nil
+}
+
+const (
+	ResNameDBParameterGroup = "DB Parameter Group"
+)
+
+type resourceDBParameterGroup struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[resourceDBParameterGroupData]
+	framework.WithNoOpDelete
+}
+
+func (r *resourceDBParameterGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_timestreaminfluxdb_db_parameter_group"
+}
+
+func (r *resourceDBParameterGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+				Description: `A description of the DB parameter group.`,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+					stringvalidator.RegexMatches(
+						regexache.MustCompile("^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$"),
+						"",
+					),
+				},
+				Description: `The name of the DB parameter group. The name must be unique per customer
+					and per region.`,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parametersData](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: `The parameters to include in the DB parameter group.`,
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"influxdbv2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[influxDBv2ParametersData](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							Description: `All the customer-modifiable InfluxDB v2 parameters in Timestream for InfluxDB.`,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flux_log_enabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+											boolplanmodifier.UseStateForUnknown(),
+										},
+										Description: `Include option to show detailed logs for Flux queries.`,
+									},
+									"log_level": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LogLevel](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+											stringplanmodifier.UseStateForUnknown(),
+										},
+										Description: `Log output level. InfluxDB outputs log entries with severity levels greater
+											than or equal to the level specified.`,
+									},
+									"metrics_disabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+											boolplanmodifier.UseStateForUnknown(),
+										},
+										Description: `Disable the HTTP /metrics endpoint which exposes internal InfluxDB metrics.`,
+									},
+									"no_tasks": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+											boolplanmodifier.UseStateForUnknown(),
+										},
+										Description: `Disable the task scheduler. If problematic tasks prevent InfluxDB from
+											starting, use this option to start InfluxDB without scheduling or executing tasks.`,
+									},
+									"query_concurrency": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.RequiresReplace(),
+											int64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+										Description: `Number of queries allowed to execute concurrently. Setting to 0 allows an
+											unlimited number of concurrent queries.`,
+									},
+									"query_queue_size": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.RequiresReplace(),
+											int64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+										Description: `Maximum number of queries allowed in execution queue. When queue limit is
+											reached, new queries are rejected. Setting to 0 allows an unlimited number of queries
+											in the queue.`,
+									},
+									"tracing_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TracingType](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+											stringplanmodifier.UseStateForUnknown(),
+										},
+										Description: `Enable tracing in InfluxDB and specifies the tracing type. Tracing is
+											disabled by default.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDBParameterGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	var plan resourceDBParameterGroupData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := timestreaminfluxdb.CreateDbParameterGroupInput{
+		Description: flex.StringFromFramework(ctx, plan.Description),
+		Name:        flex.StringFromFramework(ctx, plan.Name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	parameters, diags := expandParameters(ctx, plan.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	in.Parameters = parameters
+
+	out, err := conn.CreateDbParameterGroup(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionCreating, ResNameDBParameterGroup, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.Id == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionCreating, ResNameDBParameterGroup, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ARN = flex.StringToFramework(ctx, out.Arn)
+	state.ID = flex.StringToFramework(ctx, out.Id)
+
+	resp.Diagnostics.Append(flattenParameters(ctx, out.Parameters, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDBParameterGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	var state resourceDBParameterGroupData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findDBParameterGroupByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionSetting, ResNameDBParameterGroup, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ARN = flex.StringToFramework(ctx, output.Arn)
+	state.Description = flex.StringToFramework(ctx, output.Description)
+	state.Name = flex.StringToFramework(ctx, output.Name)
+
+	resp.Diagnostics.Append(flattenParameters(ctx, output.Parameters, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDBParameterGroup) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	in := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	out, err := conn.GetDbParameterGroup(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Id == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// expandParameters builds the Parameters union from the single configured engine block.
+func expandParameters(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[parametersData]) (awstypes.Parameters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parameters, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || parameters == nil {
+		return nil, diags
+	}
+
+	influxDBv2, d := parameters.InfluxDBv2.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || influxDBv2 == nil {
+		return nil, diags
+	}
+
+	var apiObject awstypes.InfluxDBv2Parameters
+	diags.Append(flex.Expand(ctx, influxDBv2, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.ParametersMemberInfluxDBv2{Value: apiObject}, diags
+}
+
+func flattenParameters(ctx context.Context, apiObject awstypes.Parameters, data *resourceDBParameterGroupData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch v := apiObject.(type) {
+	case nil:
+		data.Parameters = fwtypes.NewListNestedObjectValueOfNull[parametersData](ctx)
+
+	case *awstypes.ParametersMemberInfluxDBv2:
+		if v.Value == (awstypes.InfluxDBv2Parameters{}) {
+			data.Parameters = fwtypes.NewListNestedObjectValueOfNull[parametersData](ctx)
+			break
+		}
+
+		var influxDBv2 influxDBv2ParametersData
+		diags.Append(flex.Flatten(ctx, v.Value, &influxDBv2)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		data.Parameters = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &parametersData{
+			InfluxDBv2: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &influxDBv2),
+		})
+
+	default:
+		diags.AddError("unsupported parameters type", fmt.Sprintf("%T", v))
+	}
+
+	return diags
+}
+
+type resourceDBParameterGroupData struct {
+	ARN         types.String                                    `tfsdk:"arn"`
+	Description types.String                                    `tfsdk:"description"`
+	ID          types.String                                    `tfsdk:"id"`
+	Name        types.String                                    `tfsdk:"name"`
+	Parameters  fwtypes.ListNestedObjectValueOf[parametersData] `tfsdk:"parameters"`
+	Tags        tftags.Map                                      `tfsdk:"tags"`
+	TagsAll     tftags.Map                                      `tfsdk:"tags_all"`
+}
+
+type parametersData struct {
+	InfluxDBv2 fwtypes.ListNestedObjectValueOf[influxDBv2ParametersData] `tfsdk:"influxdbv2"`
+}
+
+type influxDBv2ParametersData struct {
+	FluxLogEnabled   types.Bool                               `tfsdk:"flux_log_enabled"`
+	LogLevel         fwtypes.StringEnum[awstypes.LogLevel]    `tfsdk:"log_level"`
+	MetricsDisabled  types.Bool                               `tfsdk:"metrics_disabled"`
+	NoTasks          types.Bool                               `tfsdk:"no_tasks"`
+	QueryConcurrency types.Int64                              `tfsdk:"query_concurrency"`
+	QueryQueueSize   types.Int64                              `tfsdk:"query_queue_size"`
+	TracingType      fwtypes.StringEnum[awstypes.TracingType] `tfsdk:"tracing_type"`
+}
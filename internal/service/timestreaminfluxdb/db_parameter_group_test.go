@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftimestreaminfluxdb "github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTimestreamInfluxDBDBParameterGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbParameterGroup timestreaminfluxdb.GetDbParameterGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreaminfluxdb_db_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamInfluxDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// DB parameter groups cannot be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBParameterGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &dbParameterGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "timestream-influxdb", regexache.MustCompile(`db-parameter-group/+.`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamInfluxDBDBParameterGroup_parameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbParameterGroup timestreaminfluxdb.GetDbParameterGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreaminfluxdb_db_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamInfluxDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBParameterGroupConfig_parameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &dbParameterGroup),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test parameter group"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.flux_log_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.log_level", string(awstypes.LogLevelDebug)),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.query_concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.tracing_type", string(awstypes.TracingTypeLog)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamInfluxDBDBParameterGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbParameterGroup timestreaminfluxdb.GetDbParameterGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreaminfluxdb_db_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamInfluxDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBParameterGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &dbParameterGroup),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDBParameterGroupConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &dbParameterGroup),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDBParameterGroupExists(ctx context.Context, name string, dbParameterGroup *timestreaminfluxdb.GetDbParameterGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.TimestreamInfluxDB, create.ErrActionCheckingExistence, tftimestreaminfluxdb.ResNameDBParameterGroup, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.TimestreamInfluxDB, create.ErrActionCheckingExistence, tftimestreaminfluxdb.ResNameDBParameterGroup, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+		resp, err := tftimestreaminfluxdb.FindDBParameterGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.TimestreamInfluxDB, create.ErrActionCheckingExistence, tftimestreaminfluxdb.ResNameDBParameterGroup, rs.Primary.ID, err)
+		}
+
+		*dbParameterGroup = *resp
+
+		return nil
+	}
+}
+
+func testAccDBParameterGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreaminfluxdb_db_parameter_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDBParameterGroupConfig_parameters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreaminfluxdb_db_parameter_group" "test" {
+  name        = %[1]q
+  description = "test parameter group"
+
+  parameters {
+    influxdbv2 {
+      flux_log_enabled  = true
+      log_level         = "debug"
+      query_concurrency = 10
+      tracing_type      = "log"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDBParameterGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreaminfluxdb_db_parameter_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDBParameterGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreaminfluxdb_db_parameter_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_resource_configuration", name="Resource Configuration")
+// @Tags(identifierAttribute="arn")
+func resourceResourceConfiguration() *schema.Resource {
+	resourceDefinitionKeys := []string{
+		"resource_configuration_definition.0.arn_resource",
+		"resource_configuration_definition.0.dns_resource",
+		"resource_configuration_definition.0.ip_resource",
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceConfigurationCreate,
+		ReadWithoutTimeout:   resourceResourceConfigurationRead,
+		UpdateWithoutTimeout: resourceResourceConfigurationUpdate,
+		DeleteWithoutTimeout: resourceResourceConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_association_to_shareable_service_network": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 40),
+			},
+			"port_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrProtocol: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ProtocolType](),
+			},
+			"resource_configuration_definition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn_resource": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: resourceDefinitionKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"dns_resource": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: resourceDefinitionKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDomainName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrIPAddressType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.ResourceConfigurationIpAddressType](),
+									},
+								},
+							},
+						},
+						"ip_resource": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: resourceDefinitionKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrIPAddress: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsIPAddress,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_configuration_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resource_gateway_identifier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentIDOrARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          types.ResourceConfigurationTypeSingle,
+				ValidateDiagFunc: enum.Validate[types.ResourceConfigurationType](),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameResourceConfiguration = "Resource Configuration"
+)
+
+func resourceResourceConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	in := &vpclattice.CreateResourceConfigurationInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Name:        aws.String(name),
+		Tags:        getTagsIn(ctx),
+		Type:        types.ResourceConfigurationType(d.Get(names.AttrType).(string)),
+	}
+
+	if v, ok := d.GetOkExists("allow_association_to_shareable_service_network"); ok { //nolint:staticcheck // Explicitly false is meaningful.
+		in.AllowAssociationToShareableServiceNetwork = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("port_ranges"); ok && v.(*schema.Set).Len() > 0 {
+		in.PortRanges = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrProtocol); ok {
+		in.Protocol = types.ProtocolType(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_configuration_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.ResourceConfigurationDefinition = expandResourceConfigurationDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_configuration_group_id"); ok {
+		in.ResourceConfigurationGroupIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_gateway_identifier"); ok {
+		in.ResourceGatewayIdentifier = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateResourceConfiguration(ctx, in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionCreating, ResNameResourceConfiguration, name, err)
+	}
+
+	d.SetId(aws.ToString(out.Id))
+
+	if _, err := waitResourceConfigurationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForCreation, ResNameResourceConfiguration, d.Id(), err)
+	}
+
+	return append(diags, resourceResourceConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceResourceConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	out, err := findResourceConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice Resource Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionReading, ResNameResourceConfiguration, d.Id(), err)
+	}
+
+	d.Set("allow_association_to_shareable_service_network", out.AllowAssociationToShareableServiceNetwork)
+	d.Set(names.AttrARN, out.Arn)
+	d.Set(names.AttrName, out.Name)
+	d.Set("port_ranges", out.PortRanges)
+	d.Set(names.AttrProtocol, out.Protocol)
+	if out.ResourceConfigurationDefinition != nil {
+		if err := d.Set("resource_configuration_definition", []interface{}{flattenResourceConfigurationDefinition(out.ResourceConfigurationDefinition)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resource_configuration_definition: %s", err)
+		}
+	} else {
+		d.Set("resource_configuration_definition", nil)
+	}
+	d.Set("resource_configuration_group_id", out.ResourceConfigurationGroupId)
+	d.Set("resource_gateway_identifier", out.ResourceGatewayId)
+	d.Set(names.AttrStatus, out.Status)
+	d.Set(names.AttrType, out.Type)
+
+	return diags
+}
+
+func resourceResourceConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		in := &vpclattice.UpdateResourceConfigurationInput{
+			ResourceConfigurationIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("allow_association_to_shareable_service_network") {
+			in.AllowAssociationToShareableServiceNetwork = aws.Bool(d.Get("allow_association_to_shareable_service_network").(bool))
+		}
+
+		if d.HasChange("port_ranges") {
+			in.PortRanges = flex.ExpandStringValueSet(d.Get("port_ranges").(*schema.Set))
+		}
+
+		if d.HasChange("resource_configuration_definition") {
+			if v, ok := d.GetOk("resource_configuration_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.ResourceConfigurationDefinition = expandResourceConfigurationDefinition(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.UpdateResourceConfiguration(ctx, in)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionUpdating, ResNameResourceConfiguration, d.Id(), err)
+		}
+
+		if _, err := waitResourceConfigurationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForUpdate, ResNameResourceConfiguration, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceResourceConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	log.Printf("[INFO] Deleting VPCLattice Resource Configuration: %s", d.Id())
+	_, err := conn.DeleteResourceConfiguration(ctx, &vpclattice.DeleteResourceConfigurationInput{
+		ResourceConfigurationIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionDeleting, ResNameResourceConfiguration, d.Id(), err)
+	}
+
+	if _, err := waitResourceConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameResourceConfiguration, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findResourceConfigurationByID(ctx context.Context, conn *vpclattice.Client, id string) (*vpclattice.GetResourceConfigurationOutput, error) {
+	in := &vpclattice.GetResourceConfigurationInput{
+		ResourceConfigurationIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetResourceConfiguration(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func statusResourceConfiguration(ctx context.Context, conn *vpclattice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findResourceConfigurationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitResourceConfigurationActive(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.ResourceConfigurationStatusCreateInProgress, types.ResourceConfigurationStatusUpdateInProgress),
+		Target:                    enum.Slice(types.ResourceConfigurationStatusActive),
+		Refresh:                   statusResourceConfiguration(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*vpclattice.GetResourceConfigurationOutput); ok {
+		if v := aws.ToString(out.FailureReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitResourceConfigurationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ResourceConfigurationStatusActive, types.ResourceConfigurationStatusDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusResourceConfiguration(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*vpclattice.GetResourceConfigurationOutput); ok {
+		if v := aws.ToString(out.FailureReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandResourceConfigurationDefinition(tfMap map[string]interface{}) types.ResourceConfigurationDefinition {
+	if v, ok := tfMap["arn_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.ResourceConfigurationDefinitionMemberArnResource{
+			Value: types.ArnResource{
+				Arn: aws.String(tfMap[names.AttrARN].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["dns_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.ResourceConfigurationDefinitionMemberDnsResource{
+			Value: types.DnsResource{
+				DomainName:    aws.String(tfMap[names.AttrDomainName].(string)),
+				IpAddressType: types.ResourceConfigurationIpAddressType(tfMap[names.AttrIPAddressType].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["ip_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.ResourceConfigurationDefinitionMemberIpResource{
+			Value: types.IpResource{
+				IpAddress: aws.String(tfMap[names.AttrIPAddress].(string)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenResourceConfigurationDefinition(apiObject types.ResourceConfigurationDefinition) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.ResourceConfigurationDefinitionMemberArnResource:
+		tfMap["arn_resource"] = []interface{}{map[string]interface{}{
+			names.AttrARN: aws.ToString(v.Value.Arn),
+		}}
+	case *types.ResourceConfigurationDefinitionMemberDnsResource:
+		tfMap["dns_resource"] = []interface{}{map[string]interface{}{
+			names.AttrDomainName:    aws.ToString(v.Value.DomainName),
+			names.AttrIPAddressType: v.Value.IpAddressType,
+		}}
+	case *types.ResourceConfigurationDefinitionMemberIpResource:
+		tfMap["ip_resource"] = []interface{}{map[string]interface{}{
+			names.AttrIPAddress: aws.ToString(v.Value.IpAddress),
+		}}
+	}
+
+	return tfMap
+}
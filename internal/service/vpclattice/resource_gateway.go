@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_resource_gateway", name="Resource Gateway")
+// @Tags(identifierAttribute="arn")
+func resourceResourceGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceGatewayCreate,
+		ReadWithoutTimeout:   resourceResourceGatewayRead,
+		UpdateWithoutTimeout: resourceResourceGatewayUpdate,
+		DeleteWithoutTimeout: resourceResourceGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrIPAddressType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ResourceGatewayIpAddressType](),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 40),
+			},
+			names.AttrSecurityGroupIDs: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrSubnetIDs: {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameResourceGateway = "Resource Gateway"
+)
+
+func resourceResourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	in := &vpclattice.CreateResourceGatewayInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Name:          aws.String(name),
+		SubnetIds:     flex.ExpandStringValueSet(d.Get(names.AttrSubnetIDs).(*schema.Set)),
+		Tags:          getTagsIn(ctx),
+		VpcIdentifier: aws.String(d.Get(names.AttrVPCID).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrIPAddressType); ok {
+		in.IpAddressType = types.ResourceGatewayIpAddressType(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
+		in.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	out, err := conn.CreateResourceGateway(ctx, in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionCreating, ResNameResourceGateway, name, err)
+	}
+
+	d.SetId(aws.ToString(out.Id))
+
+	if _, err := waitResourceGatewayActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForCreation, ResNameResourceGateway, d.Id(), err)
+	}
+
+	return append(diags, resourceResourceGatewayRead(ctx, d, meta)...)
+}
+
+func resourceResourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	out, err := findResourceGatewayByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice Resource Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionReading, ResNameResourceGateway, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.Arn)
+	d.Set(names.AttrIPAddressType, out.IpAddressType)
+	d.Set(names.AttrName, out.Name)
+	d.Set(names.AttrSecurityGroupIDs, out.SecurityGroupIds)
+	d.Set(names.AttrStatus, out.Status)
+	d.Set(names.AttrSubnetIDs, out.SubnetIds)
+	d.Set(names.AttrVPCID, out.VpcId)
+
+	return diags
+}
+
+func resourceResourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	if d.HasChange(names.AttrSecurityGroupIDs) {
+		in := &vpclattice.UpdateResourceGatewayInput{
+			ResourceGatewayIdentifier: aws.String(d.Id()),
+			SecurityGroupIds:          flex.ExpandStringValueSet(d.Get(names.AttrSecurityGroupIDs).(*schema.Set)),
+		}
+
+		_, err := conn.UpdateResourceGateway(ctx, in)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionUpdating, ResNameResourceGateway, d.Id(), err)
+		}
+
+		if _, err := waitResourceGatewayActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForUpdate, ResNameResourceGateway, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceGatewayRead(ctx, d, meta)...)
+}
+
+func resourceResourceGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	log.Printf("[INFO] Deleting VPCLattice Resource Gateway: %s", d.Id())
+	_, err := conn.DeleteResourceGateway(ctx, &vpclattice.DeleteResourceGatewayInput{
+		ResourceGatewayIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionDeleting, ResNameResourceGateway, d.Id(), err)
+	}
+
+	if _, err := waitResourceGatewayDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameResourceGateway, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findResourceGatewayByID(ctx context.Context, conn *vpclattice.Client, id string) (*vpclattice.GetResourceGatewayOutput, error) {
+	in := &vpclattice.GetResourceGatewayInput{
+		ResourceGatewayIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetResourceGateway(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func statusResourceGateway(ctx context.Context, conn *vpclattice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findResourceGatewayByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitResourceGatewayActive(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceGatewayOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.ResourceGatewayStatusCreateInProgress, types.ResourceGatewayStatusUpdateInProgress),
+		Target:                    enum.Slice(types.ResourceGatewayStatusActive),
+		Refresh:                   statusResourceGateway(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*vpclattice.GetResourceGatewayOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitResourceGatewayDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetResourceGatewayOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ResourceGatewayStatusActive, types.ResourceGatewayStatusDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusResourceGateway(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*vpclattice.GetResourceGatewayOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_vpclattice_service_networks", name="Service Networks")
+func dataSourceServiceNetworks() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceNetworksRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number_of_associated_resource_configurations": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_associated_services": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_associated_vpcs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrOwnerAccountID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"shared_with_me": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	awsClient := meta.(*conns.AWSClient)
+	conn := awsClient.VPCLatticeClient(ctx)
+
+	out, err := findServiceNetworks(ctx, conn, &vpclattice.ListServiceNetworksInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing VPC Lattice Service Networks: %s", err)
+	}
+
+	// Service networks shared via AWS RAM are returned alongside those created by the account.
+	// The owning account is only available from the ARN.
+	sharedWithMe := d.Get("shared_with_me").(bool)
+	var tfList []interface{}
+	for _, v := range out {
+		parsedARN, err := arn.Parse(aws.ToString(v.Arn))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if sharedWithMe && parsedARN.AccountID == awsClient.AccountID {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:  aws.ToString(v.Arn),
+			names.AttrID:   aws.ToString(v.Id),
+			names.AttrName: aws.ToString(v.Name),
+			"number_of_associated_resource_configurations": aws.ToInt64(v.NumberOfAssociatedResourceConfigurations),
+			"number_of_associated_services":                aws.ToInt64(v.NumberOfAssociatedServices),
+			"number_of_associated_vpcs":                    aws.ToInt64(v.NumberOfAssociatedVPCs),
+			names.AttrOwnerAccountID:                       parsedARN.AccountID,
+		})
+	}
+
+	d.SetId(awsClient.Region)
+	if err := d.Set("service_networks", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service_networks: %s", err)
+	}
+
+	return diags
+}
+
+func findServiceNetworks(ctx context.Context, conn *vpclattice.Client, input *vpclattice.ListServiceNetworksInput) ([]types.ServiceNetworkSummary, error) {
+	var output []types.ServiceNetworkSummary
+
+	pages := vpclattice.NewListServiceNetworksPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCLatticeServiceNetworksDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network.test"
+	dataSourceName := "data.aws_vpclattice_service_networks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworksDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "service_networks.#", 1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "service_networks.*.arn", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "service_networks.*", map[string]string{
+						names.AttrName:                  rName,
+						"number_of_associated_services": acctest.Ct0,
+						"number_of_associated_vpcs":     acctest.Ct0,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "service_networks.*.owner_account_id", "data.aws_caller_identity.current", names.AttrAccountID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworksDataSource_sharedWithMe(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network.test"
+	dataSourceName := "data.aws_vpclattice_service_networks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworksDataSourceConfig_sharedWithMe(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "service_networks.*.arn", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "service_networks.*.owner_account_id", "data.aws_caller_identity.source", names.AttrAccountID),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceNetworksDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+}
+
+data "aws_vpclattice_service_networks" "test" {
+  depends_on = [aws_vpclattice_service_network.test]
+}
+`, rName)
+}
+
+func testAccServiceNetworksDataSourceConfig_sharedWithMe(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "source" {}
+
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+}
+
+resource "aws_ram_resource_share" "test" {
+  name                      = %[1]q
+  allow_external_principals = false
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = aws_vpclattice_service_network.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_principal_association" "test" {
+  principal          = data.aws_caller_identity.target.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_vpclattice_service_networks" "test" {
+  provider = "awsalternate"
+
+  shared_with_me = true
+
+  depends_on = [aws_ram_resource_association.test, aws_ram_principal_association.test]
+}
+`, rName))
+}
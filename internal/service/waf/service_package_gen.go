@@ -49,6 +49,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_waf_web_acl",
 			Name:     "Web ACL",
 		},
+		{
+			Factory:  dataSourceWebACLWAFV2Rules,
+			TypeName: "aws_waf_web_acl_wafv2_rules",
+			Name:     "Web ACL WAFv2 Rules",
+		},
 	}
 }
 
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/waf"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/waf/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/waf/wafv2rules"
+)
+
+// wafv2RulesFinder implements wafv2rules.Finder for WAF Classic.
+type wafv2RulesFinder struct {
+	conn *waf.Client
+}
+
+var _ wafv2rules.Finder = wafv2RulesFinder{}
+
+func (f wafv2RulesFinder) FindByteMatchSetByID(ctx context.Context, id string) (*awstypes.ByteMatchSet, error) {
+	return findByteMatchSetByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindGeoMatchSetByID(ctx context.Context, id string) (*awstypes.GeoMatchSet, error) {
+	return findGeoMatchSetByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindRateBasedRuleByID(ctx context.Context, id string) (*awstypes.RateBasedRule, error) {
+	return findRateBasedRuleByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindRegexMatchSetByID(ctx context.Context, id string) (*awstypes.RegexMatchSet, error) {
+	return findRegexMatchSetByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindRegexPatternSetByID(ctx context.Context, id string) (*awstypes.RegexPatternSet, error) {
+	return findRegexPatternSetByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindRuleByID(ctx context.Context, id string) (*awstypes.Rule, error) {
+	return findRuleByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindSizeConstraintSetByID(ctx context.Context, id string) (*awstypes.SizeConstraintSet, error) {
+	return findSizeConstraintSetByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindSQLInjectionMatchSetByID(ctx context.Context, id string) (*awstypes.SqlInjectionMatchSet, error) {
+	return findSQLInjectionMatchSetByID(ctx, f.conn, id)
+}
+
+func (f wafv2RulesFinder) FindXSSMatchSetByID(ctx context.Context, id string) (*awstypes.XssMatchSet, error) {
+	return findXSSMatchSetByID(ctx, f.conn, id)
+}
@@ -0,0 +1,478 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package wafv2rules converts WAF Classic (global and Regional) web ACL rules to WAFv2 rules.
+package wafv2rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/waf/types"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// Finder looks up the WAF Classic objects referenced by a web ACL.
+// WAF Regional implementations return the equivalent WAF Classic types.
+type Finder interface {
+	FindByteMatchSetByID(ctx context.Context, id string) (*awstypes.ByteMatchSet, error)
+	FindGeoMatchSetByID(ctx context.Context, id string) (*awstypes.GeoMatchSet, error)
+	FindRateBasedRuleByID(ctx context.Context, id string) (*awstypes.RateBasedRule, error)
+	FindRegexMatchSetByID(ctx context.Context, id string) (*awstypes.RegexMatchSet, error)
+	FindRegexPatternSetByID(ctx context.Context, id string) (*awstypes.RegexPatternSet, error)
+	FindRuleByID(ctx context.Context, id string) (*awstypes.Rule, error)
+	FindSizeConstraintSetByID(ctx context.Context, id string) (*awstypes.SizeConstraintSet, error)
+	FindSQLInjectionMatchSetByID(ctx context.Context, id string) (*awstypes.SqlInjectionMatchSet, error)
+	FindXSSMatchSetByID(ctx context.Context, id string) (*awstypes.XssMatchSet, error)
+}
+
+// Unconvertible describes a web ACL rule that has no WAFv2 equivalent.
+type Unconvertible struct {
+	DataID        string
+	PredicateType string
+	Reason        string
+	RuleID        string
+	RuleType      string
+}
+
+// unconvertibleError is returned when a WAF Classic predicate has no WAFv2 equivalent.
+type unconvertibleError struct {
+	predicateType awstypes.PredicateType
+	dataID        string
+	reason        string
+}
+
+func (e *unconvertibleError) Error() string {
+	return e.reason
+}
+
+// Convert performs a best-effort conversion of a web ACL's rules to WAFv2 rules.
+// Rules that cannot be converted in their entirety are omitted and returned as Unconvertible.
+// serviceName (e.g. "WAF Classic") is used in the reasons given for unconvertible rules.
+func Convert(ctx context.Context, finder Finder, serviceName string, webACL *awstypes.WebACL) ([]wafv2types.Rule, []Unconvertible, error) {
+	c := &converter{
+		finder:      finder,
+		serviceName: serviceName,
+	}
+
+	rules, err := c.convertWebACL(ctx, webACL)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rules, c.unconvertible, nil
+}
+
+type converter struct {
+	finder        Finder
+	serviceName   string
+	unconvertible []Unconvertible
+}
+
+func (c *converter) convertWebACL(ctx context.Context, webACL *awstypes.WebACL) ([]wafv2types.Rule, error) {
+	rules := make([]wafv2types.Rule, 0, len(webACL.Rules))
+
+	for _, activatedRule := range webACL.Rules {
+		ruleID := aws.ToString(activatedRule.RuleId)
+		rule, err := c.convertActivatedRule(ctx, activatedRule)
+
+		if v, ok := errs.As[*unconvertibleError](err); ok {
+			c.unconvertible = append(c.unconvertible, Unconvertible{
+				DataID:        v.dataID,
+				PredicateType: string(v.predicateType),
+				Reason:        v.reason,
+				RuleID:        ruleID,
+				RuleType:      string(activatedRule.Type),
+			})
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("rule (%s): %w", ruleID, err)
+		}
+
+		rules = append(rules, *rule)
+	}
+
+	return rules, nil
+}
+
+func (c *converter) convertActivatedRule(ctx context.Context, activatedRule awstypes.ActivatedRule) (*wafv2types.Rule, error) {
+	var name, metricName string
+	var statement *wafv2types.Statement
+
+	switch ruleID := aws.ToString(activatedRule.RuleId); activatedRule.Type {
+	case awstypes.WafRuleTypeGroup:
+		return nil, &unconvertibleError{
+			dataID: ruleID,
+			reason: fmt.Sprintf("%s rule groups have no WAFv2 equivalent; recreate the rules in an aws_wafv2_rule_group and reference it with a rule_group_reference_statement", c.serviceName),
+		}
+
+	case awstypes.WafRuleTypeRateBased:
+		rule, err := c.finder.FindRateBasedRuleByID(ctx, ruleID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		name, metricName = aws.ToString(rule.Name), aws.ToString(rule.MetricName)
+		statement = &wafv2types.Statement{
+			RateBasedStatement: &wafv2types.RateBasedStatement{
+				AggregateKeyType: wafv2types.RateBasedStatementAggregateKeyTypeIp,
+				Limit:            rule.RateLimit,
+			},
+		}
+
+		if len(rule.MatchPredicates) > 0 {
+			scopeDownStatement, err := c.convertPredicates(ctx, rule.MatchPredicates)
+
+			if err != nil {
+				return nil, err
+			}
+
+			statement.RateBasedStatement.ScopeDownStatement = scopeDownStatement
+		}
+
+	default:
+		rule, err := c.finder.FindRuleByID(ctx, ruleID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rule.Predicates) == 0 {
+			return nil, &unconvertibleError{
+				reason: "rule has no predicates and matches no requests",
+			}
+		}
+
+		name, metricName = aws.ToString(rule.Name), aws.ToString(rule.MetricName)
+		statement, err = c.convertPredicates(ctx, rule.Predicates)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rule := &wafv2types.Rule{
+		Name:      aws.String(name),
+		Priority:  aws.ToInt32(activatedRule.Priority),
+		Statement: statement,
+		VisibilityConfig: &wafv2types.VisibilityConfig{
+			CloudWatchMetricsEnabled: true,
+			MetricName:               aws.String(metricName),
+			SampledRequestsEnabled:   true,
+		},
+	}
+
+	if v := activatedRule.Action; v != nil {
+		rule.Action = convertWAFAction(v.Type)
+	}
+
+	return rule, nil
+}
+
+func (c *converter) convertPredicates(ctx context.Context, predicates []awstypes.Predicate) (*wafv2types.Statement, error) {
+	statements := make([]wafv2types.Statement, 0, len(predicates))
+
+	for _, predicate := range predicates {
+		statement, err := c.convertPredicate(ctx, predicate)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if aws.ToBool(predicate.Negated) {
+			statement = &wafv2types.Statement{
+				NotStatement: &wafv2types.NotStatement{
+					Statement: statement,
+				},
+			}
+		}
+
+		statements = append(statements, *statement)
+	}
+
+	if len(statements) == 1 {
+		return &statements[0], nil
+	}
+
+	return &wafv2types.Statement{
+		AndStatement: &wafv2types.AndStatement{
+			Statements: statements,
+		},
+	}, nil
+}
+
+func (c *converter) convertPredicate(ctx context.Context, predicate awstypes.Predicate) (*wafv2types.Statement, error) {
+	var statements []wafv2types.Statement
+
+	switch dataID := aws.ToString(predicate.DataId); predicate.Type {
+	case awstypes.PredicateTypeByteMatch:
+		set, err := c.finder.FindByteMatchSetByID(ctx, dataID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements = tfslices.ApplyToAll(set.ByteMatchTuples, func(v awstypes.ByteMatchTuple) wafv2types.Statement {
+			return wafv2types.Statement{
+				ByteMatchStatement: &wafv2types.ByteMatchStatement{
+					FieldToMatch:         convertFieldToMatch(v.FieldToMatch),
+					PositionalConstraint: wafv2types.PositionalConstraint(v.PositionalConstraint),
+					SearchString:         v.TargetString,
+					TextTransformations:  convertTextTransformation(v.TextTransformation),
+				},
+			}
+		})
+
+	case awstypes.PredicateTypeGeoMatch:
+		set, err := c.finder.FindGeoMatchSetByID(ctx, dataID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(set.GeoMatchConstraints) > 0 {
+			statements = []wafv2types.Statement{{
+				GeoMatchStatement: &wafv2types.GeoMatchStatement{
+					CountryCodes: tfslices.ApplyToAll(set.GeoMatchConstraints, func(v awstypes.GeoMatchConstraint) wafv2types.CountryCode {
+						return wafv2types.CountryCode(v.Value)
+					}),
+				},
+			}}
+		}
+
+	case awstypes.PredicateTypeRegexMatch:
+		set, err := c.finder.FindRegexMatchSetByID(ctx, dataID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tuple := range set.RegexMatchTuples {
+			patternSet, err := c.finder.FindRegexPatternSetByID(ctx, aws.ToString(tuple.RegexPatternSetId))
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range patternSet.RegexPatternStrings {
+				statements = append(statements, wafv2types.Statement{
+					RegexMatchStatement: &wafv2types.RegexMatchStatement{
+						FieldToMatch:        convertFieldToMatch(tuple.FieldToMatch),
+						RegexString:         aws.String(v),
+						TextTransformations: convertTextTransformation(tuple.TextTransformation),
+					},
+				})
+			}
+		}
+
+	case awstypes.PredicateTypeSizeConstraint:
+		set, err := c.finder.FindSizeConstraintSetByID(ctx, dataID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements = tfslices.ApplyToAll(set.SizeConstraints, func(v awstypes.SizeConstraint) wafv2types.Statement {
+			return wafv2types.Statement{
+				SizeConstraintStatement: &wafv2types.SizeConstraintStatement{
+					ComparisonOperator:  wafv2types.ComparisonOperator(v.ComparisonOperator),
+					FieldToMatch:        convertFieldToMatch(v.FieldToMatch),
+					Size:                v.Size,
+					TextTransformations: convertTextTransformation(v.TextTransformation),
+				},
+			}
+		})
+
+	case awstypes.PredicateTypeSqlInjectionMatch:
+		set, err := c.finder.FindSQLInjectionMatchSetByID(ctx, dataID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements = tfslices.ApplyToAll(set.SqlInjectionMatchTuples, func(v awstypes.SqlInjectionMatchTuple) wafv2types.Statement {
+			return wafv2types.Statement{
+				SqliMatchStatement: &wafv2types.SqliMatchStatement{
+					FieldToMatch:        convertFieldToMatch(v.FieldToMatch),
+					TextTransformations: convertTextTransformation(v.TextTransformation),
+				},
+			}
+		})
+
+	case awstypes.PredicateTypeXssMatch:
+		set, err := c.finder.FindXSSMatchSetByID(ctx, dataID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements = tfslices.ApplyToAll(set.XssMatchTuples, func(v awstypes.XssMatchTuple) wafv2types.Statement {
+			return wafv2types.Statement{
+				XssMatchStatement: &wafv2types.XssMatchStatement{
+					FieldToMatch:        convertFieldToMatch(v.FieldToMatch),
+					TextTransformations: convertTextTransformation(v.TextTransformation),
+				},
+			}
+		})
+
+	case awstypes.PredicateTypeIpMatch:
+		return nil, &unconvertibleError{
+			predicateType: predicate.Type,
+			dataID:        dataID,
+			reason:        "IP match sets must be recreated as an aws_wafv2_ip_set and referenced with an ip_set_reference_statement",
+		}
+
+	default:
+		return nil, &unconvertibleError{
+			predicateType: predicate.Type,
+			dataID:        dataID,
+			reason:        fmt.Sprintf("unsupported predicate type: %s", predicate.Type),
+		}
+	}
+
+	switch len(statements) {
+	case 0:
+		return nil, &unconvertibleError{
+			predicateType: predicate.Type,
+			dataID:        aws.ToString(predicate.DataId),
+			reason:        "match set is empty and matches no requests",
+		}
+	case 1:
+		return &statements[0], nil
+	default:
+		return &wafv2types.Statement{
+			OrStatement: &wafv2types.OrStatement{
+				Statements: statements,
+			},
+		}, nil
+	}
+}
+
+func convertWAFAction(v awstypes.WafActionType) *wafv2types.RuleAction {
+	switch v {
+	case awstypes.WafActionTypeAllow:
+		return &wafv2types.RuleAction{Allow: &wafv2types.AllowAction{}}
+	case awstypes.WafActionTypeBlock:
+		return &wafv2types.RuleAction{Block: &wafv2types.BlockAction{}}
+	case awstypes.WafActionTypeCount:
+		return &wafv2types.RuleAction{Count: &wafv2types.CountAction{}}
+	default:
+		return nil
+	}
+}
+
+func convertFieldToMatch(v *awstypes.FieldToMatch) *wafv2types.FieldToMatch {
+	if v == nil {
+		return nil
+	}
+
+	switch v.Type {
+	case awstypes.MatchFieldTypeAllQueryArgs:
+		return &wafv2types.FieldToMatch{AllQueryArguments: &wafv2types.AllQueryArguments{}}
+	case awstypes.MatchFieldTypeBody:
+		// WAF Classic and WAF Regional inspect only the first 8 KB of the body.
+		return &wafv2types.FieldToMatch{Body: &wafv2types.Body{OversizeHandling: wafv2types.OversizeHandlingContinue}}
+	case awstypes.MatchFieldTypeHeader:
+		return &wafv2types.FieldToMatch{SingleHeader: &wafv2types.SingleHeader{Name: v.Data}}
+	case awstypes.MatchFieldTypeMethod:
+		return &wafv2types.FieldToMatch{Method: &wafv2types.Method{}}
+	case awstypes.MatchFieldTypeQueryString:
+		return &wafv2types.FieldToMatch{QueryString: &wafv2types.QueryString{}}
+	case awstypes.MatchFieldTypeSingleQueryArg:
+		return &wafv2types.FieldToMatch{SingleQueryArgument: &wafv2types.SingleQueryArgument{Name: v.Data}}
+	case awstypes.MatchFieldTypeUri:
+		return &wafv2types.FieldToMatch{UriPath: &wafv2types.UriPath{}}
+	default:
+		return nil
+	}
+}
+
+func convertTextTransformation(v awstypes.TextTransformation) []wafv2types.TextTransformation {
+	if v == "" {
+		v = awstypes.TextTransformationNone
+	}
+
+	return []wafv2types.TextTransformation{{
+		Priority: 0,
+		Type:     wafv2types.TextTransformationType(v),
+	}}
+}
+
+// Marshal returns the JSON representation of the specified WAFv2 rules in the format
+// accepted by the aws_wafv2_web_acl resource's rule_json argument.
+// Null and empty values are removed to keep the document reviewable.
+func Marshal(rules []wafv2types.Rule) (string, error) {
+	b, err := json.Marshal(rules)
+
+	if err != nil {
+		return "", err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+
+	b, err = json.Marshal(pruneJSONEmptyValues(v))
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// FlattenUnconvertible returns the Terraform representation of the specified unconvertible rules.
+func FlattenUnconvertible(apiObjects []Unconvertible) []interface{} {
+	return tfslices.ApplyToAll(apiObjects, func(v Unconvertible) interface{} {
+		return map[string]interface{}{
+			"data_id":        v.DataID,
+			"predicate_type": v.PredicateType,
+			"reason":         v.Reason,
+			"rule_id":        v.RuleID,
+			"rule_type":      v.RuleType,
+		}
+	})
+}
+
+// pruneJSONEmptyValues removes null, empty string and empty array values from decoded JSON objects.
+// Numeric zeros and false are retained as they can be significant (e.g. `"Priority": 0`), as are
+// empty objects (e.g. `"Allow": {}`).
+func pruneJSONEmptyValues(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			switch e := e.(type) {
+			case nil:
+				delete(v, k)
+			case string:
+				if e == "" {
+					delete(v, k)
+				}
+			case []interface{}:
+				if len(e) == 0 {
+					delete(v, k)
+				} else {
+					v[k] = pruneJSONEmptyValues(e)
+				}
+			default:
+				v[k] = pruneJSONEmptyValues(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = pruneJSONEmptyValues(e)
+		}
+	}
+
+	return v
+}
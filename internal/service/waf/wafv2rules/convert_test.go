@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2rules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/waf/types"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rules    []wafv2types.Rule
+		expected string
+	}{
+		"empty": {
+			rules:    []wafv2types.Rule{},
+			expected: `[]`,
+		},
+		"byte match": {
+			rules: []wafv2types.Rule{
+				{
+					Action: convertWAFAction(awstypes.WafActionTypeBlock),
+					Name:   aws.String("test"),
+					Statement: &wafv2types.Statement{
+						ByteMatchStatement: &wafv2types.ByteMatchStatement{
+							FieldToMatch:         convertFieldToMatch(&awstypes.FieldToMatch{Type: awstypes.MatchFieldTypeHeader, Data: aws.String("referer")}),
+							PositionalConstraint: wafv2types.PositionalConstraintContains,
+							SearchString:         []byte("bad"),
+							TextTransformations:  convertTextTransformation(""),
+						},
+					},
+					Priority: 1,
+					VisibilityConfig: &wafv2types.VisibilityConfig{
+						CloudWatchMetricsEnabled: true,
+						MetricName:               aws.String("test"),
+						SampledRequestsEnabled:   true,
+					},
+				},
+			},
+			expected: `[{"Action":{"Block":{}},"Name":"test","Priority":1,"Statement":{"ByteMatchStatement":{"FieldToMatch":{"SingleHeader":{"Name":"referer"}},"PositionalConstraint":"CONTAINS","SearchString":"YmFk","TextTransformations":[{"Priority":0,"Type":"NONE"}]}},"VisibilityConfig":{"CloudWatchMetricsEnabled":true,"MetricName":"test","SampledRequestsEnabled":true}}]`,
+		},
+		"not statement": {
+			rules: []wafv2types.Rule{
+				{
+					Action: convertWAFAction(awstypes.WafActionTypeCount),
+					Name:   aws.String("test"),
+					Statement: &wafv2types.Statement{
+						NotStatement: &wafv2types.NotStatement{
+							Statement: &wafv2types.Statement{
+								GeoMatchStatement: &wafv2types.GeoMatchStatement{
+									CountryCodes: []wafv2types.CountryCode{wafv2types.CountryCodeUs},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `[{"Action":{"Count":{}},"Name":"test","Priority":0,"Statement":{"NotStatement":{"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}}}}}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Marshal(testCase.rules)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+type testFinder struct {
+	Finder
+
+	byteMatchSets map[string]*awstypes.ByteMatchSet
+	rules         map[string]*awstypes.Rule
+}
+
+func (f *testFinder) FindByteMatchSetByID(_ context.Context, id string) (*awstypes.ByteMatchSet, error) {
+	return f.byteMatchSets[id], nil
+}
+
+func (f *testFinder) FindRuleByID(_ context.Context, id string) (*awstypes.Rule, error) {
+	return f.rules[id], nil
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	finder := &testFinder{
+		byteMatchSets: map[string]*awstypes.ByteMatchSet{
+			"bms-1": {
+				ByteMatchTuples: []awstypes.ByteMatchTuple{{
+					FieldToMatch:         &awstypes.FieldToMatch{Type: awstypes.MatchFieldTypeUri},
+					PositionalConstraint: awstypes.PositionalConstraintStartsWith,
+					TargetString:         []byte("/admin"),
+					TextTransformation:   awstypes.TextTransformationLowercase,
+				}},
+			},
+		},
+		rules: map[string]*awstypes.Rule{
+			"rule-byte": {
+				MetricName: aws.String("byte"),
+				Name:       aws.String("byte"),
+				Predicates: []awstypes.Predicate{{DataId: aws.String("bms-1"), Type: awstypes.PredicateTypeByteMatch}},
+			},
+			"rule-ip": {
+				MetricName: aws.String("ip"),
+				Name:       aws.String("ip"),
+				Predicates: []awstypes.Predicate{{DataId: aws.String("ips-1"), Type: awstypes.PredicateTypeIpMatch}},
+			},
+		},
+	}
+	webACL := &awstypes.WebACL{
+		Rules: []awstypes.ActivatedRule{
+			{
+				Action:   &awstypes.WafAction{Type: awstypes.WafActionTypeBlock},
+				Priority: aws.Int32(0),
+				RuleId:   aws.String("rule-byte"),
+				Type:     awstypes.WafRuleTypeRegular,
+			},
+			{
+				Action:   &awstypes.WafAction{Type: awstypes.WafActionTypeBlock},
+				Priority: aws.Int32(1),
+				RuleId:   aws.String("rule-ip"),
+				Type:     awstypes.WafRuleTypeRegular,
+			},
+			{
+				OverrideAction: &awstypes.WafOverrideAction{Type: awstypes.WafOverrideActionTypeNone},
+				Priority:       aws.Int32(2),
+				RuleId:         aws.String("rule-group"),
+				Type:           awstypes.WafRuleTypeGroup,
+			},
+		},
+	}
+
+	rules, unconvertible, err := Convert(ctx, finder, "WAF Regional", webACL)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := Marshal(rules)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := `[{"Action":{"Block":{}},"Name":"byte","Priority":0,"Statement":{"ByteMatchStatement":{"FieldToMatch":{"UriPath":{}},"PositionalConstraint":"STARTS_WITH","SearchString":"L2FkbWlu","TextTransformations":[{"Priority":0,"Type":"LOWERCASE"}]}},"VisibilityConfig":{"CloudWatchMetricsEnabled":true,"MetricName":"byte","SampledRequestsEnabled":true}}]`; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := len(unconvertible), 2; got != expected {
+		t.Fatalf("got %d unconvertible rules, expected %d", got, expected)
+	}
+
+	if got, expected := unconvertible[0], (Unconvertible{
+		DataID:        "ips-1",
+		PredicateType: "IPMatch",
+		Reason:        "IP match sets must be recreated as an aws_wafv2_ip_set and referenced with an ip_set_reference_statement",
+		RuleID:        "rule-ip",
+		RuleType:      "REGULAR",
+	}); got != expected {
+		t.Errorf("got %+v, expected %+v", got, expected)
+	}
+
+	if got, expected := unconvertible[1], (Unconvertible{
+		DataID:   "rule-group",
+		Reason:   "WAF Regional rule groups have no WAFv2 equivalent; recreate the rules in an aws_wafv2_rule_group and reference it with a rule_group_reference_statement",
+		RuleID:   "rule-group",
+		RuleType: "GROUP",
+	}); got != expected {
+		t.Errorf("got %+v, expected %+v", got, expected)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/waf/wafv2rules"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_waf_web_acl_wafv2_rules", name="Web ACL WAFv2 Rules")
+func dataSourceWebACLWAFV2Rules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceWebACLWAFV2RulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDefaultAction: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unconvertible": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"predicate_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"web_acl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceWebACLWAFV2RulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFClient(ctx)
+
+	webACLID := d.Get("web_acl_id").(string)
+	webACL, err := findWebACLByID(ctx, conn, webACLID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAF Web ACL (%s): %s", webACLID, err)
+	}
+
+	rules, unconvertible, err := wafv2rules.Convert(ctx, wafv2RulesFinder{conn: conn}, "WAF Classic", webACL)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting WAF Web ACL (%s) to WAFv2 rules: %s", webACLID, err)
+	}
+
+	ruleJSON, err := wafv2rules.Marshal(rules)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting WAF Web ACL (%s) to WAFv2 rules: %s", webACLID, err)
+	}
+
+	for _, v := range unconvertible {
+		diags = sdkdiag.AppendWarningf(diags, "WAF Web ACL (%s) rule (%s) was not converted to WAFv2: %s", webACLID, v.RuleID, v.Reason)
+	}
+
+	d.SetId(webACLID)
+	if webACL.DefaultAction != nil {
+		d.Set(names.AttrDefaultAction, string(webACL.DefaultAction.Type))
+	}
+	d.Set("rule_json", ruleJSON)
+	if err := d.Set("unconvertible", wafv2rules.FlattenUnconvertible(unconvertible)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting unconvertible: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFWebACLWAFV2RulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	resourceName := "aws_waf_web_acl.test"
+	datasourceName := "data.aws_waf_web_acl_wafv2_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLWAFV2RulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrDefaultAction, "ALLOW"),
+					resource.TestMatchResourceAttr(datasourceName, "rule_json", regexache.MustCompile(`"ByteMatchStatement":`)),
+					resource.TestMatchResourceAttr(datasourceName, "rule_json", regexache.MustCompile(`"Block":\{\}`)),
+					resource.TestCheckResourceAttr(datasourceName, "unconvertible.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(datasourceName, "unconvertible.0.data_id", "aws_waf_ipset.test", names.AttrID),
+					resource.TestCheckResourceAttr(datasourceName, "unconvertible.0.predicate_type", "IPMatch"),
+					resource.TestCheckResourceAttrPair(datasourceName, "unconvertible.0.rule_id", "aws_waf_rule.ip", names.AttrID),
+					resource.TestCheckResourceAttr(datasourceName, "unconvertible.0.rule_type", "REGULAR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebACLWAFV2RulesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_byte_match_set" "test" {
+  name = %[1]q
+
+  byte_match_tuples {
+    text_transformation   = "NONE"
+    target_string         = "badrefer1"
+    positional_constraint = "CONTAINS"
+
+    field_to_match {
+      type = "HEADER"
+      data = "referer"
+    }
+  }
+}
+
+resource "aws_waf_ipset" "test" {
+  name = %[1]q
+
+  ip_set_descriptors {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
+resource "aws_waf_rule" "byte" {
+  metric_name = "%[1]sbyte"
+  name        = "%[1]sbyte"
+
+  predicates {
+    data_id = aws_waf_byte_match_set.test.id
+    negated = false
+    type    = "ByteMatch"
+  }
+}
+
+resource "aws_waf_rule" "ip" {
+  metric_name = "%[1]sip"
+  name        = "%[1]sip"
+
+  predicates {
+    data_id = aws_waf_ipset.test.id
+    negated = false
+    type    = "IPMatch"
+  }
+}
+
+resource "aws_waf_web_acl" "test" {
+  metric_name = %[1]q
+  name        = %[1]q
+
+  default_action {
+    type = "ALLOW"
+  }
+
+  rules {
+    priority = 1
+    rule_id  = aws_waf_rule.byte.id
+
+    action {
+      type = "BLOCK"
+    }
+  }
+
+  rules {
+    priority = 2
+    rule_id  = aws_waf_rule.ip.id
+
+    action {
+      type = "BLOCK"
+    }
+  }
+}
+
+data "aws_waf_web_acl_wafv2_rules" "test" {
+  web_acl_id = aws_waf_web_acl.test.id
+}
+`, rName)
+}
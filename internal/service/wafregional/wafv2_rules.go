@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafregional
+
+import (
+	"context"
+
+	waftypes "github.com/aws/aws-sdk-go-v2/service/waf/types"
+	"github.com/aws/aws-sdk-go-v2/service/wafregional"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafregional/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/waf/wafv2rules"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// wafv2RulesFinder implements wafv2rules.Finder for WAF Regional.
+// WAF Regional objects are returned as their (structurally identical) WAF Classic equivalents.
+type wafv2RulesFinder struct {
+	conn *wafregional.Client
+}
+
+var _ wafv2rules.Finder = wafv2RulesFinder{}
+
+func (f wafv2RulesFinder) FindByteMatchSetByID(ctx context.Context, id string) (*waftypes.ByteMatchSet, error) {
+	output, err := findByteMatchSetByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.ByteMatchSet{
+		ByteMatchSetId: output.ByteMatchSetId,
+		ByteMatchTuples: tfslices.ApplyToAll(output.ByteMatchTuples, func(v awstypes.ByteMatchTuple) waftypes.ByteMatchTuple {
+			return waftypes.ByteMatchTuple{
+				FieldToMatch:         expandWAFClassicFieldToMatch(v.FieldToMatch),
+				PositionalConstraint: waftypes.PositionalConstraint(v.PositionalConstraint),
+				TargetString:         v.TargetString,
+				TextTransformation:   waftypes.TextTransformation(v.TextTransformation),
+			}
+		}),
+		Name: output.Name,
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindGeoMatchSetByID(ctx context.Context, id string) (*waftypes.GeoMatchSet, error) {
+	output, err := findGeoMatchSetByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.GeoMatchSet{
+		GeoMatchConstraints: tfslices.ApplyToAll(output.GeoMatchConstraints, func(v awstypes.GeoMatchConstraint) waftypes.GeoMatchConstraint {
+			return waftypes.GeoMatchConstraint{
+				Type:  waftypes.GeoMatchConstraintType(v.Type),
+				Value: waftypes.GeoMatchConstraintValue(v.Value),
+			}
+		}),
+		GeoMatchSetId: output.GeoMatchSetId,
+		Name:          output.Name,
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindRateBasedRuleByID(ctx context.Context, id string) (*waftypes.RateBasedRule, error) {
+	output, err := findRateBasedRuleByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.RateBasedRule{
+		MatchPredicates: expandWAFClassicPredicates(output.MatchPredicates),
+		MetricName:      output.MetricName,
+		Name:            output.Name,
+		RateKey:         waftypes.RateKey(output.RateKey),
+		RateLimit:       output.RateLimit,
+		RuleId:          output.RuleId,
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindRegexMatchSetByID(ctx context.Context, id string) (*waftypes.RegexMatchSet, error) {
+	output, err := findRegexMatchSetByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.RegexMatchSet{
+		Name:            output.Name,
+		RegexMatchSetId: output.RegexMatchSetId,
+		RegexMatchTuples: tfslices.ApplyToAll(output.RegexMatchTuples, func(v awstypes.RegexMatchTuple) waftypes.RegexMatchTuple {
+			return waftypes.RegexMatchTuple{
+				FieldToMatch:       expandWAFClassicFieldToMatch(v.FieldToMatch),
+				RegexPatternSetId:  v.RegexPatternSetId,
+				TextTransformation: waftypes.TextTransformation(v.TextTransformation),
+			}
+		}),
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindRegexPatternSetByID(ctx context.Context, id string) (*waftypes.RegexPatternSet, error) {
+	output, err := findRegexPatternSetByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.RegexPatternSet{
+		Name:                output.Name,
+		RegexPatternSetId:   output.RegexPatternSetId,
+		RegexPatternStrings: output.RegexPatternStrings,
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindRuleByID(ctx context.Context, id string) (*waftypes.Rule, error) {
+	output, err := findRuleByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.Rule{
+		MetricName: output.MetricName,
+		Name:       output.Name,
+		Predicates: expandWAFClassicPredicates(output.Predicates),
+		RuleId:     output.RuleId,
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindSizeConstraintSetByID(ctx context.Context, id string) (*waftypes.SizeConstraintSet, error) {
+	output, err := findSizeConstraintSetByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.SizeConstraintSet{
+		Name:                output.Name,
+		SizeConstraintSetId: output.SizeConstraintSetId,
+		SizeConstraints: tfslices.ApplyToAll(output.SizeConstraints, func(v awstypes.SizeConstraint) waftypes.SizeConstraint {
+			return waftypes.SizeConstraint{
+				ComparisonOperator: waftypes.ComparisonOperator(v.ComparisonOperator),
+				FieldToMatch:       expandWAFClassicFieldToMatch(v.FieldToMatch),
+				Size:               v.Size,
+				TextTransformation: waftypes.TextTransformation(v.TextTransformation),
+			}
+		}),
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindSQLInjectionMatchSetByID(ctx context.Context, id string) (*waftypes.SqlInjectionMatchSet, error) {
+	output, err := findSQLInjectionMatchSetByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.SqlInjectionMatchSet{
+		Name:                   output.Name,
+		SqlInjectionMatchSetId: output.SqlInjectionMatchSetId,
+		SqlInjectionMatchTuples: tfslices.ApplyToAll(output.SqlInjectionMatchTuples, func(v awstypes.SqlInjectionMatchTuple) waftypes.SqlInjectionMatchTuple {
+			return waftypes.SqlInjectionMatchTuple{
+				FieldToMatch:       expandWAFClassicFieldToMatch(v.FieldToMatch),
+				TextTransformation: waftypes.TextTransformation(v.TextTransformation),
+			}
+		}),
+	}, nil
+}
+
+func (f wafv2RulesFinder) FindXSSMatchSetByID(ctx context.Context, id string) (*waftypes.XssMatchSet, error) {
+	output, err := findXSSMatchSetByID(ctx, f.conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &waftypes.XssMatchSet{
+		Name:          output.Name,
+		XssMatchSetId: output.XssMatchSetId,
+		XssMatchTuples: tfslices.ApplyToAll(output.XssMatchTuples, func(v awstypes.XssMatchTuple) waftypes.XssMatchTuple {
+			return waftypes.XssMatchTuple{
+				FieldToMatch:       expandWAFClassicFieldToMatch(v.FieldToMatch),
+				TextTransformation: waftypes.TextTransformation(v.TextTransformation),
+			}
+		}),
+	}, nil
+}
+
+func expandWAFClassicWebACL(apiObject *awstypes.WebACL) *waftypes.WebACL {
+	webACL := &waftypes.WebACL{
+		MetricName: apiObject.MetricName,
+		Name:       apiObject.Name,
+		Rules: tfslices.ApplyToAll(apiObject.Rules, func(v awstypes.ActivatedRule) waftypes.ActivatedRule {
+			activatedRule := waftypes.ActivatedRule{
+				Priority: v.Priority,
+				RuleId:   v.RuleId,
+				Type:     waftypes.WafRuleType(v.Type),
+			}
+
+			if v := v.Action; v != nil {
+				activatedRule.Action = &waftypes.WafAction{Type: waftypes.WafActionType(v.Type)}
+			}
+
+			if v := v.OverrideAction; v != nil {
+				activatedRule.OverrideAction = &waftypes.WafOverrideAction{Type: waftypes.WafOverrideActionType(v.Type)}
+			}
+
+			return activatedRule
+		}),
+		WebACLArn: apiObject.WebACLArn,
+		WebACLId:  apiObject.WebACLId,
+	}
+
+	if v := apiObject.DefaultAction; v != nil {
+		webACL.DefaultAction = &waftypes.WafAction{Type: waftypes.WafActionType(v.Type)}
+	}
+
+	return webACL
+}
+
+func expandWAFClassicPredicates(apiObjects []awstypes.Predicate) []waftypes.Predicate {
+	return tfslices.ApplyToAll(apiObjects, func(v awstypes.Predicate) waftypes.Predicate {
+		return waftypes.Predicate{
+			DataId:  v.DataId,
+			Negated: v.Negated,
+			Type:    waftypes.PredicateType(v.Type),
+		}
+	})
+}
+
+func expandWAFClassicFieldToMatch(apiObject *awstypes.FieldToMatch) *waftypes.FieldToMatch {
+	if apiObject == nil {
+		return nil
+	}
+
+	return &waftypes.FieldToMatch{
+		Data: apiObject.Data,
+		Type: waftypes.MatchFieldType(apiObject.Type),
+	}
+}
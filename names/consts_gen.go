@@ -200,6 +200,7 @@ const (
 	S3                           = "s3"
 	S3Control                    = "s3control"
 	S3Outposts                   = "s3outposts"
+	S3Tables                     = "s3tables"
 	SES                          = "ses"
 	SESV2                        = "sesv2"
 	SFN                          = "sfn"
@@ -446,6 +447,7 @@ const (
 	S3ServiceID                           = "S3"
 	S3ControlServiceID                    = "S3 Control"
 	S3OutpostsServiceID                   = "S3Outposts"
+	S3TablesServiceID                     = "S3Tables"
 	SESServiceID                          = "SES"
 	SESV2ServiceID                        = "SESv2"
 	SFNServiceID                          = "SFN"
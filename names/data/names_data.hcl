@@ -7760,6 +7760,30 @@ service "s3outposts" {
   brand                    = "AWS"
 }
 
+service "s3tables" {
+  sdk {
+    id             = "S3Tables"
+    client_version = [2]
+  }
+
+  names {
+    provider_name_upper = "S3Tables"
+    human_friendly      = "S3 Tables"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListTableBuckets"
+  }
+
+  resource_prefix {
+    correct = "aws_s3tables_"
+  }
+
+  provider_package_correct = "s3tables"
+  doc_prefix               = ["s3tables_"]
+  brand                    = "Amazon"
+}
+
 service "sagemaker" {
   sdk {
     id             = "SageMaker"